@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// startScene is the scene the game opens in
+type startScene int
+
+const (
+	// startTest opens the test scene
+	startTest startScene = iota
+	// startMenu opens the test scene with the settings menu showing
+	startMenu
+	// startInput opens the input test screen showing live action and axis
+	// state over the test scene
+	startInput
+)
+
+// startSceneNames maps -scene flag values to scenes
+var startSceneNames = map[string]startScene{
+	"test":  startTest,
+	"menu":  startMenu,
+	"input": startInput,
+}
+
+// parseStartScene returns the scene for a -scene flag value
+func parseStartScene(name string) (startScene, error) {
+	scene, ok := startSceneNames[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return startTest, fmt.Errorf("unknown scene %q (want test, menu or input)", name)
+	}
+	return scene, nil
+}
+
+// options holds the parsed command line
+type options struct {
+	scene        startScene
+	windowWidth  int
+	windowHeight int
+}
+
+// parseOptions parses the command line flags
+func parseOptions() (options, error) {
+	sceneName := flag.String("scene", "test", "starting scene: test, menu or input")
+	width := flag.Int("width", screenWidth, "window width in pixels")
+	height := flag.Int("height", screenHeight, "window height in pixels")
+	flag.Parse()
+
+	scene, err := parseStartScene(*sceneName)
+	if err != nil {
+		return options{}, err
+	}
+	if *width <= 0 || *height <= 0 {
+		return options{}, fmt.Errorf("invalid window size %dx%d", *width, *height)
+	}
+
+	return options{
+		scene:        scene,
+		windowWidth:  *width,
+		windowHeight: *height,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package main
+
+import "testing"
+
+func TestParseStartScene(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    startScene
+		wantErr bool
+	}{
+		{"test", startTest, false},
+		{"menu", startMenu, false},
+		{"input", startInput, false},
+		{" Menu ", startMenu, false},
+		{"INPUT", startInput, false},
+		{"", startTest, true},
+		{"boss", startTest, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStartScene(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseStartScene(%q) error = %v, want error %v", tt.name, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseStartScene(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
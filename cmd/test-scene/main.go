@@ -1,16 +1,27 @@
 package main
 
 import (
+	"errors"
+	imgui "github.com/gabstv/cimgui-go"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"image/color"
+	"io/fs"
 	"log"
+	"math"
 	"net/http"
 	"novampires-go/internal/common"
+	"novampires-go/internal/engine/audio"
 	"novampires-go/internal/engine/camera"
+	"novampires-go/internal/engine/clock"
 	"novampires-go/internal/engine/debug"
 	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/events"
 	"novampires-go/internal/engine/input"
 	"novampires-go/internal/engine/rendering"
 	"novampires-go/internal/game/config"
+	"novampires-go/internal/game/difficulty"
+	"novampires-go/internal/game/player"
 	"novampires-go/internal/game/scenes"
 	"runtime"
 	"time"
@@ -23,6 +34,31 @@ import (
 const (
 	screenWidth  = 1600
 	screenHeight = 900
+
+	// Zoom multiplier applied per mouse wheel notch
+	wheelZoomFactor = 1.1
+
+	// File used by quick save and quick load
+	quickSavePath = "quicksave.json"
+
+	// File the settings menu saves the configuration to
+	settingsPath = "settings.json"
+
+	// Camera shake when the player takes damage
+	damageShakeIntensity = 8.0
+	damageShakeDuration  = 250 * time.Millisecond
+
+	// Camera shake when a boss enters a new phase
+	bossPhaseShakeIntensity = 14.0
+	bossPhaseShakeDuration  = 500 * time.Millisecond
+
+	// Freeze frames on kills and critical hits
+	killHitStop     = 50 * time.Millisecond
+	criticalHitStop = 35 * time.Millisecond
+
+	// Fade to black when the run ends, and back in when the next starts
+	deathFadeDuration = 800 * time.Millisecond
+	retryFadeDuration = 400 * time.Millisecond
 )
 
 // Game represents the main game state and logic
@@ -32,15 +68,102 @@ type Game struct {
 	debugManager *debug.Manager
 	camera       *camera.Camera
 	renderer     *rendering.Renderer
+	clock        *clock.Clock
+	audio        audio.Mixer
+
+	// Fixed resolution render target, scaled into the window with bars
+	canvas    *ebiten.Image
+	letterbox rendering.Letterbox
+
+	// Player configuration, edited live by the settings menu
+	config *config.Config
 
 	// Game state
 	currentScene scene.TestScene
+	settings     *scene.SettingsScene
+	inputTest    *scene.InputScene
+	gameOver     *scene.GameOverScene
 	showDebug    bool
+	paused       bool
+
+	// Whether the run has ended and is fading out before the game over screen
+	dying bool
+
+	// Whether the simulation was frozen last frame
+	frozen bool
 }
 
 func (g *Game) Update() error {
-	// Update core systems
-	g.inputManager.Update()
+	// Update core systems, input timing runs in real time
+	g.inputManager.Update(time.Second / time.Duration(ebiten.TPS()))
+	g.audio.SetMuted(g.config.Audio.MuteWhenFocusLost && !ebiten.IsFocused())
+
+	// The settings menu takes over input and freezes the simulation while
+	// open. It polls first and consumes the press that closes it.
+	if g.settings != nil {
+		if err := g.settings.Update(0); err != nil {
+			return err
+		}
+		if g.settings.IsDone() {
+			g.settings = nil
+		}
+	}
+
+	// The input test screen freezes the simulation the same way
+	if g.inputTest != nil {
+		if err := g.inputTest.Update(0); err != nil {
+			return err
+		}
+		if g.inputTest.IsDone() {
+			g.inputTest = nil
+		}
+	}
+
+	// The game over screen freezes the run until the player picks an option
+	if g.gameOver != nil {
+		if err := g.gameOver.Update(0); err != nil {
+			return err
+		}
+		if choice := g.gameOver.Choice(); choice != scene.GameOverNone {
+			g.gameOver = nil
+			g.currentScene.ResetRun()
+			g.renderer.BeginFade(retryFadeDuration, true)
+			if choice == scene.GameOverMenu {
+				g.settings = scene.NewSettingsScene(g.inputManager, g.config, settingsPath, g.applySettings)
+			}
+		}
+	} else if g.dying {
+		// The summary comes up once the run has faded to black
+		if g.renderer.FadeDone() {
+			g.dying = false
+			g.gameOver = scene.NewGameOverScene(g.inputManager, g.currentScene.RunStats())
+		}
+	} else if g.currentScene.IsGameOver() {
+		g.dying = true
+		g.renderer.BeginFade(deathFadeDuration, false)
+	}
+
+	if g.settings == nil && g.gameOver == nil && g.inputTest == nil && !g.dying {
+		if g.inputManager.JustPressed(common.ActionMenu) {
+			g.settings = scene.NewSettingsScene(g.inputManager, g.config, settingsPath, g.applySettings)
+		} else if g.inputManager.JustPressed(common.ActionPause) {
+			g.paused = !g.paused
+		}
+	}
+
+	// Scale simulation time, debug UI and input polling keep running in real time
+	realDt := time.Second / time.Duration(ebiten.TPS())
+	frozen := g.paused || g.settings != nil || g.gameOver != nil || g.inputTest != nil
+	if frozen {
+		realDt = 0
+	}
+
+	// Stop the player when the simulation freezes so it resumes standing still
+	if frozen && !g.frozen {
+		g.currentScene.Halt()
+	}
+	g.frozen = frozen
+	dt := g.clock.Tick(realDt)
 
 	// Check debug toggle
 	if g.inputManager.JustPressed(common.ActionToggleDebug) {
@@ -50,49 +173,171 @@ func (g *Game) Update() error {
 
 	// Only update debug manager if enabled
 	if g.showDebug {
+		g.debugManager.SetHotkeysEnabled(g.settings == nil)
 		g.debugManager.Update()
+		g.updateNoclip()
+
+		if g.inputManager.JustPressed(common.ActionCyclePalette) {
+			g.renderer.CyclePalette()
+			g.currentScene.SetPalette(g.renderer.GetPalette())
+		}
 	}
 
-	g.camera.Update()
+	// Quick save and load
+	if g.inputManager.JustPressed(common.ActionQuickSave) {
+		if err := g.currentScene.SaveGame(quickSavePath); err != nil {
+			log.Printf("Quick save failed: %v", err)
+		}
+	}
+	if g.inputManager.JustPressed(common.ActionQuickLoad) {
+		if err := g.currentScene.LoadGame(quickSavePath); err != nil {
+			log.Printf("Quick load failed: %v", err)
+		}
+	}
+
+	// Zoom toward the cursor with the mouse wheel
+	if _, wheelY := ebiten.Wheel(); wheelY != 0 {
+		mouseX, mouseY := g.inputManager.GetMousePosition()
+		zoom := g.camera.GetZoom() * math.Pow(wheelZoomFactor, wheelY)
+		g.camera.ZoomTo(zoom, common.Vector2{X: float64(mouseX), Y: float64(mouseY)})
+	}
+
+	g.camera.SetAimDirection(g.currentScene.GetPlayer().GetAimDirection())
+	g.camera.Update(dt)
+	g.renderer.Update(dt)
+
+	// Feed the low health warning
+	if health := g.currentScene.GetPlayer().GetHealth(); health != nil {
+		g.renderer.SetHealthFraction(health.Percent())
+	}
 
 	// Update current scene
-	return g.currentScene.Update()
+	return g.currentScene.Update(dt)
+}
+
+// updateNoclip toggles the debug noclip mode and teleports the player to
+// clicks made while it is on
+func (g *Game) updateNoclip() {
+	p := g.currentScene.GetPlayer()
+
+	if g.inputManager.JustPressed(common.ActionToggleNoclip) {
+		p.SetNoclip(!p.IsNoclip())
+	}
+
+	// Clicks on debug windows belong to the windows
+	if !p.IsNoclip() || imgui.CurrentIO().WantCaptureMouse() {
+		return
+	}
+
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		x, y := g.inputManager.GetMousePositionWorld()
+		p.TeleportTo(common.Vector2{X: float64(x), Y: float64(y)})
+	}
+}
+
+// Pause freezes the simulation until the pause action is pressed again
+func (g *Game) Pause() {
+	g.paused = true
+}
+
+// applySettings pushes configuration changes to the running systems
+func (g *Game) applySettings(cfg *config.Config) {
+	g.audio.SetVolumes(cfg.Audio.MasterVolume, cfg.Audio.MusicVolume, cfg.Audio.SFXVolume)
+	ebiten.SetFullscreen(cfg.Display.Fullscreen)
+	ebiten.SetVsyncEnabled(cfg.Display.VSync)
+	g.renderer.SetLowHealthWarning(cfg.Gameplay.LowHealthWarning, cfg.Gameplay.LowHealthThreshold)
+	g.renderer.SetUIScale(cfg.Display.UIScale)
+	g.inputManager.SetKeyboardAim(input.KeyboardAimMode(cfg.Gameplay.KeyboardAim))
+	g.currentScene.SetUIScale(cfg.Display.UIScale)
+	g.currentScene.SetSeparation(entity.SeparationConfig{
+		Strength: cfg.Gameplay.SeparationStrength,
+		Passes:   cfg.Gameplay.SeparationPasses,
+	})
+
+	modifiers := difficulty.For(difficulty.Level(cfg.Gameplay.Difficulty))
+	g.currentScene.SetDifficulty(modifiers)
+	g.currentScene.SetAutoAimStrength(modifiers.ScaleAutoAimStrength(cfg.Gameplay.AutoAimStrength))
+	g.currentScene.SetAutoAimCandidates(cfg.Gameplay.AutoAimCandidates)
+}
+
+// SetTimeScale sets the simulation speed (1.0 normal, 0.0 frozen)
+func (g *Game) SetTimeScale(scale float64) {
+	g.clock.SetTimeScale(scale)
 }
 
 func (g *Game) Draw(screen *ebiten.Image) {
 	// Begin frame
-	g.renderer.BeginFrame(screen)
+	g.renderer.BeginFrame(g.canvas)
 
 	// Draw current scene
-	g.currentScene.Draw(screen)
+	g.currentScene.Draw(g.canvas)
 
-	// Draw debug UI if enabled
+	// Draw world space debug overlays
 	if g.showDebug {
-		g.debugManager.Draw(screen)
+		g.renderer.DrawGizmo(g.canvas)
+		if g.renderer.IsSpatialGridVisible() {
+			g.currentScene.DrawSpatialDebug(g.canvas)
+		}
 	}
 
 	// End frame
-	g.renderer.EndFrame(screen)
+	g.renderer.EndFrame(g.canvas)
+
+	// Draw the game over summary, settings menu and input test over the scene
+	if g.gameOver != nil {
+		g.gameOver.Draw(g.canvas)
+	}
+	if g.settings != nil {
+		g.settings.Draw(g.canvas)
+	}
+	if g.inputTest != nil {
+		g.inputTest.Draw(g.canvas)
+	}
+
+	// Scale the canvas into the window, leaving bars where the aspect differs
+	screen.Fill(color.Black)
+	op := &ebiten.DrawImageOptions{}
+	g.letterbox.Apply(op)
+	op.Filter = ebiten.FilterLinear
+	screen.DrawImage(g.canvas, op)
+
+	// Draw debug UI if enabled, at window resolution
+	if g.showDebug {
+		g.debugManager.Draw(screen)
+	}
 }
 
 func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
+	// Fit the fixed resolution canvas into the window
+	g.letterbox = rendering.NewLetterbox(outsideWidth, outsideHeight, screenWidth, screenHeight)
+	g.inputManager.SetLetterbox(g.letterbox)
+
 	// Update ImGui display size if window is resized
 	g.debugManager.SetDisplaySize(float32(outsideWidth), float32(outsideHeight))
-	return screenWidth, screenHeight
+	return outsideWidth, outsideHeight
 }
 
 func main() {
+	opts, err := parseOptions()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	setupMemoryProfiling()
 
 	// Set window properties
-	ebiten.SetWindowSize(screenWidth, screenHeight)
+	ebiten.SetWindowSize(opts.windowWidth, opts.windowHeight)
 	ebiten.SetWindowTitle("NoVampires Test Scene - Refactored")
+	ebiten.SetWindowResizingMode(ebiten.WindowResizingModeEnabled)
 
 	// Initialize core systems
 	im := input.New()
 
-	// Create config
-	cfg := config.Default()
+	// Load config, falling back to defaults
+	cfg, err := config.Load(settingsPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Printf("Failed to load settings: %v", err)
+	}
 
 	// Create debug manager
 	dm := debug.New(debug.Deps{InputManager: im})
@@ -107,37 +352,94 @@ func main() {
 	// Create renderer
 	renderConfig := rendering.DefaultRenderConfig()
 	renderer := rendering.NewRenderer(renderConfig, cam)
+	renderer.AddPalette(rendering.HighContrastColorPalette())
 
 	// Create renderer adapter for entity system
 	rendererAdapter := entity.NewRendererAdapter(renderer)
 
+	// Create event bus and wire up effect subscribers
+	bus := events.NewBus()
+	bus.Subscribe(events.TypePlayerDamaged, func(e events.Event) {
+		if cfg.Gameplay.ScreenShake {
+			cam.Shake(damageShakeIntensity*cfg.Gameplay.CameraShakeAmount, damageShakeDuration)
+		}
+	})
+	bus.Subscribe(events.TypeBossPhaseChanged, func(e events.Event) {
+		if cfg.Gameplay.ScreenShake {
+			cam.Shake(bossPhaseShakeIntensity*cfg.Gameplay.CameraShakeAmount, bossPhaseShakeDuration)
+		}
+	})
+
 	// Create game instance
 	game := &Game{
 		inputManager: im,
 		debugManager: dm,
 		camera:       cam,
 		renderer:     renderer,
+		clock:        clock.New(),
+		audio:        audio.NewManager(),
+		canvas:       ebiten.NewImage(screenWidth, screenHeight),
+		config:       &cfg,
 		showDebug:    cfg.Display.ShowDebugInfo,
 	}
 
+	// Freeze briefly on impactful hits
+	bus.Subscribe(events.TypeEnemyKilled, func(e events.Event) {
+		if cfg.Gameplay.HitStop {
+			game.clock.HitStop(killHitStop)
+		}
+	})
+	bus.Subscribe(events.TypeCriticalHit, func(e events.Event) {
+		if cfg.Gameplay.HitStop {
+			game.clock.HitStop(criticalHitStop)
+		}
+	})
+
+	// Route gamepad connection events and pause when the controller drops out
+	im.SetEventBus(bus)
+	if cfg.Gameplay.PauseOnGamepadDisconnect {
+		bus.Subscribe(events.TypeGamepadDisconnected, func(e events.Event) {
+			game.Pause()
+		})
+	}
+
 	// Add debug windows
 	dm.AddWindow(im.CreateDebugWindow())
 	keyBindEditor := input.NewKeyBindingEditorWindow(im)
 	dm.AddWindow(keyBindEditor)
 	dm.AddWindow(cam.CreateDebugWindow())
+	dm.AddWindow(renderer.CreateDebugWindow())
 
 	// Create scene dependencies
 	sceneDeps := scene.Dependencies{
 		InputManager: im,
 		Renderer:     rendererAdapter,
+		Camera:       cam,
+		Events:       bus,
+		Difficulty:   difficulty.For(difficulty.Level(cfg.Gameplay.Difficulty)),
+		Rng:          common.NewTimeSeededRng(),
 		ScreenWidth:  screenWidth,
 		ScreenHeight: screenHeight,
 	}
 
 	// Create and set test scene
-	game.currentScene = *scene.NewTestScene(sceneDeps)
-	player := game.currentScene.GetPlayer()
-	cam.SetTarget(&player.Position)
+	testScene, err := scene.NewTestScene(sceneDeps)
+	if err != nil {
+		log.Fatal(err)
+	}
+	game.currentScene = *testScene
+	game.applySettings(&cfg)
+	p := game.currentScene.GetPlayer()
+	cam.SetTarget(&p.Position)
+	dm.AddWindow(player.NewDebugWindow(dm, p))
+
+	// Jump straight to the requested scene
+	switch opts.scene {
+	case startMenu:
+		game.settings = scene.NewSettingsScene(im, game.config, settingsPath, game.applySettings)
+	case startInput:
+		game.inputTest = scene.NewInputScene(im)
+	}
 
 	// Run the game
 	if err := ebiten.RunGame(game); err != nil {
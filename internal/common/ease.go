@@ -0,0 +1,69 @@
+package common
+
+import "math"
+
+// EaseFunc maps a normalized progress t in [0, 1] to an eased value. Every
+// curve returns 0 at t = 0 and 1 at t = 1.
+type EaseFunc func(t float64) float64
+
+// clamp01 limits t to [0, 1] so curves never extrapolate
+func clamp01(t float64) float64 {
+	return min(max(t, 0), 1)
+}
+
+// EaseLinear returns t unchanged
+func EaseLinear(t float64) float64 {
+	return clamp01(t)
+}
+
+// EaseInQuad starts slow and accelerates
+func EaseInQuad(t float64) float64 {
+	t = clamp01(t)
+	return t * t
+}
+
+// EaseOutQuad starts fast and decelerates
+func EaseOutQuad(t float64) float64 {
+	t = clamp01(t)
+	return t * (2 - t)
+}
+
+// EaseInOutQuad accelerates to the midpoint and decelerates after it
+func EaseInOutQuad(t float64) float64 {
+	t = clamp01(t)
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 2)/2
+}
+
+// EaseInCubic starts slower than EaseInQuad and accelerates harder
+func EaseInCubic(t float64) float64 {
+	t = clamp01(t)
+	return t * t * t
+}
+
+// EaseOutCubic starts fast and settles gently
+func EaseOutCubic(t float64) float64 {
+	t = clamp01(t)
+	return 1 - math.Pow(1-t, 3)
+}
+
+// EaseInOutCubic accelerates to the midpoint and decelerates after it,
+// more sharply than EaseInOutQuad
+func EaseInOutCubic(t float64) float64 {
+	t = clamp01(t)
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 3)/2
+}
+
+// EaseOutBack overshoots past 1 slightly before settling back
+func EaseOutBack(t float64) float64 {
+	const c1 = 1.70158
+	const c3 = c1 + 1
+
+	t = clamp01(t)
+	return 1 + c3*math.Pow(t-1, 3) + c1*math.Pow(t-1, 2)
+}
@@ -0,0 +1,62 @@
+package common
+
+import (
+	"math"
+	"testing"
+)
+
+// curves are the easing functions checked by the tests
+var curves = map[string]EaseFunc{
+	"Linear":     EaseLinear,
+	"InQuad":     EaseInQuad,
+	"OutQuad":    EaseOutQuad,
+	"InOutQuad":  EaseInOutQuad,
+	"InCubic":    EaseInCubic,
+	"OutCubic":   EaseOutCubic,
+	"InOutCubic": EaseInOutCubic,
+	"OutBack":    EaseOutBack,
+}
+
+func TestEaseBoundaries(t *testing.T) {
+	for name, ease := range curves {
+		t.Run(name, func(t *testing.T) {
+			for _, tt := range []struct{ t, want float64 }{{0, 0}, {1, 1}, {-0.5, 0}, {1.5, 1}} {
+				if got := ease(tt.t); math.Abs(got-tt.want) > 1e-9 {
+					t.Errorf("ease(%v) = %v, want %v", tt.t, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestEaseMonotonic(t *testing.T) {
+	for name, ease := range curves {
+		// Overshooting is the point of EaseOutBack
+		if name == "OutBack" {
+			continue
+		}
+
+		t.Run(name, func(t *testing.T) {
+			previous := ease(0)
+			for i := 1; i <= 100; i++ {
+				value := ease(float64(i) / 100)
+				if value < previous {
+					t.Fatalf("ease(%v) = %v, below ease at the previous step %v", float64(i)/100, value, previous)
+				}
+				previous = value
+			}
+		})
+	}
+}
+
+func TestEaseOutCubic(t *testing.T) {
+	// Fast start, so it is ahead of linear everywhere in between
+	for _, x := range []float64{0.1, 0.25, 0.5, 0.75, 0.9} {
+		if got := EaseOutCubic(x); got <= x {
+			t.Errorf("EaseOutCubic(%v) = %v, want above linear", x, got)
+		}
+	}
+	if got := EaseOutCubic(0.5); math.Abs(got-0.875) > 1e-9 {
+		t.Errorf("EaseOutCubic(0.5) = %v, want 0.875", got)
+	}
+}
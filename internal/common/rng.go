@@ -0,0 +1,99 @@
+package common
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Rng is a seedable random source. Systems that need randomness take an Rng
+// instead of using the global math/rand so runs can be replayed from a seed.
+type Rng struct {
+	seed int64
+	src  *countingSource
+	r    *rand.Rand
+}
+
+// countingSource wraps a source and counts the values drawn from it so the
+// position in the sequence can be saved and restored
+type countingSource struct {
+	src   rand.Source64
+	draws uint64
+}
+
+func (c *countingSource) Int63() int64 {
+	c.draws++
+	return c.src.Int63()
+}
+
+func (c *countingSource) Uint64() uint64 {
+	c.draws++
+	return c.src.Uint64()
+}
+
+func (c *countingSource) Seed(seed int64) {
+	c.src.Seed(seed)
+	c.draws = 0
+}
+
+// NewRng creates a random source with the given seed
+func NewRng(seed int64) *Rng {
+	src := &countingSource{src: rand.NewSource(seed).(rand.Source64)}
+	return &Rng{
+		seed: seed,
+		src:  src,
+		r:    rand.New(src),
+	}
+}
+
+// NewTimeSeededRng creates a random source seeded from the current time
+func NewTimeSeededRng() *Rng {
+	return NewRng(time.Now().UnixNano())
+}
+
+// Seed returns the seed the sequence was started from
+func (g *Rng) Seed() int64 {
+	return g.seed
+}
+
+// SetSeed restarts the sequence from a new seed
+func (g *Rng) SetSeed(seed int64) {
+	g.seed = seed
+	g.r.Seed(seed)
+}
+
+// Draws returns how many values have been drawn since the sequence was seeded
+func (g *Rng) Draws() uint64 {
+	return g.src.draws
+}
+
+// Restore rewinds the sequence to seed and fast-forwards it by draws values,
+// reproducing the state reported by Seed and Draws
+func (g *Rng) Restore(seed int64, draws uint64) {
+	g.SetSeed(seed)
+	for i := uint64(0); i < draws; i++ {
+		g.src.Int63()
+	}
+}
+
+// Intn returns a random int in [0, n)
+func (g *Rng) Intn(n int) int {
+	return g.r.Intn(n)
+}
+
+// Float64 returns a random float in [0, 1)
+func (g *Rng) Float64() float64 {
+	return g.r.Float64()
+}
+
+// Range returns a random float in [min, max)
+func (g *Rng) Range(min, max float64) float64 {
+	return min + g.r.Float64()*(max-min)
+}
+
+// Duration returns a random duration in [min, max)
+func (g *Rng) Duration(min, max time.Duration) time.Duration {
+	if max <= min {
+		return min
+	}
+	return min + time.Duration(g.r.Int63n(int64(max-min)))
+}
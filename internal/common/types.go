@@ -1,5 +1,10 @@
 package common
 
+import (
+	"encoding/json"
+	"math"
+)
+
 type TargetInfo struct {
 	ID       uint64
 	Pos      Vector2
@@ -13,6 +18,38 @@ type Rectangle struct {
 	Size Vector2
 }
 
+// RectFromCenter creates a rectangle of the given size centered on center
+func RectFromCenter(center, size Vector2) Rectangle {
+	return Rectangle{
+		Pos:  Vector2{X: center.X - size.X/2, Y: center.Y - size.Y/2},
+		Size: size,
+	}
+}
+
+// rectangleJSON is the compact JSON form of a Rectangle
+type rectangleJSON struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	W float64 `json:"w"`
+	H float64 `json:"h"`
+}
+
+// MarshalJSON encodes the rectangle as {"x":..,"y":..,"w":..,"h":..}
+func (r Rectangle) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rectangleJSON{X: r.Pos.X, Y: r.Pos.Y, W: r.Size.X, H: r.Size.Y})
+}
+
+// UnmarshalJSON decodes a rectangle from {"x":..,"y":..,"w":..,"h":..}
+func (r *Rectangle) UnmarshalJSON(data []byte) error {
+	var j rectangleJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	r.Pos = Vector2{X: j.X, Y: j.Y}
+	r.Size = Vector2{X: j.W, Y: j.H}
+	return nil
+}
+
 func (r Rectangle) Contains(p Vector2) bool {
 	return p.X >= r.Pos.X &&
 		p.X <= r.Pos.X+r.Size.X &&
@@ -34,6 +71,29 @@ func (r Rectangle) Center() Vector2 {
 	}
 }
 
+// EdgeIntersection returns where the ray from center toward target leaves
+// bounds. center must be inside bounds. A target at center returns center.
+func EdgeIntersection(center, target Vector2, bounds Rectangle) Vector2 {
+	dir := target.Sub(center)
+	if dir.X == 0 && dir.Y == 0 {
+		return center
+	}
+
+	t := math.Inf(1)
+	if dir.X > 0 {
+		t = min(t, (bounds.Pos.X+bounds.Size.X-center.X)/dir.X)
+	} else if dir.X < 0 {
+		t = min(t, (bounds.Pos.X-center.X)/dir.X)
+	}
+	if dir.Y > 0 {
+		t = min(t, (bounds.Pos.Y+bounds.Size.Y-center.Y)/dir.Y)
+	} else if dir.Y < 0 {
+		t = min(t, (bounds.Pos.Y-center.Y)/dir.Y)
+	}
+
+	return center.Add(dir.Scale(t))
+}
+
 // Action represents a game action that can be triggered by input
 type Action uint8
 
@@ -51,11 +111,21 @@ const (
 	ActionToggleDebug
 	ActionInteract
 	ActionMenu
+	ActionQuickSave
+	ActionQuickLoad
 
 	// Debug window specific actions
 	ActionTogglePlayerDebug
 	ActionToggleInputDebug
 	ActionToggleBindingEditor
+	ActionToggleNoclip
+	ActionCyclePalette
+
+	// Twin-stick aiming from the keyboard, appended so saved bindings keep their values
+	ActionAimUp
+	ActionAimDown
+	ActionAimLeft
+	ActionAimRight
 )
 
 var Actions = []Action{
@@ -71,10 +141,19 @@ var Actions = []Action{
 	ActionToggleDebug,
 	ActionInteract,
 	ActionMenu,
+	ActionQuickSave,
+	ActionQuickLoad,
 
 	ActionTogglePlayerDebug,
 	ActionToggleInputDebug,
 	ActionToggleBindingEditor,
+	ActionToggleNoclip,
+	ActionCyclePalette,
+
+	ActionAimUp,
+	ActionAimDown,
+	ActionAimLeft,
+	ActionAimRight,
 }
 
 func (a Action) String() string {
@@ -103,12 +182,28 @@ func (a Action) String() string {
 		return "Interact"
 	case ActionMenu:
 		return "Menu"
+	case ActionQuickSave:
+		return "Quick Save"
+	case ActionQuickLoad:
+		return "Quick Load"
+	case ActionAimUp:
+		return "Aim Up"
+	case ActionAimDown:
+		return "Aim Down"
+	case ActionAimLeft:
+		return "Aim Left"
+	case ActionAimRight:
+		return "Aim Right"
 	case ActionTogglePlayerDebug:
 		return "Toggle Player Debug"
 	case ActionToggleInputDebug:
 		return "Toggle Input Debug"
 	case ActionToggleBindingEditor:
 		return "Toggle Binding Editor"
+	case ActionToggleNoclip:
+		return "Toggle Noclip"
+	case ActionCyclePalette:
+		return "Cycle Palette"
 	default:
 		return "Unknown Action"
 	}
@@ -136,6 +231,7 @@ const (
 	WindowBindingEdit = "Binding Editor"
 	WindowCameraDebug = "Camera Debug"
 	WindowSpriteDebug = "Sprite Debugger"
+	WindowRenderDebug = "Render Debug"
 )
 
 // InputProvider defines the interface for accessing input state
@@ -149,6 +245,10 @@ type InputProvider interface {
 	// JustPressed returns whether an action was just activated this frame
 	JustPressed(action Action) bool
 
+	// Consume makes JustPressed report false for an action for the rest of
+	// the frame, so a press is only handled once
+	Consume(action Action)
+
 	// JustReleased returns whether an action was just released this frame
 	JustReleased(action Action) bool
 
@@ -163,4 +263,10 @@ type InputProvider interface {
 
 	// GetGamepadAim returns the aim vector from the gamepad right stick
 	GetGamepadAim() (float64, float64, bool)
+
+	// GetKeyboardAim returns the normalized aim vector from the keyboard aim keys
+	GetKeyboardAim() (float64, float64, bool)
+
+	// Reset drops key sequence progress and this frame's unhandled presses
+	Reset()
 }
@@ -0,0 +1,100 @@
+package common
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestEdgeIntersection(t *testing.T) {
+	bounds := Rectangle{Size: Vector2{X: 200, Y: 100}}
+	center := bounds.Center()
+
+	tests := []struct {
+		name   string
+		target Vector2
+		want   Vector2
+	}{
+		{"right", Vector2{X: 500, Y: 50}, Vector2{X: 200, Y: 50}},
+		{"left", Vector2{X: -500, Y: 50}, Vector2{X: 0, Y: 50}},
+		{"up", Vector2{X: 100, Y: -300}, Vector2{X: 100, Y: 0}},
+		{"down right corner", Vector2{X: 300, Y: 150}, Vector2{X: 200, Y: 100}},
+		{"shallow diagonal hits the side", Vector2{X: 400, Y: 80}, Vector2{X: 200, Y: 60}},
+		{"inside", Vector2{X: 150, Y: 50}, Vector2{X: 200, Y: 50}},
+		{"at center", center, center},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EdgeIntersection(center, tt.target, bounds)
+			if math.Abs(got.X-tt.want.X) > 1e-9 || math.Abs(got.Y-tt.want.Y) > 1e-9 {
+				t.Errorf("EdgeIntersection(%v) = %v, want %v", tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRectangleJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		rect Rectangle
+		want string
+	}{
+		{"zero", Rectangle{}, `{"x":0,"y":0,"w":0,"h":0}`},
+		{"arena", Rectangle{Pos: Vector2{X: -1000, Y: -500}, Size: Vector2{X: 2000, Y: 1000}}, `{"x":-1000,"y":-500,"w":2000,"h":1000}`},
+		{"fractional", Rectangle{Pos: Vector2{X: 0.5, Y: 1.25}, Size: Vector2{X: 3.75, Y: 8}}, `{"x":0.5,"y":1.25,"w":3.75,"h":8}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.rect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", data, tt.want)
+			}
+
+			var got Rectangle
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.rect {
+				t.Errorf("round trip = %+v, want %+v", got, tt.rect)
+			}
+		})
+	}
+}
+
+func TestVector2JSONRoundTrip(t *testing.T) {
+	v := Vector2{X: -12.5, Y: 40}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"x":-12.5,"y":40}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var got Vector2
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != v {
+		t.Errorf("round trip = %v, want %v", got, v)
+	}
+
+	if err := json.Unmarshal([]byte(`[1, 2]`), &got); err == nil {
+		t.Error("Unmarshal() of an array = nil, want an error")
+	}
+}
+
+func TestRectFromCenter(t *testing.T) {
+	r := RectFromCenter(Vector2{X: 100, Y: 50}, Vector2{X: 40, Y: 20})
+	if want := (Rectangle{Pos: Vector2{X: 80, Y: 40}, Size: Vector2{X: 40, Y: 20}}); r != want {
+		t.Errorf("RectFromCenter() = %+v, want %+v", r, want)
+	}
+	if c := r.Center(); c != (Vector2{X: 100, Y: 50}) {
+		t.Errorf("Center() = %v, want the center it was built from", c)
+	}
+}
@@ -5,3 +5,13 @@ import "math"
 func NormalizeAngle(angle float64) float64 {
 	return angle - 2*math.Pi*math.Floor((angle+math.Pi)/(2*math.Pi))
 }
+
+// AngleDifference returns the signed shortest angle that rotates from onto to
+func AngleDifference(from, to float64) float64 {
+	return NormalizeAngle(to - from)
+}
+
+// LerpAngle interpolates between two angles along the shortest path
+func LerpAngle(from, to, t float64) float64 {
+	return NormalizeAngle(from + AngleDifference(from, to)*t)
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 )
@@ -13,6 +14,27 @@ func (v Vector2) String() string {
 	return fmt.Sprintf("(%.2f, %.2f)", v.X, v.Y)
 }
 
+// vector2JSON is the compact JSON form of a Vector2
+type vector2JSON struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// MarshalJSON encodes the vector as {"x":..,"y":..}
+func (v Vector2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(vector2JSON{X: v.X, Y: v.Y})
+}
+
+// UnmarshalJSON decodes a vector from {"x":..,"y":..}
+func (v *Vector2) UnmarshalJSON(data []byte) error {
+	var j vector2JSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	v.X, v.Y = j.X, j.Y
+	return nil
+}
+
 func (v Vector2) Add(v2 Vector2) Vector2 {
 	return Vector2{v.X + v2.X, v.Y + v2.Y}
 }
@@ -65,6 +87,16 @@ func (v Vector2) Lerp(v2 Vector2, t float64) Vector2 {
 	return v.Add(v2.Sub(v).Scale(t))
 }
 
+// MoveTowards moves v toward target by at most maxDistance without overshooting
+func (v Vector2) MoveTowards(target Vector2, maxDistance float64) Vector2 {
+	delta := target.Sub(v)
+	dist := delta.Magnitude()
+	if dist <= maxDistance || dist == 0 {
+		return target
+	}
+	return v.Add(delta.Scale(maxDistance / dist))
+}
+
 func (v Vector2) Distance(v2 Vector2) float64 {
 	return v.Sub(v2).Length()
 }
@@ -0,0 +1,58 @@
+// internal/engine/assets/assets.go
+package assets
+
+import (
+	"fmt"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"sync"
+)
+
+// Loader loads images from disk and caches them by path, so an image shared
+// by several sprites is only decoded once
+type Loader struct {
+	mu     sync.Mutex
+	images map[string]*ebiten.Image
+}
+
+// NewLoader creates a loader with an empty cache
+func NewLoader() *Loader {
+	return &Loader{
+		images: make(map[string]*ebiten.Image),
+	}
+}
+
+// LoadImage returns the image at path, loading it on first use. Failed loads
+// are not cached so they can be retried.
+func (l *Loader) LoadImage(path string) (*ebiten.Image, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if img, ok := l.images[path]; ok {
+		return img, nil
+	}
+
+	img, _, err := ebitenutil.NewImageFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load image %q: %w", path, err)
+	}
+
+	l.images[path] = img
+	return img, nil
+}
+
+// Clear drops all cached images
+func (l *Loader) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	clear(l.images)
+}
+
+// defaultLoader backs the package level LoadImage
+var defaultLoader = NewLoader()
+
+// LoadImage returns the image at path from the shared cache
+func LoadImage(path string) (*ebiten.Image, error) {
+	return defaultLoader.LoadImage(path)
+}
@@ -0,0 +1,67 @@
+package assets
+
+import (
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writePNG writes a small blank PNG to name in dir and returns its path
+func writePNG(t *testing.T, dir, name string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := png.Encode(f, image.NewRGBA(image.Rect(0, 0, 4, 4))); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoaderCachesImages(t *testing.T) {
+	dir := t.TempDir()
+	sheet := writePNG(t, dir, "sheet.png")
+	other := writePNG(t, dir, "other.png")
+
+	l := NewLoader()
+	first, err := l.LoadImage(sheet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := l.LoadImage(sheet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Error("loading the same path twice returned different images")
+	}
+
+	if img, err := l.LoadImage(other); err != nil || img == first {
+		t.Errorf("other path returned %p, %v, want a separate image", img, err)
+	}
+
+	l.Clear()
+	if img, err := l.LoadImage(sheet); err != nil || img == first {
+		t.Errorf("after Clear returned %p, %v, want a freshly loaded image", img, err)
+	}
+}
+
+func TestLoaderRetriesFailedLoads(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "late.png")
+
+	l := NewLoader()
+	if img, err := l.LoadImage(path); err == nil || img != nil {
+		t.Fatalf("missing file returned %p, %v, want an error", img, err)
+	}
+
+	writePNG(t, dir, "late.png")
+	if _, err := l.LoadImage(path); err != nil {
+		t.Errorf("load after the file appeared: %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+// internal/engine/audio/audio.go
+package audio
+
+// Mixer receives the volume settings sounds are played at
+type Mixer interface {
+	// SetVolumes sets the master, music and sound effect volumes, each 0-1
+	SetVolumes(master, music, sfx float64)
+
+	// SetMuted silences all sound without losing the volume settings
+	SetMuted(muted bool)
+}
+
+// Manager is a Mixer that keeps the volume levels for sounds to read when
+// they play. Nothing plays through it yet.
+type Manager struct {
+	master float64
+	music  float64
+	sfx    float64
+	muted  bool
+}
+
+// NewManager creates a manager at full volume
+func NewManager() *Manager {
+	return &Manager{master: 1, music: 1, sfx: 1}
+}
+
+// SetVolumes sets the master, music and sound effect volumes, clamped to 0-1
+func (m *Manager) SetVolumes(master, music, sfx float64) {
+	m.master = clamp(master)
+	m.music = clamp(music)
+	m.sfx = clamp(sfx)
+}
+
+// SetMuted silences all sound without losing the volume settings
+func (m *Manager) SetMuted(muted bool) {
+	m.muted = muted
+}
+
+// IsMuted returns whether sound is silenced
+func (m *Manager) IsMuted() bool {
+	return m.muted
+}
+
+// MusicVolume returns the volume music plays at after the master volume
+func (m *Manager) MusicVolume() float64 {
+	return m.effective(m.music)
+}
+
+// SFXVolume returns the volume sound effects play at after the master volume
+func (m *Manager) SFXVolume() float64 {
+	return m.effective(m.sfx)
+}
+
+// effective scales a channel volume by the master volume and mute
+func (m *Manager) effective(volume float64) float64 {
+	if m.muted {
+		return 0
+	}
+	return m.master * volume
+}
+
+// clamp limits a volume to 0-1
+func clamp(volume float64) float64 {
+	return min(max(volume, 0), 1)
+}
@@ -0,0 +1,35 @@
+package audio
+
+import "testing"
+
+func TestManagerVolumes(t *testing.T) {
+	tests := []struct {
+		name      string
+		master    float64
+		music     float64
+		sfx       float64
+		muted     bool
+		wantMusic float64
+		wantSFX   float64
+	}{
+		{"full", 1, 1, 1, false, 1, 1},
+		{"master scales channels", 0.5, 0.8, 0.4, false, 0.4, 0.2},
+		{"clamped", 2, -1, 0.5, false, 0, 0.5},
+		{"muted", 1, 1, 1, true, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager()
+			m.SetVolumes(tt.master, tt.music, tt.sfx)
+			m.SetMuted(tt.muted)
+
+			if got := m.MusicVolume(); got != tt.wantMusic {
+				t.Errorf("MusicVolume = %v, want %v", got, tt.wantMusic)
+			}
+			if got := m.SFXVolume(); got != tt.wantSFX {
+				t.Errorf("SFXVolume = %v, want %v", got, tt.wantSFX)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"math"
 	"novampires-go/internal/common"
+	"time"
 )
 
 // Config holds camera configuration parameters
@@ -15,11 +16,26 @@ type Config struct {
 	// Deadzone is the area around the target where the camera won't move
 	Deadzone common.Rectangle
 
+	// FollowOffset is added to the target, so the camera centers on
+	// target + offset (e.g. frame the player in the lower third)
+	FollowOffset common.Vector2
+
+	// AimPeek nudges the view up to this many world units toward where the
+	// player aims, on top of FollowOffset. 0 disables it.
+	AimPeek float64
+
+	// How quickly the peek offset catches up with the aim, per second
+	AimPeekRate float64
+
 	// Bounds define the world boundaries the camera can't move beyond
 	Bounds *common.Rectangle
 
 	// The size of the viewport in screen coordinates
 	ViewportSize common.Vector2
+
+	// Zoom limits enforced by SetZoom and ZoomTo
+	MinZoom float64
+	MaxZoom float64
 }
 
 // DefaultConfig returns a Config with sensible defaults
@@ -29,7 +45,11 @@ func DefaultConfig() *Config {
 		Deadzone: common.Rectangle{
 			Size: common.Vector2{X: 10, Y: 10},
 		},
+		AimPeek:      0,
+		AimPeekRate:  6.0,
 		ViewportSize: common.Vector2{X: 1600, Y: 900},
+		MinZoom:      0.2,
+		MaxZoom:      5.0,
 	}
 }
 
@@ -50,8 +70,33 @@ type Camera struct {
 	// Rotation in radians
 	rotation float64
 
+	// Animated rotation started by RotateTo
+	targetRotation float64
+	rotationSpeed  float64 // radians per second
+	rotating       bool
+
+	// Eased pan started by PanTo, which suspends following while it runs
+	panFrom     common.Vector2
+	panTo       common.Vector2
+	panDuration time.Duration
+	panElapsed  time.Duration
+	panning     bool
+
+	// Aim direction given by SetAimDirection and the smoothed peek offset
+	aim  common.Vector2
+	peek common.Vector2
+
 	// Visible world area
 	visibleArea common.Rectangle
+
+	// Screen shake state
+	shakeIntensity float64
+	shakeDuration  time.Duration
+	shakeElapsed   time.Duration
+	shakeOffset    common.Vector2
+
+	// Random source for shake offsets
+	rng *common.Rng
 }
 
 func New() *Camera {
@@ -63,6 +108,7 @@ func NewWithConfig(config *Config) *Camera {
 		config:   config,
 		zoom:     1.0,
 		rotation: 0.0,
+		rng:      common.NewTimeSeededRng(),
 	}
 
 	// Initialize the visible area
@@ -71,12 +117,21 @@ func NewWithConfig(config *Config) *Camera {
 }
 
 // Update handles camera movement and following behavior
-func (c *Camera) Update() {
+func (c *Camera) Update(dt time.Duration) {
+	c.updateShake(dt)
+	c.updateRotation(dt)
+
+	if c.panning {
+		c.updatePan(dt)
+		return
+	}
+
 	if c.target == nil {
 		return
 	}
 
-	targetCenter := *c.target
+	c.updatePeek(dt)
+	targetCenter := c.deadzoneTarget(c.target.Add(c.config.FollowOffset).Add(c.peek))
 
 	// Apply smoothing to move toward the target
 	c.pos.X += (targetCenter.X - c.pos.X) * c.config.Smoothing
@@ -91,6 +146,157 @@ func (c *Camera) Update() {
 	c.updateVisibleArea()
 }
 
+// PanTo moves the camera center to pos over duration with an ease-out
+// curve, then resumes following the target. A non-positive duration jumps
+// straight there.
+func (c *Camera) PanTo(pos common.Vector2, duration time.Duration) {
+	if duration <= 0 {
+		c.panning = false
+		c.SetCenter(pos)
+		return
+	}
+
+	c.panFrom = c.pos
+	c.panTo = pos
+	c.panDuration = duration
+	c.panElapsed = 0
+	c.panning = true
+}
+
+// IsPanning returns whether a PanTo transition is in progress
+func (c *Camera) IsPanning() bool {
+	return c.panning
+}
+
+// updatePan advances the PanTo transition
+func (c *Camera) updatePan(dt time.Duration) {
+	c.panElapsed += dt
+	progress := float64(c.panElapsed) / float64(c.panDuration)
+	if progress >= 1 {
+		c.panning = false
+	}
+
+	c.pos = c.panFrom.Lerp(c.panTo, common.EaseOutCubic(progress))
+
+	if c.config.Bounds != nil {
+		c.clampToBounds()
+	}
+
+	c.updateVisibleArea()
+}
+
+// Shake starts a screen shake that decays linearly over duration.
+// A weaker shake does not interrupt a stronger one already running.
+func (c *Camera) Shake(intensity float64, duration time.Duration) {
+	if c.shakeIntensity > 0 && c.currentShakeAmount() > intensity {
+		return
+	}
+
+	c.shakeIntensity = intensity
+	c.shakeDuration = duration
+	c.shakeElapsed = 0
+}
+
+// currentShakeAmount returns the remaining shake intensity in world units
+func (c *Camera) currentShakeAmount() float64 {
+	if c.shakeDuration <= 0 || c.shakeElapsed >= c.shakeDuration {
+		return 0
+	}
+	return c.shakeIntensity * (1 - float64(c.shakeElapsed)/float64(c.shakeDuration))
+}
+
+// SetRng replaces the random source used for screen shake
+func (c *Camera) SetRng(rng *common.Rng) {
+	c.rng = rng
+}
+
+// updateShake advances the shake and picks a new random offset for this frame
+func (c *Camera) updateShake(dt time.Duration) {
+	if c.shakeIntensity == 0 {
+		return
+	}
+
+	c.shakeElapsed += dt
+
+	amount := c.currentShakeAmount()
+	if amount <= 0 {
+		c.shakeIntensity = 0
+		c.shakeOffset = common.Vector2{}
+		return
+	}
+
+	c.shakeOffset = common.Vector2{
+		X: c.rng.Range(-amount, amount),
+		Y: c.rng.Range(-amount, amount),
+	}
+}
+
+// AimPeekOffset returns the view offset for an aim vector. The aim's length
+// (clamped to 1) scales the offset, so a half-tilted stick peeks half as far
+// and the result never exceeds maxOffset.
+func AimPeekOffset(aim common.Vector2, maxOffset float64) common.Vector2 {
+	length := aim.Magnitude()
+	if length == 0 || maxOffset <= 0 {
+		return common.Vector2{}
+	}
+	return aim.Scale(min(length, 1) * maxOffset / length)
+}
+
+// SetAimDirection sets where the player is aiming for the aim peek. Pass a
+// zero vector when not aiming to let the view settle back.
+func (c *Camera) SetAimDirection(aim common.Vector2) {
+	c.aim = aim
+}
+
+// SetAimPeek sets the maximum aim peek offset, 0 to disable it
+func (c *Camera) SetAimPeek(maxOffset float64) {
+	c.config.AimPeek = max(maxOffset, 0)
+}
+
+// GetAimPeek returns the current, smoothed aim peek offset
+func (c *Camera) GetAimPeek() common.Vector2 {
+	return c.peek
+}
+
+// updatePeek eases the peek offset toward the current aim, independent of
+// the frame rate
+func (c *Camera) updatePeek(dt time.Duration) {
+	desired := AimPeekOffset(c.aim, c.config.AimPeek)
+	if c.config.AimPeekRate <= 0 {
+		c.peek = desired
+		return
+	}
+
+	t := 1 - math.Exp(-c.config.AimPeekRate*dt.Seconds())
+	c.peek = c.peek.Lerp(desired, t)
+}
+
+// viewCenter returns the rendered view center including any shake offset
+func (c *Camera) viewCenter() common.Vector2 {
+	return c.pos.Add(c.shakeOffset)
+}
+
+// deadzoneTarget returns the camera position needed to bring the target back
+// inside the deadzone. A target inside the deadzone leaves the camera where it is.
+func (c *Camera) deadzoneTarget(target common.Vector2) common.Vector2 {
+	deadzone := common.RectFromCenter(c.pos, c.config.Deadzone.Size)
+	desired := c.pos
+
+	if target.X < deadzone.Pos.X {
+		desired.X = target.X + deadzone.Size.X/2
+	} else if target.X > deadzone.Pos.X+deadzone.Size.X {
+		desired.X = target.X - deadzone.Size.X/2
+	}
+
+	if target.Y < deadzone.Pos.Y {
+		desired.Y = target.Y + deadzone.Size.Y/2
+	} else if target.Y > deadzone.Pos.Y+deadzone.Size.Y {
+		desired.Y = target.Y - deadzone.Size.Y/2
+	}
+
+	return desired
+}
+
 // updateVisibleArea calculates the world rectangle that's currently visible
 func (c *Camera) updateVisibleArea() {
 	// Calculate the half-sizes of the viewport in world coordinates
@@ -115,6 +321,16 @@ func (c *Camera) SetTarget(target *common.Vector2) {
 	c.target = target
 }
 
+// SetFollowOffset sets the offset from the target the camera centers on
+func (c *Camera) SetFollowOffset(offset common.Vector2) {
+	c.config.FollowOffset = offset
+}
+
+// GetFollowOffset returns the offset from the target the camera centers on
+func (c *Camera) GetFollowOffset() common.Vector2 {
+	return c.config.FollowOffset
+}
+
 // GetTarget returns the current camera target position
 func (c *Camera) GetTarget() *common.Vector2 {
 	return c.target
@@ -125,7 +341,8 @@ func (c *Camera) GetTransform() ebiten.GeoM {
 	m := ebiten.GeoM{}
 
 	// 1. Translate to center the camera position
-	m.Translate(-c.pos.X, -c.pos.Y)
+	center := c.viewCenter()
+	m.Translate(-center.X, -center.Y)
 
 	// 2. Scale according to zoom
 	m.Scale(c.zoom, c.zoom)
@@ -171,7 +388,8 @@ func (c *Camera) ScreenToWorld(screenPos common.Vector2) common.Vector2 {
 	}
 
 	// 4. Translate to world position
-	m.Translate(c.pos.X, c.pos.Y)
+	center := c.viewCenter()
+	m.Translate(center.X, center.Y)
 
 	// Apply transformation
 	worldX, worldY := m.Apply(screenPos.X, screenPos.Y)
@@ -229,13 +447,100 @@ func (c *Camera) GetCenter() common.Vector2 {
 
 // SetZoom sets the camera zoom level
 func (c *Camera) SetZoom(zoom float64) {
-	c.zoom = math.Max(0.1, zoom) // Prevent negative or zero zoom
+	c.zoom = c.clampZoom(zoom)
+	c.updateVisibleArea()
+}
+
+// ZoomTo changes the zoom level while keeping the world point under
+// screenAnchor fixed on screen (e.g. zooming toward the mouse cursor)
+func (c *Camera) ZoomTo(zoom float64, screenAnchor common.Vector2) {
+	before := c.ScreenToWorld(screenAnchor)
+	c.zoom = c.clampZoom(zoom)
+	after := c.ScreenToWorld(screenAnchor)
+
+	// Shift the camera so the anchored world point lines up again
+	c.pos = c.pos.Add(before.Sub(after))
+
+	if c.config.Bounds != nil {
+		c.clampToBounds()
+	}
+
 	c.updateVisibleArea()
 }
 
-// SetRotation sets the camera rotation in radians
+// FitZoom returns the zoom at which bounds exactly fills a viewport along
+// its tighter axis, so the whole of bounds is visible
+func FitZoom(bounds common.Rectangle, viewportSize common.Vector2) float64 {
+	if bounds.Size.X <= 0 || bounds.Size.Y <= 0 {
+		return 1.0
+	}
+	return math.Min(viewportSize.X/bounds.Size.X, viewportSize.Y/bounds.Size.Y)
+}
+
+// FitBounds zooms so the configured bounds fit the viewport and centers the
+// view on them. The zoom limits still apply, so a bounds too large for
+// MinZoom is only partly visible. It returns false if no bounds are set.
+func (c *Camera) FitBounds() bool {
+	bounds := c.config.Bounds
+	if bounds == nil {
+		return false
+	}
+
+	c.zoom = c.clampZoom(FitZoom(*bounds, c.config.ViewportSize))
+	c.pos = bounds.Center()
+	c.clampToBounds()
+	c.updateVisibleArea()
+	return true
+}
+
+// clampZoom limits a zoom level to the configured range
+func (c *Camera) clampZoom(zoom float64) float64 {
+	zoom = math.Max(0.1, zoom) // Prevent negative or zero zoom
+
+	if c.config.MinZoom > 0 {
+		zoom = math.Max(c.config.MinZoom, zoom)
+	}
+	if c.config.MaxZoom > 0 {
+		zoom = math.Min(c.config.MaxZoom, zoom)
+	}
+
+	return zoom
+}
+
+// SetRotation sets the camera rotation in radians, cancelling any RotateTo
 func (c *Camera) SetRotation(radians float64) {
 	c.rotation = radians
+	c.rotating = false
+}
+
+// RotateTo animates the rotation toward target along the shortest path
+// at speed radians per second. A non-positive speed rotates instantly.
+func (c *Camera) RotateTo(target float64, speed float64) {
+	c.targetRotation = common.NormalizeAngle(target)
+	c.rotationSpeed = speed
+	c.rotating = true
+}
+
+// IsRotating returns whether a RotateTo animation is in progress
+func (c *Camera) IsRotating() bool {
+	return c.rotating
+}
+
+// updateRotation advances the RotateTo animation
+func (c *Camera) updateRotation(dt time.Duration) {
+	if !c.rotating {
+		return
+	}
+
+	diff := math.Abs(common.AngleDifference(c.rotation, c.targetRotation))
+	step := c.rotationSpeed * dt.Seconds()
+
+	if diff <= step || c.rotationSpeed <= 0 {
+		c.rotation = c.targetRotation
+		c.rotating = false
+	} else {
+		c.rotation = common.LerpAngle(c.rotation, c.targetRotation, step/diff)
+	}
 }
 
 // GetZoom returns the current zoom level
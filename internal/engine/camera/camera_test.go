@@ -0,0 +1,236 @@
+package camera
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+	"time"
+)
+
+func TestPanToEasesOut(t *testing.T) {
+	c := New()
+	c.SetCenter(common.Vector2{})
+	c.PanTo(common.Vector2{X: 100}, time.Second)
+
+	// Ease-out covers most of the distance in the first half
+	c.Update(500 * time.Millisecond)
+	if x := c.GetCenter().X; math.Abs(x-87.5) > 1e-6 {
+		t.Errorf("center halfway through = %v, want 87.5", x)
+	}
+	if !c.IsPanning() {
+		t.Error("pan ended halfway through")
+	}
+
+	c.Update(600 * time.Millisecond)
+	if x := c.GetCenter().X; math.Abs(x-100) > 1e-6 {
+		t.Errorf("center after the pan = %v, want 100", x)
+	}
+	if c.IsPanning() {
+		t.Error("still panning after the duration")
+	}
+}
+
+func TestFitZoom(t *testing.T) {
+	viewport := common.Vector2{X: 1600, Y: 900}
+
+	tests := []struct {
+		name   string
+		bounds common.Rectangle
+		want   float64
+	}{
+		{"same size", common.Rectangle{Size: common.Vector2{X: 1600, Y: 900}}, 1},
+		{"twice as large", common.Rectangle{Size: common.Vector2{X: 3200, Y: 1800}}, 0.5},
+		{"wide bounds fit by width", common.Rectangle{Size: common.Vector2{X: 3200, Y: 900}}, 0.5},
+		{"tall bounds fit by height", common.Rectangle{Size: common.Vector2{X: 800, Y: 1800}}, 0.5},
+		{"small bounds zoom in", common.Rectangle{Size: common.Vector2{X: 400, Y: 300}}, 3},
+		{"empty bounds", common.Rectangle{}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FitZoom(tt.bounds, viewport); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("FitZoom(%v) = %v, want %v", tt.bounds.Size, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFollowOffsetSettles(t *testing.T) {
+	offset := common.Vector2{X: 0, Y: 150}
+	bounds := &common.Rectangle{Size: common.Vector2{X: 4000, Y: 4000}}
+
+	tests := []struct {
+		name   string
+		bounds *common.Rectangle
+		target common.Vector2
+		want   common.Vector2
+	}{
+		{"no bounds", nil, common.Vector2{X: 300, Y: -200}, common.Vector2{X: 300, Y: -50}},
+		{"inside bounds", bounds, common.Vector2{X: 2000, Y: 2000}, common.Vector2{X: 2000, Y: 2150}},
+		{"offset clamped at the edge", bounds, common.Vector2{X: 2000, Y: 3500}, common.Vector2{X: 2000, Y: 3550}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.Deadzone = common.Rectangle{}
+			config.FollowOffset = offset
+			config.Bounds = tt.bounds
+
+			c := NewWithConfig(config)
+			c.SetCenter(tt.target)
+			target := tt.target
+			c.SetTarget(&target)
+			for range 300 {
+				c.Update(16 * time.Millisecond)
+			}
+
+			got := c.GetCenter()
+			if math.Abs(got.X-tt.want.X) > 1e-6 || math.Abs(got.Y-tt.want.Y) > 1e-6 {
+				t.Errorf("settled at %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAimPeekOffsetClamps(t *testing.T) {
+	const maxOffset = 80.0
+
+	tests := []struct {
+		name string
+		aim  common.Vector2
+		want float64 // Offset magnitude
+	}{
+		{"no aim", common.Vector2{}, 0},
+		{"half tilt", common.Vector2{X: 0.5}, 40},
+		{"full tilt", common.Vector2{Y: -1}, maxOffset},
+		{"diagonal full tilt", common.Vector2{X: 1, Y: 1}.Normalized(), maxOffset},
+		{"unnormalized cursor direction", common.Vector2{X: 300, Y: -400}, maxOffset},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset := AimPeekOffset(tt.aim, maxOffset)
+			if got := offset.Magnitude(); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("offset %v has length %v, want %v", offset, got, tt.want)
+			}
+			if tt.want > 0 && offset.Normalized().Sub(tt.aim.Normalized()).Magnitude() > 1e-9 {
+				t.Errorf("offset %v doesn't point along the aim %v", offset, tt.aim)
+			}
+		})
+	}
+
+	if got := AimPeekOffset(common.Vector2{X: 1}, 0); got != (common.Vector2{}) {
+		t.Errorf("AimPeekOffset with peek disabled = %v, want zero", got)
+	}
+}
+
+func TestZoomToKeepsAnchorFixed(t *testing.T) {
+	tests := []struct {
+		name     string
+		anchor   common.Vector2
+		from     float64
+		to       float64
+		rotation float64
+	}{
+		{"zoom in at the center", common.Vector2{X: 800, Y: 450}, 1, 1.25, 0},
+		{"zoom in at a corner", common.Vector2{X: 40, Y: 860}, 1, 1.25, 0},
+		{"zoom out off center", common.Vector2{X: 1200, Y: 100}, 2, 1.6, 0},
+		{"rotated view", common.Vector2{X: 300, Y: 700}, 0.8, 1, math.Pi / 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			c.SetCenter(common.Vector2{X: 250, Y: -75})
+			c.SetZoom(tt.from)
+			c.rotation = tt.rotation
+
+			before := c.ScreenToWorld(tt.anchor)
+			c.ZoomTo(tt.to, tt.anchor)
+			after := c.ScreenToWorld(tt.anchor)
+
+			if after.Sub(before).Magnitude() > 1e-9 {
+				t.Errorf("world point under the anchor moved from %v to %v", before, after)
+			}
+			if c.GetZoom() != tt.to {
+				t.Errorf("zoom = %v, want %v", c.GetZoom(), tt.to)
+			}
+		})
+	}
+}
+
+func TestZoomClamps(t *testing.T) {
+	config := DefaultConfig()
+	c := NewWithConfig(config)
+
+	c.SetZoom(100)
+	if got := c.GetZoom(); got != config.MaxZoom {
+		t.Errorf("SetZoom(100) gives %v, want MaxZoom %v", got, config.MaxZoom)
+	}
+
+	c.ZoomTo(0.001, common.Vector2{X: 100, Y: 100})
+	if got := c.GetZoom(); got != config.MinZoom {
+		t.Errorf("ZoomTo(0.001) gives %v, want MinZoom %v", got, config.MinZoom)
+	}
+}
+
+func TestRotateToConverges(t *testing.T) {
+	tests := []struct {
+		name   string
+		start  float64
+		target float64
+	}{
+		{"counterclockwise", 0, 1},
+		{"clockwise", 1, -0.5},
+		{"wraps past pi", 3, -3},
+		{"wraps past minus pi", -3, 3},
+		{"target outside range", 0, 2*math.Pi + 0.5},
+	}
+
+	const speed = 2.0 // radians per second
+	const step = 10 * time.Millisecond
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			c.SetRotation(tt.start)
+			c.RotateTo(tt.target, speed)
+
+			// Every step turns the same way by at most the speed allows
+			total := common.AngleDifference(tt.start, tt.target)
+			prev := tt.start
+			for i := 0; c.IsRotating(); i++ {
+				if i > 1000 {
+					t.Fatal("rotation never settled")
+				}
+				c.Update(step)
+
+				turned := common.AngleDifference(prev, c.GetRotation())
+				if turned*total < 0 {
+					t.Fatalf("turned %v, away from the shortest path", turned)
+				}
+				if math.Abs(turned) > speed*step.Seconds()+1e-9 {
+					t.Fatalf("turned %v in one step, faster than %v", turned, speed*step.Seconds())
+				}
+				prev = c.GetRotation()
+			}
+
+			if diff := common.AngleDifference(c.GetRotation(), tt.target); math.Abs(diff) > 1e-9 {
+				t.Errorf("settled at %v, want %v", c.GetRotation(), common.NormalizeAngle(tt.target))
+			}
+		})
+	}
+}
+
+func TestSetRotationCancelsRotateTo(t *testing.T) {
+	c := New()
+	c.RotateTo(2, 1)
+	c.Update(100 * time.Millisecond)
+	c.SetRotation(0.5)
+	c.Update(100 * time.Millisecond)
+
+	if c.IsRotating() || c.GetRotation() != 0.5 {
+		t.Errorf("rotation = %v, rotating = %v, want to stay at 0.5", c.GetRotation(), c.IsRotating())
+	}
+}
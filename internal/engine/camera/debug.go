@@ -6,6 +6,7 @@ import (
 	imgui "github.com/gabstv/cimgui-go"
 	"novampires-go/internal/common"
 	"novampires-go/internal/engine/debug"
+	"time"
 	"unsafe"
 )
 
@@ -20,6 +21,9 @@ type DebugWindow struct {
 	smoothing float32
 	deadzoneX float32
 	deadzoneY float32
+	offsetX   float32
+	offsetY   float32
+	aimPeek   float32
 
 	// Pointers for sliders
 	zoomPtr      unsafe.Pointer
@@ -27,6 +31,9 @@ type DebugWindow struct {
 	smoothingPtr unsafe.Pointer
 	deadzoneXPtr unsafe.Pointer
 	deadzoneYPtr unsafe.Pointer
+	offsetXPtr   unsafe.Pointer
+	offsetYPtr   unsafe.Pointer
+	aimPeekPtr   unsafe.Pointer
 }
 
 func NewDebugWindow(camera *Camera) *DebugWindow {
@@ -38,6 +45,9 @@ func NewDebugWindow(camera *Camera) *DebugWindow {
 		smoothing: float32(camera.config.Smoothing),
 		deadzoneX: float32(camera.config.Deadzone.Size.X),
 		deadzoneY: float32(camera.config.Deadzone.Size.Y),
+		offsetX:   float32(camera.config.FollowOffset.X),
+		offsetY:   float32(camera.config.FollowOffset.Y),
+		aimPeek:   float32(camera.config.AimPeek),
 	}
 
 	w.openPtr = unsafe.Pointer(&w.open)
@@ -46,6 +56,9 @@ func NewDebugWindow(camera *Camera) *DebugWindow {
 	w.smoothingPtr = unsafe.Pointer(&w.smoothing)
 	w.deadzoneXPtr = unsafe.Pointer(&w.deadzoneX)
 	w.deadzoneYPtr = unsafe.Pointer(&w.deadzoneY)
+	w.offsetXPtr = unsafe.Pointer(&w.offsetX)
+	w.offsetYPtr = unsafe.Pointer(&w.offsetY)
+	w.aimPeekPtr = unsafe.Pointer(&w.aimPeek)
 
 	return w
 }
@@ -67,6 +80,10 @@ func (w *DebugWindow) Draw() {
 			} else {
 				debug.LabeledValue("Target:", "None", nil)
 			}
+
+			if imgui.Button("Pan to Origin") {
+				w.camera.PanTo(common.Vector2{}, time.Second)
+			}
 		})
 
 		// Transform info
@@ -76,6 +93,11 @@ func (w *DebugWindow) Draw() {
 				w.camera.SetZoom(float64(w.zoom))
 			}
 
+			if w.camera.config.Bounds != nil && imgui.Button("Fit Bounds") {
+				w.camera.FitBounds()
+				w.zoom = float32(w.camera.GetZoom())
+			}
+
 			// Rotation control
 			if imgui.SliderFloat("Rotation", (*float32)(w.rotationPtr), -3.14, 3.14) {
 				w.camera.SetRotation(float64(w.rotation))
@@ -102,6 +124,18 @@ func (w *DebugWindow) Draw() {
 			if imgui.SliderFloat("Deadzone Y", (*float32)(w.deadzoneYPtr), 0, 100) {
 				w.camera.config.Deadzone.Size.Y = float64(w.deadzoneY)
 			}
+
+			if imgui.SliderFloat("Follow Offset X", (*float32)(w.offsetXPtr), -300, 300) {
+				w.camera.config.FollowOffset.X = float64(w.offsetX)
+			}
+
+			if imgui.SliderFloat("Follow Offset Y", (*float32)(w.offsetYPtr), -300, 300) {
+				w.camera.config.FollowOffset.Y = float64(w.offsetY)
+			}
+
+			if imgui.SliderFloat("Aim Peek", (*float32)(w.aimPeekPtr), 0, 200) {
+				w.camera.SetAimPeek(float64(w.aimPeek))
+			}
 		})
 	})
 }
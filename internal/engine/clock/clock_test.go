@@ -0,0 +1,100 @@
+package clock
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"testing"
+	"time"
+)
+
+func TestHitStopHoldsThenReleases(t *testing.T) {
+	frame := 10 * time.Millisecond
+	c := New()
+	c.HitStop(3 * frame)
+
+	for i := range 3 {
+		if !c.IsHitStopped() {
+			t.Fatalf("frame %d: hit-stop released early", i)
+		}
+		if dt := c.Tick(frame); dt >= frame {
+			t.Errorf("frame %d: dt = %v during hit-stop, want less than %v", i, dt, frame)
+		}
+	}
+
+	if c.IsHitStopped() {
+		t.Fatal("hit-stop still active after its duration")
+	}
+	if dt := c.Tick(frame); dt != frame {
+		t.Errorf("dt = %v after release, want %v", dt, frame)
+	}
+}
+
+func TestHitStopSplitsFrame(t *testing.T) {
+	c := New()
+	c.HitStop(5 * time.Millisecond)
+
+	dt := c.Tick(10 * time.Millisecond)
+	want := 5*time.Millisecond + time.Duration(float64(5*time.Millisecond)*hitStopScale)
+	if dt != want {
+		t.Errorf("dt = %v, want %v", dt, want)
+	}
+}
+
+func TestHitStopStacksUpToCap(t *testing.T) {
+	tests := []struct {
+		name  string
+		stops []time.Duration
+		want  time.Duration
+	}{
+		{"single", []time.Duration{50 * time.Millisecond}, 50 * time.Millisecond},
+		{"extends", []time.Duration{50 * time.Millisecond, 30 * time.Millisecond}, 80 * time.Millisecond},
+		{"capped", []time.Duration{200 * time.Millisecond, 200 * time.Millisecond}, maxHitStop},
+		{"ignores non-positive", []time.Duration{50 * time.Millisecond, -time.Second}, 50 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			for _, d := range tt.stops {
+				c.HitStop(d)
+			}
+			if c.hitStop != tt.want {
+				t.Errorf("hit-stop = %v, want %v", c.hitStop, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeScaleScalesMovement(t *testing.T) {
+	const frame = 10 * time.Millisecond
+	velocity := common.Vector2{X: 120}
+
+	tests := []struct {
+		scale float64
+		want  float64 // Distance covered in one real second
+	}{
+		{1, 120},
+		{0.5, 60},
+		{0, 0},
+		{-1, 0}, // Clamped to frozen
+	}
+
+	for _, tt := range tests {
+		c := New()
+		c.SetTimeScale(tt.scale)
+
+		e := entity.NewEntity(1, common.Vector2{})
+		e.SetVelocity(velocity)
+		for range 100 {
+			e.Update(c.Tick(frame))
+		}
+
+		if got := e.GetPosition().X; math.Abs(got-tt.want) > 1e-6 {
+			t.Errorf("scale %v: moved %v in a real second, want %v", tt.scale, got, tt.want)
+		}
+		if want := time.Duration(float64(100*frame) * max(tt.scale, 0)); c.Elapsed() != want {
+			t.Errorf("scale %v: Elapsed() = %v, want %v", tt.scale, c.Elapsed(), want)
+		}
+	}
+}
@@ -18,6 +18,9 @@ type Manager struct {
 	enabled bool
 	windows map[string]Window
 	im      common.InputProvider
+
+	// Window toggle shortcuts are ignored while false, e.g. in menus
+	hotkeys bool
 }
 
 type Deps struct {
@@ -29,6 +32,7 @@ func New(deps Deps) *Manager {
 		enabled: true,
 		windows: make(map[string]Window),
 		im:      deps.InputManager,
+		hotkeys: true,
 	}
 }
 
@@ -37,20 +41,44 @@ func (m *Manager) Update() {
 		return
 	}
 
-	// In debug manager's Update()
-	if m.im.JustPressed(common.ActionTogglePlayerDebug) {
-		m.GetWindow(common.WindowPlayerDebug).Toggle()
-	}
-	if m.im.JustPressed(common.ActionToggleBindingEditor) {
-		m.GetWindow(common.WindowBindingEdit).Toggle()
-	}
-	if m.im.JustPressed(common.ActionToggleInputDebug) {
-		m.GetWindow(common.WindowInputDebug).Toggle()
+	if m.hotkeys && m.im != nil {
+		if m.im.JustPressed(common.ActionTogglePlayerDebug) {
+			m.ToggleWindow(common.WindowPlayerDebug)
+		}
+		if m.im.JustPressed(common.ActionToggleBindingEditor) {
+			m.ToggleWindow(common.WindowBindingEdit)
+		}
+		if m.im.JustPressed(common.ActionToggleInputDebug) {
+			m.ToggleWindow(common.WindowInputDebug)
+		}
 	}
 
+	// ImGui keeps running while the game is paused so the windows stay usable
 	ebimgui.Update(1.0 / 60.0) // Fixed update rate for ImGui
 }
 
+// ToggleWindow toggles a registered window. It returns false and does
+// nothing if no window has that name.
+func (m *Manager) ToggleWindow(name string) bool {
+	w, ok := m.windows[name]
+	if !ok || w == nil {
+		return false
+	}
+	w.Toggle()
+	return true
+}
+
+// SetHotkeysEnabled turns the window toggle shortcuts on or off, so keys
+// meant for a menu don't also open debug windows
+func (m *Manager) SetHotkeysEnabled(enabled bool) {
+	m.hotkeys = enabled
+}
+
+// HotkeysEnabled returns whether the window toggle shortcuts are active
+func (m *Manager) HotkeysEnabled() bool {
+	return m.hotkeys
+}
+
 func (m *Manager) BeginFrame() {
 	if !m.enabled {
 		return
@@ -83,6 +111,9 @@ func (m *Manager) Draw(screen *ebiten.Image) {
 }
 
 func (m *Manager) AddWindow(w Window) {
+	if w == nil {
+		return
+	}
 	m.windows[w.Name()] = w
 }
 
@@ -90,6 +121,7 @@ func (m *Manager) RemoveWindow(name string) {
 	delete(m.windows, name)
 }
 
+// GetWindow returns a registered window, or nil if there is none with that name
 func (m *Manager) GetWindow(name string) Window {
 	return m.windows[name]
 }
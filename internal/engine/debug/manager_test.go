@@ -0,0 +1,44 @@
+package debug
+
+import "testing"
+
+// fakeWindow counts toggles
+type fakeWindow struct {
+	name    string
+	open    bool
+	toggles int
+}
+
+func (w *fakeWindow) Name() string { return w.name }
+func (w *fakeWindow) Draw()        {}
+func (w *fakeWindow) IsOpen() bool { return w.open }
+func (w *fakeWindow) Close()       { w.open = false }
+func (w *fakeWindow) Toggle() {
+	w.open = !w.open
+	w.toggles++
+}
+
+func TestToggleWindow(t *testing.T) {
+	m := New(Deps{})
+	w := &fakeWindow{name: "registered"}
+	m.AddWindow(w)
+
+	if !m.ToggleWindow("registered") {
+		t.Error("ToggleWindow() = false for a registered window")
+	}
+	if !w.open || w.toggles != 1 {
+		t.Errorf("window open %v after %d toggles, want open after 1", w.open, w.toggles)
+	}
+
+	if m.ToggleWindow("missing") {
+		t.Error("ToggleWindow() = true for an unregistered window")
+	}
+	if w.toggles != 1 {
+		t.Errorf("toggling an unregistered window toggled another one")
+	}
+
+	m.RemoveWindow("registered")
+	if m.ToggleWindow("registered") {
+		t.Error("ToggleWindow() = true for a removed window")
+	}
+}
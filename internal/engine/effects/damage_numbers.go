@@ -0,0 +1,100 @@
+// internal/engine/effects/damage_numbers.go
+package effects
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"strconv"
+	"time"
+)
+
+// DamageStyle describes how a damage number is drawn
+type DamageStyle struct {
+	Scale   float64
+	Color   color.RGBA
+	Outline bool
+}
+
+// DamageNumberConfig contains damage number tuning parameters
+type DamageNumberConfig struct {
+	Lifetime  time.Duration // How long a number stays on screen
+	RiseSpeed float64       // Upward drift in world units per second
+	Normal    DamageStyle   // Style for regular hits
+	Critical  DamageStyle   // Style for critical hits
+}
+
+// DefaultDamageNumberConfig returns default damage number configuration
+func DefaultDamageNumberConfig() DamageNumberConfig {
+	return DamageNumberConfig{
+		Lifetime:  800 * time.Millisecond,
+		RiseSpeed: 40.0,
+		Normal: DamageStyle{
+			Scale:   1.0,
+			Color:   color.RGBA{255, 255, 255, 255},
+			Outline: false,
+		},
+		Critical: DamageStyle{
+			Scale:   1.6,
+			Color:   color.RGBA{255, 200, 40, 255},
+			Outline: true,
+		},
+	}
+}
+
+// StyleFor returns the style used for a hit
+func (c DamageNumberConfig) StyleFor(critical bool) DamageStyle {
+	if critical {
+		return c.Critical
+	}
+	return c.Normal
+}
+
+// TextStyle returns the floating text style for a damage style
+func (c DamageNumberConfig) TextStyle(style DamageStyle) FloatingTextStyle {
+	return FloatingTextStyle{
+		Scale:    style.Scale,
+		Color:    style.Color,
+		Outline:  style.Outline,
+		Motion:   MotionRise,
+		Speed:    c.RiseSpeed,
+		Lifetime: c.Lifetime,
+		Space:    SpaceWorld,
+	}
+}
+
+// DamageNumbers spawns, animates and draws floating damage numbers. It is a
+// floating text preset.
+type DamageNumbers struct {
+	config DamageNumberConfig
+	texts  *FloatingTextSystem
+}
+
+// NewDamageNumbers creates an empty damage number system
+func NewDamageNumbers(config DamageNumberConfig) *DamageNumbers {
+	return &DamageNumbers{
+		config: config,
+		texts:  NewFloatingTextSystem(),
+	}
+}
+
+// Spawn shows a damage amount at a world position
+func (d *DamageNumbers) Spawn(position common.Vector2, amount int, critical bool) {
+	d.texts.Spawn(strconv.Itoa(amount), position, d.config.TextStyle(d.config.StyleFor(critical)))
+}
+
+// Count returns the number of damage numbers on screen
+func (d *DamageNumbers) Count() int {
+	return d.texts.Count()
+}
+
+// Update moves numbers upward and removes expired ones
+func (d *DamageNumbers) Update(dt time.Duration) {
+	d.texts.Update(dt)
+}
+
+// Draw draws all damage numbers, fading them out over their lifetime
+func (d *DamageNumbers) Draw(screen *ebiten.Image, renderer entity.Renderer) {
+	d.texts.Draw(screen, renderer)
+}
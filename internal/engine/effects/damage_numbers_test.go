@@ -0,0 +1,60 @@
+package effects
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"testing"
+)
+
+func TestStyleForCrit(t *testing.T) {
+	config := DefaultDamageNumberConfig()
+
+	if got := config.StyleFor(false); got != config.Normal {
+		t.Errorf("StyleFor(false) = %+v, want the normal style", got)
+	}
+	crit := config.StyleFor(true)
+	if crit != config.Critical {
+		t.Errorf("StyleFor(true) = %+v, want the critical style", crit)
+	}
+	if crit.Scale <= config.Normal.Scale || crit.Color == config.Normal.Color || !crit.Outline {
+		t.Errorf("critical style %+v doesn't stand out from %+v", crit, config.Normal)
+	}
+}
+
+// damageRenderer records the world text draws
+type damageRenderer struct {
+	entity.Renderer
+	styles []DamageStyle
+}
+
+func (r *damageRenderer) DrawWorldText(screen *ebiten.Image, text string, position common.Vector2, scale float64, fill color.RGBA, outline bool) {
+	r.styles = append(r.styles, DamageStyle{Scale: scale, Color: fill, Outline: outline})
+}
+
+func TestDamageNumbersDrawCritStyle(t *testing.T) {
+	config := DefaultDamageNumberConfig()
+
+	tests := []struct {
+		name     string
+		critical bool
+		want     DamageStyle
+	}{
+		{"normal hit", false, config.Normal},
+		{"critical hit", true, config.Critical},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			numbers := NewDamageNumbers(config)
+			numbers.Spawn(common.Vector2{}, 42, tt.critical)
+
+			renderer := &damageRenderer{}
+			numbers.Draw(nil, renderer)
+			if len(renderer.styles) != 1 || renderer.styles[0] != tt.want {
+				t.Errorf("drawn with %+v, want %+v", renderer.styles, tt.want)
+			}
+		})
+	}
+}
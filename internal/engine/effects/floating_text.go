@@ -0,0 +1,182 @@
+// internal/engine/effects/floating_text.go
+package effects
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/rendering"
+	"time"
+)
+
+// TextMotion is how floating text moves over its lifetime
+type TextMotion int
+
+const (
+	// MotionRise drifts upward at a constant speed
+	MotionRise TextMotion = iota
+	// MotionPop starts enlarged and shrinks back while rising and slowing down
+	MotionPop
+	// MotionStatic stays in place
+	MotionStatic
+)
+
+// TextSpace is the coordinate space floating text is positioned in
+type TextSpace int
+
+const (
+	// SpaceWorld follows the camera, e.g. numbers over enemies
+	SpaceWorld TextSpace = iota
+	// SpaceScreen is fixed on screen and scaled with the UI, e.g. "Level Up!"
+	// banners
+	SpaceScreen
+)
+
+// FloatingTextStyle describes how a floating text looks and moves
+type FloatingTextStyle struct {
+	Scale    float64 // Text scale on top of the camera zoom or UI scale
+	Color    color.RGBA
+	Font     rendering.Font // nil uses the renderer's font
+	Outline  bool           // World space only
+	Motion   TextMotion
+	Speed    float64 // Rise speed in units per second
+	PopScale float64 // Extra scale at spawn for MotionPop, 0.5 starts 50% larger
+	Lifetime time.Duration
+	Space    TextSpace
+}
+
+// XPTextStyle returns a preset for experience gains such as "+50 XP"
+func XPTextStyle() FloatingTextStyle {
+	return FloatingTextStyle{
+		Scale:    1.0,
+		Color:    color.RGBA{120, 220, 255, 255},
+		Motion:   MotionRise,
+		Speed:    30.0,
+		Lifetime: time.Second,
+		Space:    SpaceWorld,
+	}
+}
+
+// BannerTextStyle returns a preset for screen space announcements such as
+// "Level Up!"
+func BannerTextStyle() FloatingTextStyle {
+	return FloatingTextStyle{
+		Scale:    1.0,
+		Color:    color.RGBA{255, 230, 120, 255},
+		Motion:   MotionPop,
+		Speed:    20.0,
+		PopScale: 0.5,
+		Lifetime: 1500 * time.Millisecond,
+		Space:    SpaceScreen,
+	}
+}
+
+// progress returns how far through its lifetime a text of age is (0-1)
+func (s FloatingTextStyle) progress(age time.Duration) float64 {
+	if s.Lifetime <= 0 {
+		return 1
+	}
+	return min(float64(age)/float64(s.Lifetime), 1)
+}
+
+// MotionOffset returns how far a text has moved from where it spawned at age
+func MotionOffset(style FloatingTextStyle, age time.Duration) common.Vector2 {
+	switch style.Motion {
+	case MotionRise:
+		return common.Vector2{Y: -style.Speed * age.Seconds()}
+	case MotionPop:
+		// Covers the same distance as rising, front-loaded
+		distance := style.Speed * style.Lifetime.Seconds()
+		return common.Vector2{Y: -distance * common.EaseOutCubic(style.progress(age))}
+	default:
+		return common.Vector2{}
+	}
+}
+
+// MotionScale returns the scale of a text at age
+func MotionScale(style FloatingTextStyle, age time.Duration) float64 {
+	if style.Motion != MotionPop {
+		return style.Scale
+	}
+
+	// Settle within the first quarter of the lifetime
+	settle := common.EaseOutCubic(style.progress(age) * 4)
+	return style.Scale * (1 + style.PopScale*(1-settle))
+}
+
+// floatingText is a single text on screen
+type floatingText struct {
+	text     string
+	position common.Vector2
+	style    FloatingTextStyle
+	age      time.Duration
+}
+
+// FloatingTextSystem spawns, animates and draws short-lived text
+type FloatingTextSystem struct {
+	texts []floatingText
+}
+
+// NewFloatingTextSystem creates an empty floating text system
+func NewFloatingTextSystem() *FloatingTextSystem {
+	return &FloatingTextSystem{}
+}
+
+// Spawn shows text at a position in the style's space
+func (f *FloatingTextSystem) Spawn(text string, position common.Vector2, style FloatingTextStyle) {
+	f.texts = append(f.texts, floatingText{
+		text:     text,
+		position: position,
+		style:    style,
+	})
+}
+
+// Count returns the number of texts on screen
+func (f *FloatingTextSystem) Count() int {
+	return len(f.texts)
+}
+
+// Clear removes all texts
+func (f *FloatingTextSystem) Clear() {
+	f.texts = f.texts[:0]
+}
+
+// Update ages texts and removes expired ones
+func (f *FloatingTextSystem) Update(dt time.Duration) {
+	alive := f.texts[:0]
+	for _, t := range f.texts {
+		t.age += dt
+		if t.age >= t.style.Lifetime {
+			continue
+		}
+		alive = append(alive, t)
+	}
+	clear(f.texts[len(alive):])
+	f.texts = alive
+}
+
+// Draw draws all texts centered on their position, fading them out over
+// their lifetime
+func (f *FloatingTextSystem) Draw(screen *ebiten.Image, renderer entity.Renderer) {
+	for _, t := range f.texts {
+		if t.style.Font != nil {
+			renderer.WithFont(t.style.Font, func() { t.draw(screen, renderer) })
+		} else {
+			t.draw(screen, renderer)
+		}
+	}
+}
+
+// draw draws the text in its space at its current offset and scale
+func (t floatingText) draw(screen *ebiten.Image, renderer entity.Renderer) {
+	col := rendering.FadeColor(t.style.Color, 1-t.style.progress(t.age))
+
+	pos := t.position.Add(MotionOffset(t.style, t.age))
+	scale := MotionScale(t.style, t.age)
+	if t.style.Space == SpaceScreen {
+		renderer.DrawHUDTextScaled(screen, t.text, pos, scale, col)
+	} else {
+		renderer.DrawWorldText(screen, t.text, pos, scale, col, t.style.Outline)
+	}
+}
@@ -0,0 +1,125 @@
+package effects
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"math"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/rendering"
+	"testing"
+	"time"
+)
+
+func TestFloatingTextExpires(t *testing.T) {
+	style := XPTextStyle()
+	texts := NewFloatingTextSystem()
+	texts.Spawn("+10 XP", common.Vector2{}, style)
+	texts.Spawn("+20 XP", common.Vector2{}, FloatingTextStyle{Lifetime: style.Lifetime * 2})
+
+	texts.Update(style.Lifetime - time.Millisecond)
+	if got := texts.Count(); got != 2 {
+		t.Fatalf("count = %d before the lifetime, want 2", got)
+	}
+
+	texts.Update(time.Millisecond)
+	if got := texts.Count(); got != 1 {
+		t.Errorf("count = %d at the first lifetime, want 1", got)
+	}
+
+	texts.Update(style.Lifetime)
+	if got := texts.Count(); got != 0 {
+		t.Errorf("count = %d after every lifetime, want 0", got)
+	}
+}
+
+func TestMotionOffset(t *testing.T) {
+	rise := FloatingTextStyle{Motion: MotionRise, Speed: 40, Lifetime: time.Second}
+	pop := FloatingTextStyle{Motion: MotionPop, Speed: 40, Lifetime: time.Second}
+	static := FloatingTextStyle{Motion: MotionStatic, Speed: 40, Lifetime: time.Second}
+
+	tests := []struct {
+		name  string
+		style FloatingTextStyle
+		age   time.Duration
+		want  float64
+	}{
+		{"rise at spawn", rise, 0, 0},
+		{"rise halfway", rise, 500 * time.Millisecond, -20},
+		{"rise at the end", rise, time.Second, -40},
+		{"pop at spawn", pop, 0, 0},
+		{"pop at the end", pop, time.Second, -40},
+		{"pop past the end", pop, 2 * time.Second, -40},
+		{"static", static, 500 * time.Millisecond, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MotionOffset(tt.style, tt.age)
+			if got.X != 0 || math.Abs(got.Y-tt.want) > 1e-9 {
+				t.Errorf("MotionOffset = %v, want (0, %v)", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMotionPopFrontLoaded(t *testing.T) {
+	pop := FloatingTextStyle{Motion: MotionPop, Speed: 40, PopScale: 0.5, Scale: 1, Lifetime: time.Second}
+	rise := pop
+	rise.Motion = MotionRise
+
+	half := 500 * time.Millisecond
+	if p, r := MotionOffset(pop, half).Y, MotionOffset(rise, half).Y; p >= r {
+		t.Errorf("pop offset %v at half life, want further up than rise %v", p, r)
+	}
+
+	if got := MotionScale(pop, 0); got != 1.5 {
+		t.Errorf("scale at spawn = %v, want 1.5", got)
+	}
+	if got := MotionScale(pop, pop.Lifetime/4); got != 1 {
+		t.Errorf("scale after settling = %v, want 1", got)
+	}
+}
+
+// textRenderer records the floating text draw calls
+type textRenderer struct {
+	entity.Renderer
+	hud   []float64 // Scales of screen space texts
+	world []float64 // Scales of world space texts
+	fonts []rendering.Font
+}
+
+func (r *textRenderer) DrawHUDTextScaled(screen *ebiten.Image, text string, pos common.Vector2, scale float64, col color.RGBA) {
+	r.hud = append(r.hud, scale)
+}
+
+func (r *textRenderer) DrawWorldText(screen *ebiten.Image, text string, position common.Vector2, scale float64, fill color.RGBA, outline bool) {
+	r.world = append(r.world, scale)
+}
+
+func (r *textRenderer) WithFont(font rendering.Font, draw func()) {
+	r.fonts = append(r.fonts, font)
+	draw()
+}
+
+func TestDrawUsesSpaceScaleAndFont(t *testing.T) {
+	banner := BannerTextStyle()
+	banner.Font = rendering.DebugFont{}
+
+	texts := NewFloatingTextSystem()
+	texts.Spawn("Level Up!", common.Vector2{X: 320, Y: 100}, banner)
+	texts.Spawn("+10 XP", common.Vector2{}, XPTextStyle())
+
+	renderer := &textRenderer{}
+	texts.Draw(nil, renderer)
+
+	if len(renderer.hud) != 1 || renderer.hud[0] != MotionScale(banner, 0) {
+		t.Errorf("screen text scales = %v, want the popped scale %v", renderer.hud, MotionScale(banner, 0))
+	}
+	if len(renderer.world) != 1 {
+		t.Errorf("world texts drawn = %d, want 1", len(renderer.world))
+	}
+	if len(renderer.fonts) != 1 || renderer.fonts[0] != banner.Font {
+		t.Errorf("fonts = %v, want only the banner's", renderer.fonts)
+	}
+}
@@ -0,0 +1,169 @@
+package entity
+
+import (
+	"math"
+	"novampires-go/internal/common"
+)
+
+// CollisionComponent gives an entity a circular collision body
+type CollisionComponent struct {
+	// Radius of the collision circle in world units
+	Radius float64
+
+	// Static bodies are never moved by collision resolution
+	Static bool
+
+	// Mass determines how separation and velocity are shared between
+	// dynamic bodies. Values <= 0 are treated as 1.
+	Mass float64
+
+	// Restitution controls the velocity response along the collision normal
+	// (0 = bodies stop closing on each other, 1 = fully elastic bounce)
+	Restitution float64
+
+	// Disabled bodies are skipped by collision resolution (e.g. debug noclip)
+	Disabled bool
+}
+
+// DefaultStaticRestitution makes dynamic bodies bounce off static ones with
+// their velocity reflected
+const DefaultStaticRestitution = 1.0
+
+// NewCollisionComponent creates a dynamic collision body with unit mass
+func NewCollisionComponent(radius float64) *CollisionComponent {
+	return &CollisionComponent{
+		Radius:      radius,
+		Static:      false,
+		Mass:        1.0,
+		Restitution: 0.0,
+	}
+}
+
+// NewStaticCollisionComponent creates a collision body that is never moved and
+// reflects what runs into it
+func NewStaticCollisionComponent(radius float64) *CollisionComponent {
+	return &CollisionComponent{
+		Radius:      radius,
+		Static:      true,
+		Mass:        1.0,
+		Restitution: DefaultStaticRestitution,
+	}
+}
+
+// inverseMass returns 1/mass, or 0 for static bodies
+func (c *CollisionComponent) inverseMass() float64 {
+	if c.Static {
+		return 0
+	}
+	if c.Mass <= 0 {
+		return 1
+	}
+	return 1 / c.Mass
+}
+
+// SeparationConfig controls how firmly overlapping entities are pushed apart
+type SeparationConfig struct {
+	// Fraction of the overlap removed per pass (0-1). Lower values let
+	// crowds squash together and spread out softly over a few frames.
+	Strength float64
+
+	// Number of resolution passes per call. More passes settle dense packs
+	// where pushing one pair apart pushes another together.
+	Passes int
+}
+
+// DefaultSeparationConfig returns a single full-strength pass
+func DefaultSeparationConfig() SeparationConfig {
+	return SeparationConfig{
+		Strength: 1.0,
+		Passes:   1,
+	}
+}
+
+// ResolveCollisions separates overlapping entities and exchanges velocity
+// along the collision normal. Entities without an enabled collision component
+// are ignored.
+func ResolveCollisions(entities []*Entity) {
+	ResolveCollisionsWith(entities, DefaultSeparationConfig())
+}
+
+// ResolveCollisionsWith resolves collisions with a separation strength and
+// number of passes
+func ResolveCollisionsWith(entities []*Entity, config SeparationConfig) {
+	strength := min(max(config.Strength, 0), 1)
+	for range max(config.Passes, 1) {
+		for i := 0; i < len(entities); i++ {
+			a := entities[i]
+			if !a.collides() {
+				continue
+			}
+
+			for j := i + 1; j < len(entities); j++ {
+				b := entities[j]
+				if !b.collides() {
+					continue
+				}
+
+				resolvePair(a, b, strength)
+			}
+		}
+	}
+}
+
+// collides returns whether the entity takes part in collision resolution
+func (e *Entity) collides() bool {
+	return e.collision != nil && !e.collision.Disabled
+}
+
+// resolvePair resolves a single collision between two entities, removing
+// strength of the overlap
+func resolvePair(a, b *Entity, strength float64) {
+	invMassA := a.collision.inverseMass()
+	invMassB := b.collision.inverseMass()
+	totalInvMass := invMassA + invMassB
+
+	// Two static bodies never move
+	if totalInvMass == 0 {
+		return
+	}
+
+	delta := b.Position.Sub(a.Position)
+	minDist := a.collision.Radius + b.collision.Radius
+	distSq := delta.MagnitudeSquared()
+
+	if distSq >= minDist*minDist {
+		return
+	}
+
+	// Pick an arbitrary normal if the centers coincide
+	dist := math.Sqrt(distSq)
+	normal := common.Vector2{X: 1, Y: 0}
+	if dist > 0 {
+		normal = delta.Div(dist)
+	}
+
+	// Separate the bodies proportionally to their inverse mass
+	overlap := (minDist - dist) * strength
+	a.Position = a.Position.Sub(normal.Scale(overlap * invMassA / totalInvMass))
+	b.Position = b.Position.Add(normal.Scale(overlap * invMassB / totalInvMass))
+
+	// Only respond if the bodies are moving toward each other
+	relVel := b.Velocity.Sub(a.Velocity)
+	closingSpeed := relVel.Dot(normal)
+	if closingSpeed >= 0 {
+		return
+	}
+
+	// A static body's restitution decides how the dynamic one bounces off it
+	restitution := math.Min(a.collision.Restitution, b.collision.Restitution)
+	if a.collision.Static {
+		restitution = a.collision.Restitution
+	} else if b.collision.Static {
+		restitution = b.collision.Restitution
+	}
+
+	// Impulse along the normal, all of it goes to the dynamic body of a static pair
+	impulse := -(1 + restitution) * closingSpeed / totalInvMass
+	a.Velocity = a.Velocity.Sub(normal.Scale(impulse * invMassA))
+	b.Velocity = b.Velocity.Add(normal.Scale(impulse * invMassB))
+}
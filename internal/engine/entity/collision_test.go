@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+)
+
+// body creates an entity with a collision body moving at velocity
+func body(id uint64, pos, velocity common.Vector2, collision *CollisionComponent) *Entity {
+	e := NewEntity(id, pos)
+	e.Velocity = velocity
+	e.SetCollision(collision)
+	return e
+}
+
+// near returns whether two vectors are equal within a small tolerance
+func near(a, b common.Vector2) bool {
+	return math.Abs(a.X-b.X) < 1e-9 && math.Abs(a.Y-b.Y) < 1e-9
+}
+
+func TestElasticCollisionExchangesVelocity(t *testing.T) {
+	elastic := func() *CollisionComponent {
+		c := NewCollisionComponent(10)
+		c.Restitution = 1
+		return c
+	}
+	a := body(1, common.Vector2{X: 0}, common.Vector2{X: 5}, elastic())
+	b := body(2, common.Vector2{X: 15}, common.Vector2{X: -3}, elastic())
+
+	ResolveCollisions([]*Entity{a, b})
+
+	if !near(a.Velocity, common.Vector2{X: -3}) || !near(b.Velocity, common.Vector2{X: 5}) {
+		t.Errorf("velocities = %v, %v, want exchanged (-3, 0), (5, 0)", a.Velocity, b.Velocity)
+	}
+}
+
+func TestDynamicBodyReflectsOffStatic(t *testing.T) {
+	wall := body(1, common.Vector2{}, common.Vector2{}, NewStaticCollisionComponent(10))
+	ball := body(2, common.Vector2{X: 15}, common.Vector2{X: -4, Y: 2}, NewCollisionComponent(10))
+
+	ResolveCollisions([]*Entity{wall, ball})
+
+	if !near(ball.Velocity, common.Vector2{X: 4, Y: 2}) {
+		t.Errorf("ball velocity = %v, want reflected (4, 2)", ball.Velocity)
+	}
+	if wall.Position != (common.Vector2{}) || wall.Velocity != (common.Vector2{}) {
+		t.Errorf("static body moved to %v with velocity %v", wall.Position, wall.Velocity)
+	}
+	if ball.Position.X < 20 {
+		t.Errorf("ball at %v still overlaps the wall", ball.Position)
+	}
+}
+
+// overlap returns the total overlap between every pair of bodies
+func overlap(entities []*Entity) float64 {
+	total := 0.0
+	for i, a := range entities {
+		for _, b := range entities[i+1:] {
+			total += max(a.collision.Radius+b.collision.Radius-a.Position.Sub(b.Position).Magnitude(), 0)
+		}
+	}
+	return total
+}
+
+// crowd returns bodies packed closely along a line
+func crowd() []*Entity {
+	entities := make([]*Entity, 5)
+	for i := range entities {
+		entities[i] = body(uint64(i+1), common.Vector2{X: float64(i) * 12}, common.Vector2{}, NewCollisionComponent(10))
+	}
+	return entities
+}
+
+func TestSeparationStrength(t *testing.T) {
+	weak, strong := crowd(), crowd()
+	ResolveCollisionsWith(weak, SeparationConfig{Strength: 0.2, Passes: 1})
+	ResolveCollisionsWith(strong, SeparationConfig{Strength: 0.8, Passes: 1})
+
+	if overlap(strong) >= overlap(weak) {
+		t.Errorf("overlap after strong pass = %v, want less than weak pass %v", overlap(strong), overlap(weak))
+	}
+}
+
+func TestSeparationPasses(t *testing.T) {
+	before := overlap(crowd())
+
+	previous := before
+	for _, passes := range []int{1, 2, 4, 8} {
+		entities := crowd()
+		ResolveCollisionsWith(entities, SeparationConfig{Strength: 1, Passes: passes})
+
+		residual := overlap(entities)
+		if residual >= previous {
+			t.Errorf("overlap after %d passes = %v, want less than %v", passes, residual, previous)
+		}
+		previous = residual
+	}
+}
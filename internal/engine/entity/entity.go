@@ -3,6 +3,7 @@ package entity
 import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"novampires-go/internal/common"
+	"time"
 )
 
 // Entity represents a base game entity with core functionality
@@ -15,9 +16,28 @@ type Entity struct {
 	// Core identity
 	ID uint64
 
+	// Lifespan is how long the entity has left before it expires.
+	// Zero means the entity lives until it is removed explicitly.
+	Lifespan time.Duration
+
+	// OnExpire is called when the lifespan runs out, before the entity is removed
+	OnExpire func(e *Entity)
+
+	// OnDeath is called once when the manager removes the entity for
+	// running out of health, e.g. to publish a kill event
+	OnDeath func(e *Entity)
+
 	// Optional components
-	sprite *SpriteComponent
-	input  InputComponent
+	sprite    *SpriteComponent
+	input     InputComponent
+	collision *CollisionComponent
+	health    *HealthComponent
+	trail     *TrailComponent
+	watchdog  *WatchdogComponent
+	status    *StatusEffectComponent
+
+	// Set when the manager is asked to remove the entity mid-update
+	removed bool
 }
 
 // NewEntity creates a new entity with the given parameters
@@ -30,29 +50,75 @@ func NewEntity(id uint64, position common.Vector2) *Entity {
 	}
 }
 
-// Update updates the entity state
-func (e *Entity) Update() {
-	// Update position based on velocity
-	e.Position = e.Position.Add(e.Velocity)
+// Update updates the entity state by the (scaled) simulation delta
+func (e *Entity) Update(dt time.Duration) {
+	// Update position based on velocity (world units per second)
+	e.Position = e.Position.Add(e.Velocity.Scale(dt.Seconds()))
+
+	// Remember where the entity has been
+	if e.trail != nil {
+		e.trail.Record(e.Position)
+	}
+
+	// Damage over time from active status effects
+	if e.status != nil {
+		e.status.Update(e.health, dt)
+	}
 
 	// Update sprite if available
 	if e.sprite != nil {
-		e.sprite.Update(e)
+		e.sprite.Update(e, dt)
 	}
 
 	// Process input if available
 	if e.input != nil {
-		e.input.ProcessInput(e)
+		e.input.ProcessInput(e, dt)
+	}
+}
+
+// advanceLifespan counts down the lifespan and returns true once it has expired
+func (e *Entity) advanceLifespan(dt time.Duration) bool {
+	if e.Lifespan <= 0 {
+		return false
+	}
+
+	e.Lifespan -= dt
+	return e.Lifespan <= 0
+}
+
+// isDead returns whether the entity has a health component at zero
+func (e *Entity) isDead() bool {
+	return e.health != nil && e.health.IsDead()
+}
+
+// reset clears the entity so it can be reused from a pool
+func (e *Entity) reset(id uint64, position common.Vector2) {
+	*e = Entity{
+		ID:       id,
+		Position: position,
 	}
 }
 
 // Draw draws the entity
 func (e *Entity) Draw(screen *ebiten.Image, renderer Renderer) {
+	// Trail goes behind the sprite
+	if e.trail != nil {
+		e.trail.Draw(screen, renderer, e.Position)
+	}
+
 	if e.sprite != nil {
 		e.sprite.Draw(screen, renderer, e)
 	}
 }
 
+// SortKey returns the value entities are ordered by when drawn with Y-sorting
+func (e *Entity) SortKey() float64 {
+	if e.sprite != nil {
+		return e.Position.Y + e.sprite.sortOffset
+	}
+	return e.Position.Y
+}
+
 // SetSprite assigns a sprite component to the entity
 func (e *Entity) SetSprite(sprite *SpriteComponent) {
 	e.sprite = sprite
@@ -73,6 +139,65 @@ func (e *Entity) GetInput() InputComponent {
 	return e.input
 }
 
+// SetCollision assigns a collision component to the entity
+func (e *Entity) SetCollision(collision *CollisionComponent) {
+	e.collision = collision
+}
+
+// SetHealth assigns a health component to the entity
+func (e *Entity) SetHealth(health *HealthComponent) {
+	e.health = health
+}
+
+// GetHealth returns the entity's health component
+func (e *Entity) GetHealth() *HealthComponent {
+	return e.health
+}
+
+// SetTrail assigns a trail component to the entity
+func (e *Entity) SetTrail(trail *TrailComponent) {
+	e.trail = trail
+}
+
+// GetTrail returns the entity's trail component
+func (e *Entity) GetTrail() *TrailComponent {
+	return e.trail
+}
+
+// SetWatchdog opts the entity into being rescued when stuck or out of bounds
+func (e *Entity) SetWatchdog(watchdog *WatchdogComponent) {
+	e.watchdog = watchdog
+}
+
+// GetWatchdog returns the entity's watchdog, or nil if it has none
+func (e *Entity) GetWatchdog() *WatchdogComponent {
+	return e.watchdog
+}
+
+// SetStatusEffects lets the entity be affected by status effects
+func (e *Entity) SetStatusEffects(status *StatusEffectComponent) {
+	e.status = status
+}
+
+// GetStatusEffects returns the entity's status effects, or nil if it has none
+func (e *Entity) GetStatusEffects() *StatusEffectComponent {
+	return e.status
+}
+
+// ApplyEffect applies a status effect, adding a status effect component on
+// first use
+func (e *Entity) ApplyEffect(effect StatusEffect) {
+	if e.status == nil {
+		e.status = NewStatusEffectComponent()
+	}
+	e.status.ApplyEffect(effect)
+}
+
+// GetCollision returns the entity's collision component
+func (e *Entity) GetCollision() *CollisionComponent {
+	return e.collision
+}
+
 // GetPosition returns the entity position
 func (e *Entity) GetPosition() common.Vector2 {
 	return e.Position
@@ -93,6 +218,11 @@ func (e *Entity) SetVelocity(vel common.Vector2) {
 	e.Velocity = vel
 }
 
+// ApplyImpulse changes the entity's velocity instantly
+func (e *Entity) ApplyImpulse(impulse common.Vector2) {
+	e.Velocity = e.Velocity.Add(impulse)
+}
+
 // GetRotation returns the entity rotation
 func (e *Entity) GetRotation() float64 {
 	return e.Rotation
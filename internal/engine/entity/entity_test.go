@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+	"time"
+)
+
+// dtRecorder records the time steps a component is updated with
+type dtRecorder struct {
+	AnimationController
+	steps []time.Duration
+}
+
+func (r *dtRecorder) Update(dt time.Duration) {
+	r.steps = append(r.steps, dt)
+}
+
+func (r *dtRecorder) ProcessInput(entity *Entity, dt time.Duration) {
+	r.steps = append(r.steps, dt)
+}
+
+func (r *dtRecorder) GetAimDirection() common.Vector2 {
+	return common.Vector2{}
+}
+
+func TestComponentsShareUpdateDt(t *testing.T) {
+	controller := &dtRecorder{}
+	input := &dtRecorder{}
+
+	e := NewEntity(1, common.Vector2{})
+	e.Velocity = common.Vector2{X: 100}
+	sprite := NewSpriteComponent()
+	sprite.SetSecondarySpriteSheet(nil, controller)
+	e.SetSprite(sprite)
+	e.SetInput(input)
+
+	steps := []time.Duration{16 * time.Millisecond, 33 * time.Millisecond, 0, 250 * time.Millisecond}
+	for _, dt := range steps {
+		e.Update(dt)
+	}
+
+	for name, got := range map[string][]time.Duration{"animation controller": controller.steps, "input": input.steps} {
+		if len(got) != len(steps) {
+			t.Fatalf("%s updated %d times, want %d", name, len(got), len(steps))
+		}
+		for i := range steps {
+			if got[i] != steps[i] {
+				t.Errorf("%s update %d got %v, want %v", name, i, got[i], steps[i])
+			}
+		}
+	}
+
+	// Movement uses the same steps: 299ms at 100 units per second
+	if got, want := e.Position.X, 29.9; math.Abs(got-want) > 1e-9 {
+		t.Errorf("moved to x = %v, want %v", got, want)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"image"
 	"math"
-	"math/rand"
 	"novampires-go/internal/common"
 	"novampires-go/internal/engine/sprite"
 	"time"
@@ -40,30 +39,58 @@ type EyeController struct {
 	// Current direction
 	direction Direction
 
-	// Should flip horizontally (for left directions)
+	// Whether the eye layer is mirrored along with the body
 	flipX bool
 
+	// Whether the eyes look toward the left side of the screen
+	lookLeft bool
+
 	// Is currently blinking
 	isBlinking bool
 
-	// Blink timer
-	blinkTimer    int
-	blinkInterval int
+	// Schedules the next blink
+	blinkTrigger *sprite.IntervalTrigger
+
+	// Blinks requested while already blinking, played back to back
+	queuedBlinks int
 
 	// Position relative to character center
 	position common.Vector2
 }
 
-// NewEyeController creates a new eye controller
-func NewEyeController() *EyeController {
-	return &EyeController{
-		isBlinking:    false,
-		blinkTimer:    0,
-		blinkInterval: 180 + rand.Intn(120), // 3-5 seconds
-		position:      common.Vector2{X: 0, Y: 0},
-		direction:     LookingCenter,
-		flipX:         false,
+// Default delay between automatic blinks
+const (
+	defaultBlinkIntervalMin    = 3 * time.Second
+	defaultBlinkIntervalSpread = 2 * time.Second
+)
+
+// NewEyeController creates a new eye controller that uses rng for blink timing
+func NewEyeController(rng *common.Rng) *EyeController {
+	c := &EyeController{
+		isBlinking: false,
+		position:   common.Vector2{X: 0, Y: 0},
+		direction:  LookingCenter,
+		flipX:      false,
 	}
+	c.blinkTrigger = sprite.NewIntervalTrigger(defaultBlinkIntervalMin, defaultBlinkIntervalSpread, rng, c.TriggerBlink)
+	return c
+}
+
+// SetBlinkInterval sets the automatic blink delay to min plus up to spread
+func (c *EyeController) SetBlinkInterval(min, spread time.Duration) {
+	c.blinkTrigger.Min = min
+	c.blinkTrigger.Spread = spread
+	c.blinkTrigger.Reset()
+}
+
+// GetBlinkInterval returns the automatic blink delay range
+func (c *EyeController) GetBlinkInterval() (min, spread time.Duration) {
+	return c.blinkTrigger.Min, c.blinkTrigger.Spread
+}
+
+// SetRng replaces the random source used for blink timing
+func (c *EyeController) SetRng(rng *common.Rng) {
+	c.blinkTrigger.SetRng(rng)
 }
 
 // SetSpriteSheet sets the eye sprite sheet
@@ -80,54 +107,54 @@ func (c *EyeController) SetSpriteSheet(sheet *ebiten.Image) {
 	// Frame order: Center (3), Right (3), Left (3), Up (3), Down (3), UpRight (3), DownRight (3), UpLeft (3), DownLeft (3)
 	c.allFrames = []sprite.FrameData{
 		// Center (0, 1, 2)
-		{0, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 2, 0, frameWidth, frameWidth, frameDuration},
+		{SrcX: 0, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 2, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
 
 		// Right (3, 4, 5)
-		{frameWidth * 3, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 4, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 5, 0, frameWidth, frameWidth, frameDuration},
+		{SrcX: frameWidth * 3, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 4, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 5, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
 
 		// Left (6, 7, 8)
-		{frameWidth * 6, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 7, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 8, 0, frameWidth, frameWidth, frameDuration},
+		{SrcX: frameWidth * 6, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 7, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 8, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
 
 		// Up (9, 10, 11)
-		{frameWidth * 9, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 10, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 11, 0, frameWidth, frameWidth, frameDuration},
+		{SrcX: frameWidth * 9, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 10, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 11, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
 
 		// Down (12, 13, 14)
-		{frameWidth * 12, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 13, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 14, 0, frameWidth, frameWidth, frameDuration},
+		{SrcX: frameWidth * 12, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 13, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 14, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
 
 		// UpRight (15, 16, 17)
-		{frameWidth * 15, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 16, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 17, 0, frameWidth, frameWidth, frameDuration},
+		{SrcX: frameWidth * 15, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 16, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 17, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
 
 		// DownRight (18, 19, 20)
-		{frameWidth * 18, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 19, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 20, 0, frameWidth, frameWidth, frameDuration},
+		{SrcX: frameWidth * 18, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 19, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 20, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
 
 		// UpLeft (21, 22, 23)
-		{frameWidth * 21, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 22, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 23, 0, frameWidth, frameWidth, frameDuration},
+		{SrcX: frameWidth * 21, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 22, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 23, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
 
 		// DownLeft (24, 25, 26)
-		{frameWidth * 24, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 25, 0, frameWidth, frameWidth, frameDuration},
-		{frameWidth * 26, 0, frameWidth, frameWidth, frameDuration},
+		{SrcX: frameWidth * 24, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 25, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
+		{SrcX: frameWidth * 26, SrcY: 0, SrcWidth: frameWidth, SrcHeight: frameWidth, Duration: frameDuration},
 	}
 
 	// Create initial blink animation for center eyes
 	blinkSequence := []int{0, 1, 2, 1}
-	c.animation = sprite.NewAnimationWithSequence(c.allFrames[:3], blinkSequence, false)
+	c.animation = sprite.MustAnimation(sprite.NewAnimationWithSequence(c.allFrames[:3], blinkSequence, false))
 
 	// Initialize with the first frame
 	frame := c.allFrames[0]
@@ -157,56 +184,50 @@ func (c *EyeController) updateBlinking(dt time.Duration) {
 
 		if c.animation.IsFinished() {
 			c.isBlinking = false
-			c.blinkTimer = 0
-			c.blinkInterval = 180 + rand.Intn(120) // 3-5 seconds
+
+			// Play queued blinks back to back before resuming the interval
+			if c.queuedBlinks > 0 {
+				c.queuedBlinks--
+				c.TriggerBlink()
+				return
+			}
+			c.blinkTrigger.Reset()
 		}
 		return
 	}
 
-	c.blinkTimer++
+	c.blinkTrigger.Update(dt)
+}
 
-	if c.blinkTimer >= c.blinkInterval {
-		c.isBlinking = true
+// directionStartIndex returns the first frame of the current look direction.
+// The renderer mirrors the eye layer together with the body, so when the body
+// is flipped the look direction is mirrored too to keep the eyes pointing
+// where the player aims.
+func (c *EyeController) directionStartIndex() int {
+	left := c.lookLeft != c.flipX
 
-		// Create a new animation for the current eye direction
-		var startIdx int
-		switch c.direction {
-		case LookingCenter:
-			startIdx = 0
-		case LookingRight:
-			if c.flipX {
-				// Use Left frames (6-8)
-				startIdx = 6
-			} else {
-				// Use Right frames (3-5)
-				startIdx = 3
-			}
-		case LookingUp:
-			startIdx = 9
-		case LookingDown:
-			startIdx = 12
-		case LookingUpRight:
-			if c.flipX {
-				// Use UpLeft frames (21-23)
-				startIdx = 21
-			} else {
-				// Use UpRight frames (15-17)
-				startIdx = 15
-			}
-		case LookingDownRight:
-			if c.flipX {
-				// Use DownLeft frames (24-26)
-				startIdx = 24
-			} else {
-				// Use DownRight frames (18-20)
-				startIdx = 18
-			}
+	switch c.direction {
+	case LookingRight:
+		if left {
+			return 6 // Left
 		}
-
-		blinkSequence := []int{0, 1, 2, 1}
-		directionFrames := c.allFrames[startIdx : startIdx+3]
-		c.animation = sprite.NewAnimationWithSequence(directionFrames, blinkSequence, false)
-		c.animation.Reset()
+		return 3 // Right
+	case LookingUp:
+		return 9
+	case LookingDown:
+		return 12
+	case LookingUpRight:
+		if left {
+			return 21 // UpLeft
+		}
+		return 15 // UpRight
+	case LookingDownRight:
+		if left {
+			return 24 // DownLeft
+		}
+		return 18 // DownRight
+	default:
+		return 0 // Center
 	}
 }
 
@@ -226,39 +247,7 @@ func (c *EyeController) updateSprite() {
 		c.currentSprite = c.spriteSheet.SubImage(rect).(*ebiten.Image)
 	} else {
 		// When not blinking, use the first frame for current direction
-		var frameIdx int
-		switch c.direction {
-		case LookingCenter:
-			frameIdx = 0
-		case LookingRight:
-			if c.flipX {
-				// Use Left frames
-				frameIdx = 6
-			} else {
-				// Use Right frames
-				frameIdx = 3
-			}
-		case LookingUp:
-			frameIdx = 9
-		case LookingDown:
-			frameIdx = 12
-		case LookingUpRight:
-			if c.flipX {
-				// Use UpLeft frames
-				frameIdx = 21
-			} else {
-				// Use UpRight frames
-				frameIdx = 15
-			}
-		case LookingDownRight:
-			if c.flipX {
-				// Use DownLeft frames
-				frameIdx = 24
-			} else {
-				// Use DownRight frames
-				frameIdx = 18
-			}
-		}
+		frameIdx := c.directionStartIndex()
 
 		frame := c.allFrames[frameIdx]
 		rect := image.Rect(
@@ -279,16 +268,16 @@ func (c *EyeController) UpdateLookDirection(aimDirection common.Vector2) {
 	}
 
 	oldDirection := c.direction
-	oldFlipX := c.flipX
+	oldLookLeft := c.lookLeft
 
 	// Skip if vector is too small
 	magnitude := aimDirection.Magnitude()
 	if magnitude < 0.3 {
 		c.direction = LookingCenter
-		c.flipX = false
+		c.lookLeft = false
 
 		// Only update sprite if direction changed
-		if oldDirection != c.direction || oldFlipX != c.flipX {
+		if oldDirection != c.direction || oldLookLeft != c.lookLeft {
 			c.updateSprite()
 		}
 		return
@@ -300,8 +289,8 @@ func (c *EyeController) UpdateLookDirection(aimDirection common.Vector2) {
 		angle += 2 * math.Pi
 	}
 
-	// Default to not flipping
-	c.flipX = false
+	// Default to looking right
+	c.lookLeft = false
 
 	// Determine direction based on angle
 	if angle >= 7*math.Pi/4 || angle < math.Pi/4 {
@@ -311,9 +300,9 @@ func (c *EyeController) UpdateLookDirection(aimDirection common.Vector2) {
 		// Down
 		c.direction = LookingDown
 	} else if angle >= 3*math.Pi/4 && angle < 5*math.Pi/4 {
-		// Left (flip right)
+		// Left
 		c.direction = LookingRight
-		c.flipX = true
+		c.lookLeft = true
 	} else if angle >= 5*math.Pi/4 && angle < 7*math.Pi/4 {
 		// Up
 		c.direction = LookingUp
@@ -324,20 +313,20 @@ func (c *EyeController) UpdateLookDirection(aimDirection common.Vector2) {
 		// Down-Right
 		c.direction = LookingDownRight
 	} else if angle >= 5*math.Pi/8 && angle < 7*math.Pi/8 {
-		// Down-Left (flip Down-Right)
+		// Down-Left
 		c.direction = LookingDownRight
-		c.flipX = true
+		c.lookLeft = true
 	} else if angle >= 9*math.Pi/8 && angle < 11*math.Pi/8 {
-		// Up-Left (flip Up-Right)
+		// Up-Left
 		c.direction = LookingUpRight
-		c.flipX = true
+		c.lookLeft = true
 	} else if angle >= 13*math.Pi/8 && angle < 15*math.Pi/8 {
 		// Up-Right
 		c.direction = LookingUpRight
 	}
 
 	// Only update sprite if direction changed
-	if oldDirection != c.direction || oldFlipX != c.flipX {
+	if oldDirection != c.direction || oldLookLeft != c.lookLeft {
 		c.updateSprite()
 	}
 }
@@ -373,7 +362,11 @@ func (c *EyeController) GetFlipX() bool {
 
 // SetFlipX sets whether the sprite should be flipped horizontally
 func (c *EyeController) SetFlipX(flip bool) {
+	if c.flipX == flip {
+		return
+	}
 	c.flipX = flip
+	c.updateSprite()
 }
 
 // SetPosition sets the eye position relative to character center
@@ -381,43 +374,33 @@ func (c *EyeController) SetPosition(pos common.Vector2) {
 	c.position = pos
 }
 
+// QueueBlinks triggers count blinks in a row, e.g. 2 for a double-blink.
+// If a blink is already playing the new ones follow it.
+func (c *EyeController) QueueBlinks(count int) {
+	if count <= 0 {
+		return
+	}
+	if !c.isBlinking {
+		c.TriggerBlink()
+		count--
+	}
+	c.queuedBlinks += count
+}
+
 // TriggerBlink manually triggers a blink animation
 func (c *EyeController) TriggerBlink() {
+	if c.allFrames == nil {
+		return
+	}
 	if !c.isBlinking {
 		c.isBlinking = true
 
 		// Create a new animation for the current eye direction
-		var startIdx int
-		switch c.direction {
-		case LookingCenter:
-			startIdx = 0
-		case LookingRight:
-			if c.flipX {
-				startIdx = 6 // Left
-			} else {
-				startIdx = 3 // Right
-			}
-		case LookingUp:
-			startIdx = 9
-		case LookingDown:
-			startIdx = 12
-		case LookingUpRight:
-			if c.flipX {
-				startIdx = 21 // UpLeft
-			} else {
-				startIdx = 15 // UpRight
-			}
-		case LookingDownRight:
-			if c.flipX {
-				startIdx = 24 // DownLeft
-			} else {
-				startIdx = 18 // DownRight
-			}
-		}
+		startIdx := c.directionStartIndex()
 
 		blinkSequence := []int{0, 1, 2, 1}
 		directionFrames := c.allFrames[startIdx : startIdx+3]
-		c.animation = sprite.NewAnimationWithSequence(directionFrames, blinkSequence, false)
+		c.animation = sprite.MustAnimation(sprite.NewAnimationWithSequence(directionFrames, blinkSequence, false))
 		c.animation.Reset()
 	}
 }
@@ -428,7 +411,7 @@ func (c *EyeController) GetCurrentAnimation() string {
 	case LookingCenter:
 		return "center"
 	case LookingRight:
-		if c.flipX {
+		if c.lookLeft {
 			return "left"
 		}
 		return "right"
@@ -437,12 +420,12 @@ func (c *EyeController) GetCurrentAnimation() string {
 	case LookingDown:
 		return "down"
 	case LookingUpRight:
-		if c.flipX {
+		if c.lookLeft {
 			return "upleft"
 		}
 		return "upright"
 	case LookingDownRight:
-		if c.flipX {
+		if c.lookLeft {
 			return "downleft"
 		}
 		return "downright"
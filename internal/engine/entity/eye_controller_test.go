@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"novampires-go/internal/common"
+	"testing"
+	"time"
+)
+
+// eyeFrameSize is the width and height of one eye frame in the sheet
+const eyeFrameSize = 96
+
+// newEyes creates an eye controller with a blank sheet holding every frame
+func newEyes() *EyeController {
+	c := NewEyeController(common.NewRng(1))
+	c.SetSpriteSheet(ebiten.NewImage(eyeFrameSize*27, eyeFrameSize))
+	return c
+}
+
+// eyeFrame returns the index in the sheet of the eye sprite being shown
+func eyeFrame(c *EyeController) int {
+	return c.GetSprite().Bounds().Min.X / eyeFrameSize
+}
+
+func TestLookDirectionFrames(t *testing.T) {
+	tests := []struct {
+		name     string
+		aim      common.Vector2
+		flipX    bool
+		want     int
+		wantAnim string
+	}{
+		{"center", common.Vector2{}, false, 0, "center"},
+		{"right", common.Vector2{X: 1}, false, 3, "right"},
+		{"left", common.Vector2{X: -1}, false, 6, "left"},
+		{"up", common.Vector2{Y: -1}, false, 9, "up"},
+		{"down", common.Vector2{Y: 1}, false, 12, "down"},
+		{"up right", common.Vector2{X: 1, Y: -1}, false, 15, "upright"},
+		{"down right", common.Vector2{X: 1, Y: 1}, false, 18, "downright"},
+		{"up left", common.Vector2{X: -1, Y: -1}, false, 21, "upleft"},
+		{"down left", common.Vector2{X: -1, Y: 1}, false, 24, "downleft"},
+
+		// A flipped layer is mirrored when drawn, so left looks use right frames
+		{"left flipped", common.Vector2{X: -1}, true, 3, "left"},
+		{"up left flipped", common.Vector2{X: -1, Y: -1}, true, 15, "upleft"},
+		{"down left flipped", common.Vector2{X: -1, Y: 1}, true, 18, "downleft"},
+		{"right flipped", common.Vector2{X: 1}, true, 6, "right"},
+		{"up flipped", common.Vector2{Y: -1}, true, 9, "up"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newEyes()
+			c.SetFlipX(tt.flipX)
+			c.UpdateLookDirection(tt.aim)
+			c.Update(0)
+
+			if got := eyeFrame(c); got != tt.want {
+				t.Errorf("frame = %d, want %d", got, tt.want)
+			}
+			if got := c.GetCurrentAnimation(); got != tt.wantAnim {
+				t.Errorf("animation = %q, want %q", got, tt.wantAnim)
+			}
+			if got := c.GetFlipX(); got != tt.flipX {
+				t.Errorf("flipX = %v, want %v", got, tt.flipX)
+			}
+		})
+	}
+}
+
+// blinkDuration is how long one blink plays: four frames of 100ms
+const blinkDuration = 400 * time.Millisecond
+
+// runEyes updates c in 10ms steps for d and reports when it was blinking
+func runEyes(c *EyeController, d time.Duration) []time.Duration {
+	const step = 10 * time.Millisecond
+	var blinking []time.Duration
+	for elapsed := step; elapsed <= d; elapsed += step {
+		c.Update(step)
+		if c.isBlinking {
+			blinking = append(blinking, elapsed)
+		}
+	}
+	return blinking
+}
+
+func TestCustomBlinkInterval(t *testing.T) {
+	c := newEyes()
+	c.SetBlinkInterval(500*time.Millisecond, 0)
+
+	if min, spread := c.GetBlinkInterval(); min != 500*time.Millisecond || spread != 0 {
+		t.Fatalf("interval = %v + %v, want 500ms + 0s", min, spread)
+	}
+
+	// The default interval would not blink within the first second
+	blinking := runEyes(c, time.Second)
+	if len(blinking) == 0 {
+		t.Fatal("never blinked")
+	}
+	if got, want := blinking[0], 500*time.Millisecond; got != want {
+		t.Errorf("first blink shown at %v, want %v", got, want)
+	}
+}
+
+func TestQueuedBlinkFollowsCurrent(t *testing.T) {
+	c := newEyes()
+	c.SetBlinkInterval(time.Hour, 0)
+
+	c.TriggerBlink()
+	c.QueueBlinks(1)
+	if c.queuedBlinks != 1 {
+		t.Fatalf("queued %d blinks during a blink, want 1", c.queuedBlinks)
+	}
+
+	// The queued blink starts as the first ends, so the eyes blink throughout
+	blinking := runEyes(c, 2*blinkDuration-10*time.Millisecond)
+	if got, want := len(blinking), int(2*blinkDuration/(10*time.Millisecond))-1; got != want {
+		t.Errorf("blinking for %d steps, want %d", got, want)
+	}
+	if c.queuedBlinks != 0 {
+		t.Errorf("%d blinks still queued", c.queuedBlinks)
+	}
+
+	runEyes(c, 20*time.Millisecond)
+	if c.isBlinking {
+		t.Error("still blinking after both blinks")
+	}
+}
+
+func TestQueueBlinksStartsImmediately(t *testing.T) {
+	c := newEyes()
+	c.QueueBlinks(2)
+	if !c.isBlinking || c.queuedBlinks != 1 {
+		t.Errorf("blinking = %v with %d queued, want a blink playing and 1 queued", c.isBlinking, c.queuedBlinks)
+	}
+}
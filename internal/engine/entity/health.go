@@ -0,0 +1,115 @@
+package entity
+
+// DamageType determines how damage interacts with armor
+type DamageType int
+
+const (
+	// DamagePhysical is reduced by armor
+	DamagePhysical DamageType = iota
+	// DamageTrue ignores armor
+	DamageTrue
+)
+
+func (t DamageType) String() string {
+	switch t {
+	case DamagePhysical:
+		return "Physical"
+	case DamageTrue:
+		return "True"
+	default:
+		return "Unknown"
+	}
+}
+
+// HealthComponent tracks an entity's hit points and damage mitigation
+type HealthComponent struct {
+	current int
+	max     int
+
+	// Flat reduction applied to physical damage
+	Armor int
+
+	// Smallest amount a non-zero hit can be reduced to by armor
+	MinDamage int
+
+	// Multiplier applied to incoming damage before armor (e.g. difficulty)
+	DamageScale float64
+}
+
+// NewHealthComponent creates a health component at full health
+func NewHealthComponent(maxHealth int) *HealthComponent {
+	return &HealthComponent{
+		current:     maxHealth,
+		max:         maxHealth,
+		Armor:       0,
+		MinDamage:   1,
+		DamageScale: 1.0,
+	}
+}
+
+// EffectiveDamage returns how much health a hit would remove after armor
+func (h *HealthComponent) EffectiveDamage(amount int, dtype DamageType) int {
+	if amount <= 0 {
+		return 0
+	}
+	if h.DamageScale > 0 && h.DamageScale != 1 {
+		amount = max(0, int(float64(amount)*h.DamageScale+0.5))
+	}
+	if dtype == DamageTrue {
+		return amount
+	}
+
+	// Armor can soften a hit but never fully negate it
+	return max(amount-h.Armor, min(h.MinDamage, amount))
+}
+
+// TakeDamage applies a hit and returns the health actually removed
+func (h *HealthComponent) TakeDamage(amount int, dtype DamageType) int {
+	damage := min(h.EffectiveDamage(amount, dtype), h.current)
+	h.current -= damage
+	return damage
+}
+
+// Heal restores health up to the maximum and returns the amount restored
+func (h *HealthComponent) Heal(amount int) int {
+	if amount <= 0 || h.IsDead() {
+		return 0
+	}
+	healed := min(amount, h.max-h.current)
+	h.current += healed
+	return healed
+}
+
+// IsDead returns whether health has reached zero
+func (h *HealthComponent) IsDead() bool {
+	return h.current <= 0
+}
+
+// GetCurrent returns the current health
+func (h *HealthComponent) GetCurrent() int {
+	return h.current
+}
+
+// SetCurrent sets the current health, clamped to [0, max]
+func (h *HealthComponent) SetCurrent(health int) {
+	h.current = min(max(health, 0), h.max)
+}
+
+// GetMax returns the maximum health
+func (h *HealthComponent) GetMax() int {
+	return h.max
+}
+
+// SetMax changes the maximum health, clamping current health to it
+func (h *HealthComponent) SetMax(maxHealth int) {
+	h.max = maxHealth
+	h.current = min(h.current, maxHealth)
+}
+
+// Percent returns current health as a fraction of the maximum
+func (h *HealthComponent) Percent() float64 {
+	if h.max <= 0 {
+		return 0
+	}
+	return float64(h.current) / float64(h.max)
+}
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/rendering"
+)
+
+// HealthBarStyle controls where and when health bars are drawn above entities
+type HealthBarStyle struct {
+	Height       float64 // Bar height in world units
+	Offset       float64 // Gap between the top of the entity and the bar
+	MinWidth     float64 // Width used for entities smaller than this
+	HideWhenFull bool    // Skip the bar for undamaged entities
+
+	// Border and rounding, the zero value draws a plain bar
+	Frame rendering.HealthBarFrame
+}
+
+// DefaultHealthBarStyle returns the default health bar style
+func DefaultHealthBarStyle() HealthBarStyle {
+	return HealthBarStyle{
+		Height:       4.0,
+		Offset:       6.0,
+		MinWidth:     20.0,
+		HideWhenFull: false,
+	}
+}
+
+// HealthBarRect returns the world space rectangle of a health bar centered
+// above a circle of the given radius
+func HealthBarRect(center common.Vector2, radius float64, style HealthBarStyle) common.Rectangle {
+	width := max(radius*2, style.MinWidth)
+	return common.Rectangle{
+		Pos: common.Vector2{
+			X: center.X - width/2,
+			Y: center.Y - radius - style.Offset - style.Height,
+		},
+		Size: common.Vector2{X: width, Y: style.Height},
+	}
+}
+
+// DrawEntityHealthBar draws an entity's health above it. The entity is sized
+// by its collision radius. Entities without a health component are skipped.
+func DrawEntityHealthBar(screen *ebiten.Image, e *Entity, renderer Renderer, style HealthBarStyle) {
+	var radius float64
+	if e.collision != nil {
+		radius = e.collision.Radius
+	}
+	DrawHealthBarAbove(screen, e, radius, renderer, style)
+}
+
+// DrawHealthBarAbove draws an entity's health above a circle of the given
+// radius, for entities that aren't sized by a collision component
+func DrawHealthBarAbove(screen *ebiten.Image, e *Entity, radius float64, renderer Renderer, style HealthBarStyle) {
+	health := e.GetHealth()
+	if health == nil {
+		return
+	}
+
+	percent := health.Percent()
+	if style.HideWhenFull && percent >= 1 {
+		return
+	}
+
+	// Bars are UI, keep their edges crisp whatever the world uses
+	rect := HealthBarRect(e.Position, radius, style)
+	renderer.WithAntiAlias(rendering.AntiAliasOff, func() {
+		renderer.DrawFramedHealthBar(screen, rect.Pos, rect.Size.X, rect.Size.Y, percent, style.Frame)
+	})
+}
@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/rendering"
+	"testing"
+)
+
+func TestHealthBarRect(t *testing.T) {
+	style := HealthBarStyle{Height: 4, Offset: 6, MinWidth: 20}
+
+	tests := []struct {
+		name   string
+		center common.Vector2
+		radius float64
+		want   common.Rectangle
+	}{
+		{
+			name:   "wider than min width",
+			center: common.Vector2{X: 100, Y: 200},
+			radius: 18,
+			want: common.Rectangle{
+				Pos:  common.Vector2{X: 82, Y: 172},
+				Size: common.Vector2{X: 36, Y: 4},
+			},
+		},
+		{
+			name:   "narrower than min width",
+			center: common.Vector2{X: 100, Y: 200},
+			radius: 5,
+			want: common.Rectangle{
+				Pos:  common.Vector2{X: 90, Y: 185},
+				Size: common.Vector2{X: 20, Y: 4},
+			},
+		},
+		{
+			name:   "zero radius",
+			center: common.Vector2{X: -10, Y: 0},
+			radius: 0,
+			want: common.Rectangle{
+				Pos:  common.Vector2{X: -20, Y: -10},
+				Size: common.Vector2{X: 20, Y: 4},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HealthBarRect(tt.center, tt.radius, style); got != tt.want {
+				t.Errorf("HealthBarRect() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// barRenderer records the health bars drawn
+type barRenderer struct {
+	Renderer
+	positions []common.Vector2
+	widths    []float64
+}
+
+func (r *barRenderer) DrawFramedHealthBar(screen *ebiten.Image, position common.Vector2, width, height float64, percent float64, frame rendering.HealthBarFrame) {
+	r.positions = append(r.positions, position)
+	r.widths = append(r.widths, width)
+}
+
+func (r *barRenderer) WithAntiAlias(aa rendering.AntiAlias, draw func()) {
+	draw()
+}
+
+func TestDrawEntityHealthBarUsesCollisionRadius(t *testing.T) {
+	e := NewEntity(1, common.Vector2{X: 50, Y: 80})
+	e.SetHealth(NewHealthComponent(100))
+	e.SetCollision(NewCollisionComponent(15))
+
+	style := DefaultHealthBarStyle()
+	renderer := &barRenderer{}
+	DrawEntityHealthBar(nil, e, renderer, style)
+
+	want := HealthBarRect(e.Position, 15, style)
+	if len(renderer.positions) != 1 {
+		t.Fatalf("drew %d bars, want 1", len(renderer.positions))
+	}
+	if renderer.positions[0] != want.Pos || renderer.widths[0] != want.Size.X {
+		t.Errorf("bar at %v width %v, want %v width %v",
+			renderer.positions[0], renderer.widths[0], want.Pos, want.Size.X)
+	}
+}
+
+func TestDrawHealthBarAboveSkips(t *testing.T) {
+	full := NewEntity(1, common.Vector2{})
+	full.SetHealth(NewHealthComponent(100))
+
+	damaged := NewEntity(2, common.Vector2{})
+	damaged.SetHealth(NewHealthComponent(100))
+	damaged.GetHealth().SetCurrent(50)
+
+	tests := []struct {
+		name         string
+		entity       *Entity
+		hideWhenFull bool
+		wantDrawn    bool
+	}{
+		{"no health", NewEntity(3, common.Vector2{}), false, false},
+		{"full shown", full, false, true},
+		{"full hidden", full, true, false},
+		{"damaged with hide when full", damaged, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			style := DefaultHealthBarStyle()
+			style.HideWhenFull = tt.hideWhenFull
+
+			renderer := &barRenderer{}
+			DrawHealthBarAbove(nil, tt.entity, 10, renderer, style)
+			if drawn := len(renderer.positions) > 0; drawn != tt.wantDrawn {
+				t.Errorf("drawn = %v, want %v", drawn, tt.wantDrawn)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package entity
+
+import "testing"
+
+func TestTakeDamage(t *testing.T) {
+	tests := []struct {
+		name       string
+		armor      int
+		minDamage  int
+		amount     int
+		dtype      DamageType
+		wantDamage int
+	}{
+		{"no armor", 0, 1, 12, DamagePhysical, 12},
+		{"armor reduces physical", 5, 1, 12, DamagePhysical, 7},
+		{"armor floors at min damage", 20, 1, 12, DamagePhysical, 1},
+		{"raised min damage floor", 20, 3, 12, DamagePhysical, 3},
+		{"floor never exceeds the hit", 20, 3, 2, DamagePhysical, 2},
+		{"true damage bypasses armor", 20, 1, 12, DamageTrue, 12},
+		{"zero damage stays zero", 5, 1, 0, DamagePhysical, 0},
+		{"clamped to remaining health", 0, 1, 150, DamageTrue, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHealthComponent(100)
+			h.Armor = tt.armor
+			h.MinDamage = tt.minDamage
+
+			if got := h.TakeDamage(tt.amount, tt.dtype); got != tt.wantDamage {
+				t.Errorf("TakeDamage() = %d, want %d", got, tt.wantDamage)
+			}
+			if got, want := h.GetCurrent(), 100-tt.wantDamage; got != want {
+				t.Errorf("health = %d, want %d", got, want)
+			}
+		})
+	}
+}
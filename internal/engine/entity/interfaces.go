@@ -4,23 +4,39 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"image/color"
 	"novampires-go/internal/common"
+	"novampires-go/internal/engine/rendering"
 	"time"
 )
 
 // Renderer defines an interface for rendering operations
 type Renderer interface {
-	DrawSprite(screen *ebiten.Image, sprite *ebiten.Image, position common.Vector2, rotation float64, scale float64, flipX bool)
-	DrawLayeredSprite(screen *ebiten.Image, baseSprite, overlaySprite *ebiten.Image, position, overlayOffset common.Vector2, rotation, scale float64, flipX bool)
+	DrawSprite(screen *ebiten.Image, sprite *ebiten.Image, position common.Vector2, rotation float64, scale float64, stretch common.Vector2, origin common.Vector2, flipX bool)
+	DrawLayeredSprite(screen *ebiten.Image, baseSprite, overlaySprite *ebiten.Image, position, overlayOffset common.Vector2, rotation, scale float64, stretch common.Vector2, origin common.Vector2, flipX bool)
 	DrawAimLine(screen *ebiten.Image, start common.Vector2, direction common.Vector2, length float64)
+	DrawReticle(screen *ebiten.Image, worldPos common.Vector2, style rendering.ReticleStyle)
 	DrawCircle(screen *ebiten.Image, position common.Vector2, radius float64, fill color.RGBA)
+	DrawCircleBlend(screen *ebiten.Image, position common.Vector2, radius float64, fill color.RGBA, mode rendering.BlendMode)
+	DrawArc(screen *ebiten.Image, center common.Vector2, radius, startAngle, endAngle, lineWidth float64, stroke color.RGBA)
+	DrawSector(screen *ebiten.Image, center common.Vector2, radius, startAngle, endAngle float64, fill color.RGBA)
 	DrawLine(screen *ebiten.Image, start, end common.Vector2, lineWidth float64, stroke color.RGBA)
+	DrawLineBlend(screen *ebiten.Image, start, end common.Vector2, lineWidth float64, stroke color.RGBA, mode rendering.BlendMode)
+	DrawRect(screen *ebiten.Image, rect common.Rectangle, fill color.RGBA)
+	DrawRectOutline(screen *ebiten.Image, rect common.Rectangle, lineWidth float64, stroke color.RGBA)
 	DrawHealthBar(screen *ebiten.Image, position common.Vector2, width, height float64, percent float64)
+	DrawFramedHealthBar(screen *ebiten.Image, position common.Vector2, width, height float64, percent float64, frame rendering.HealthBarFrame)
+	DrawWorldText(screen *ebiten.Image, text string, position common.Vector2, scale float64, fill color.RGBA, outline bool)
+	DrawWorldLabel(screen *ebiten.Image, text string, worldPos common.Vector2, col color.RGBA)
+	DrawHUDText(screen *ebiten.Image, text string, pos common.Vector2, col color.RGBA)
+	DrawHUDTextScaled(screen *ebiten.Image, text string, pos common.Vector2, scale float64, col color.RGBA)
+	DrawCooldownRing(screen *ebiten.Image, center common.Vector2, radius float64, progress float64)
 	DrawGrid(screen *ebiten.Image)
+	WithAntiAlias(aa rendering.AntiAlias, draw func())
+	WithFont(font rendering.Font, draw func())
 }
 
 // InputComponent defines an interface for processing input
 type InputComponent interface {
-	ProcessInput(entity *Entity)
+	ProcessInput(entity *Entity, dt time.Duration)
 	GetAimDirection() common.Vector2
 }
 
@@ -43,7 +59,7 @@ type TargetProvider interface {
 
 // Updatable defines an interface for objects that can be updated
 type Updatable interface {
-	Update()
+	Update(dt time.Duration)
 }
 
 // Drawable defines an interface for objects that can be drawn
@@ -0,0 +1,316 @@
+package entity
+
+import (
+	"cmp"
+	"github.com/hajimehoshi/ebiten/v2"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/events"
+	"novampires-go/internal/engine/spatial"
+	"slices"
+	"time"
+)
+
+// maxPoolSize caps how many removed entities are kept around for reuse
+const maxPoolSize = 256
+
+// Manager owns a set of entities, updating and drawing them and
+// recycling the ones that are removed
+type Manager struct {
+	entities []*Entity
+
+	// Entities added during Update, merged in once the update pass is done
+	pending  []*Entity
+	updating bool
+
+	// Entities removed during Update, dropped once the update pass is done
+	deferred int
+
+	// Removed entities ready for reuse
+	pool []*Entity
+
+	nextID uint64
+
+	// Remove entities that ran out of health at the end of each update
+	removeDead bool
+
+	// Optional bus EnemyKilled is published on when dead entities are removed
+	events *events.Bus
+
+	// Draw entities lower on screen in front of those above them
+	ySort     bool
+	drawOrder []*Entity
+
+	// Spatial index of targets for QueryTargets, rebuilt after each update
+	// and whenever entities are added or removed
+	grid          *spatial.Grid
+	gridEntities  []*Entity
+	gridMaxRadius float64
+	gridDirty     bool
+	nearby        []spatial.Entry
+	found         []*Entity
+}
+
+// NewManager creates an empty entity manager
+func NewManager() *Manager {
+	return &Manager{
+		entities:   make([]*Entity, 0, 64),
+		removeDead: true,
+		grid:       spatial.NewGrid(targetGridCellSize),
+	}
+}
+
+// Spawn creates a managed entity at position, reusing a pooled one if available
+func (m *Manager) Spawn(position common.Vector2) *Entity {
+	m.nextID++
+
+	var e *Entity
+	if n := len(m.pool); n > 0 {
+		e = m.pool[n-1]
+		m.pool[n-1] = nil
+		m.pool = m.pool[:n-1]
+		e.reset(m.nextID, position)
+	} else {
+		e = NewEntity(m.nextID, position)
+	}
+
+	m.Add(e)
+	return e
+}
+
+// Add starts managing an existing entity
+func (m *Manager) Add(e *Entity) {
+	m.gridDirty = true
+	if m.updating {
+		m.pending = append(m.pending, e)
+		return
+	}
+	m.entities = append(m.entities, e)
+}
+
+// SetEventBus sets the bus EnemyKilled events are published on
+func (m *Manager) SetEventBus(bus *events.Bus) {
+	m.events = bus
+}
+
+// Remove stops managing an entity and returns it to the pool. During Update
+// the entity is only marked and is dropped once the update pass is done.
+func (m *Manager) Remove(e *Entity) {
+	// Spawned this update, it never joined the entities
+	for i, existing := range m.pending {
+		if existing == e {
+			m.pending = slices.Delete(m.pending, i, i+1)
+			m.recycle(e)
+			return
+		}
+	}
+
+	if m.updating {
+		if !e.removed && slices.Contains(m.entities, e) {
+			e.removed = true
+			m.deferred++
+		}
+		return
+	}
+
+	for i, existing := range m.entities {
+		if existing == e {
+			copy(m.entities[i:], m.entities[i+1:])
+			m.entities[len(m.entities)-1] = nil
+			m.entities = m.entities[:len(m.entities)-1]
+			m.recycle(e)
+			m.gridDirty = true
+			return
+		}
+	}
+}
+
+// Clear removes every entity and returns them to the pool. It must not be
+// called during Update.
+func (m *Manager) Clear() {
+	for _, e := range m.entities {
+		m.recycle(e)
+	}
+	clear(m.entities)
+	m.entities = m.entities[:0]
+	m.gridDirty = true
+}
+
+// Entities returns the managed entities. The slice must not be modified.
+func (m *Manager) Entities() []*Entity {
+	return m.entities
+}
+
+// Count returns the number of managed entities
+func (m *Manager) Count() int {
+	return len(m.entities)
+}
+
+// Update updates all entities and removes the ones whose lifespan ran out
+func (m *Manager) Update(dt time.Duration) {
+	m.updating = true
+
+	alive := m.entities[:0]
+	for _, e := range m.entities {
+		if e.removed {
+			m.recycle(e)
+			continue
+		}
+
+		e.Update(dt)
+
+		if e.advanceLifespan(dt) {
+			if e.OnExpire != nil {
+				e.OnExpire(e)
+			}
+			m.recycle(e)
+			continue
+		}
+
+		alive = append(alive, e)
+	}
+
+	// Clear stale pointers left behind by the compaction
+	for i := len(alive); i < len(m.entities); i++ {
+		m.entities[i] = nil
+	}
+	m.entities = alive
+
+	m.updating = false
+
+	// Entities updated before something removed them
+	m.dropRemoved()
+
+	// Merge entities spawned during the update pass
+	m.flushPending()
+
+	if m.removeDead {
+		m.RemoveDead()
+	}
+
+	m.rebuildGrid()
+}
+
+// RemoveDead removes entities whose health has run out, calling their
+// OnDeath hook and publishing EnemyKilled first, and returns how many were
+// removed. It does nothing while entities are being updated; the pass at the
+// end of Update covers them.
+func (m *Manager) RemoveDead() int {
+	if m.updating {
+		return 0
+	}
+
+	// Entities spawned by death hooks are held back until the pass is done
+	m.updating = true
+
+	alive := m.entities[:0]
+	for _, e := range m.entities {
+		if e.removed || !e.isDead() {
+			alive = append(alive, e)
+			continue
+		}
+
+		if e.OnDeath != nil {
+			e.OnDeath(e)
+		}
+		if m.events != nil {
+			m.events.Publish(events.EnemyKilled{EnemyID: e.ID, Position: e.Position})
+		}
+		m.recycle(e)
+	}
+
+	removed := len(m.entities) - len(alive)
+	for i := len(alive); i < len(m.entities); i++ {
+		m.entities[i] = nil
+	}
+	m.entities = alive
+	if removed > 0 {
+		m.gridDirty = true
+	}
+
+	m.updating = false
+
+	// Entities a death hook removed
+	m.dropRemoved()
+
+	m.flushPending()
+
+	return removed
+}
+
+// SetRemoveDead enables or disables removing dead entities after each update
+func (m *Manager) SetRemoveDead(enabled bool) {
+	m.removeDead = enabled
+}
+
+// IsRemovingDead returns whether dead entities are removed after each update
+func (m *Manager) IsRemovingDead() bool {
+	return m.removeDead
+}
+
+// SetYSort enables or disables depth sorting by Y when drawing
+func (m *Manager) SetYSort(enabled bool) {
+	m.ySort = enabled
+}
+
+// IsYSorted returns whether entities are depth sorted when drawing
+func (m *Manager) IsYSorted() bool {
+	return m.ySort
+}
+
+// DrawOrder returns the entities in the order they are drawn.
+// The slice is reused between calls and must not be modified.
+func (m *Manager) DrawOrder() []*Entity {
+	if !m.ySort {
+		return m.entities
+	}
+
+	m.drawOrder = append(m.drawOrder[:0], m.entities...)
+	slices.SortStableFunc(m.drawOrder, func(a, b *Entity) int {
+		return cmp.Compare(a.SortKey(), b.SortKey())
+	})
+	return m.drawOrder
+}
+
+// Draw draws all entities
+func (m *Manager) Draw(screen *ebiten.Image, renderer Renderer) {
+	for _, e := range m.DrawOrder() {
+		e.Draw(screen, renderer)
+	}
+}
+
+// dropRemoved recycles the entities marked by Remove during an update pass
+func (m *Manager) dropRemoved() {
+	if m.deferred == 0 {
+		return
+	}
+	m.deferred = 0
+
+	alive := m.entities[:0]
+	for _, e := range m.entities {
+		if e.removed {
+			m.recycle(e)
+			continue
+		}
+		alive = append(alive, e)
+	}
+	for i := len(alive); i < len(m.entities); i++ {
+		m.entities[i] = nil
+	}
+	m.entities = alive
+}
+
+// flushPending adds the entities spawned while updating
+func (m *Manager) flushPending() {
+	m.entities = append(m.entities, m.pending...)
+	for i := range m.pending {
+		m.pending[i] = nil
+	}
+	m.pending = m.pending[:0]
+}
+
+// recycle returns an entity to the pool if there is room
+func (m *Manager) recycle(e *Entity) {
+	if len(m.pool) < maxPoolSize {
+		m.pool = append(m.pool, e)
+	}
+}
@@ -0,0 +1,150 @@
+package entity
+
+import (
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/events"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRemoveDeadPublishesOnce(t *testing.T) {
+	bus := events.NewBus()
+	var killed []uint64
+	bus.Subscribe(events.TypeEnemyKilled, func(e events.Event) {
+		killed = append(killed, e.(events.EnemyKilled).EnemyID)
+	})
+
+	m := NewManager()
+	m.SetEventBus(bus)
+
+	dead := m.Spawn(common.Vector2{})
+	dead.SetHealth(NewHealthComponent(10))
+	alive := m.Spawn(common.Vector2{X: 50})
+	alive.SetHealth(NewHealthComponent(10))
+
+	dead.GetHealth().TakeDamage(10, DamagePhysical)
+	m.Update(time.Millisecond)
+	m.Update(time.Millisecond)
+	m.RemoveDead()
+
+	if slices.Contains(m.Entities(), dead) {
+		t.Error("dead entity is still managed after cleanup")
+	}
+	if !slices.Contains(m.Entities(), alive) {
+		t.Error("living entity was removed")
+	}
+	if len(killed) != 1 || killed[0] != dead.ID {
+		t.Errorf("EnemyKilled published for %v, want once for %d", killed, dead.ID)
+	}
+}
+
+func TestRemoveDuringUpdateIsDeferred(t *testing.T) {
+	m := NewManager()
+	first := m.Spawn(common.Vector2{})
+	second := m.Spawn(common.Vector2{})
+	third := m.Spawn(common.Vector2{})
+
+	// One entity is removed after its turn, the other before it
+	updated := 0
+	third.SetInput(inputFunc(func() { updated++ }))
+	second.SetInput(inputFunc(func() {
+		m.Remove(first)
+		m.Remove(third)
+	}))
+
+	m.Update(time.Millisecond)
+
+	if got := m.Entities(); len(got) != 1 || got[0] != second {
+		t.Errorf("entities after update = %v, want only the second", got)
+	}
+	if updated != 0 {
+		t.Error("entity removed before its turn was still updated")
+	}
+}
+
+func TestRemovePending(t *testing.T) {
+	m := NewManager()
+	var spawned *Entity
+
+	owner := m.Spawn(common.Vector2{})
+	owner.SetInput(inputFunc(func() {
+		spawned = m.Spawn(common.Vector2{})
+		m.Remove(spawned)
+	}))
+
+	m.Update(time.Millisecond)
+
+	if slices.Contains(m.Entities(), spawned) {
+		t.Error("entity spawned and removed during update is managed")
+	}
+	if m.Count() != 1 {
+		t.Errorf("Count() = %d, want 1", m.Count())
+	}
+}
+
+// inputFunc runs a function each time its entity updates
+type inputFunc func()
+
+func (f inputFunc) ProcessInput(*Entity, time.Duration) { f() }
+func (f inputFunc) GetAimDirection() common.Vector2     { return common.Vector2{} }
+
+func TestLifespanExpiresOnStep(t *testing.T) {
+	const step = 10 * time.Millisecond
+
+	m := NewManager()
+	forever := m.Spawn(common.Vector2{})
+	effect := m.Spawn(common.Vector2{X: 20})
+	effect.Lifespan = 3 * step
+
+	var expired []*Entity
+	effect.OnExpire = func(e *Entity) { expired = append(expired, e) }
+
+	for i := 1; i <= 2; i++ {
+		m.Update(step)
+		if !slices.Contains(m.Entities(), effect) {
+			t.Fatalf("entity removed on step %d of 3", i)
+		}
+	}
+
+	m.Update(step)
+	if slices.Contains(m.Entities(), effect) {
+		t.Error("entity still managed after its third step")
+	}
+	if !slices.Contains(m.Entities(), forever) {
+		t.Error("entity without a lifespan was removed")
+	}
+	if len(expired) != 1 || expired[0] != effect {
+		t.Errorf("OnExpire called for %v, want once for the expired entity", expired)
+	}
+
+	// The expired entity is pooled and comes back without its lifespan
+	reused := m.Spawn(common.Vector2{})
+	if reused != effect {
+		t.Error("expired entity was not reused by the next spawn")
+	}
+	if reused.Lifespan != 0 || reused.OnExpire != nil {
+		t.Error("pooled entity kept its lifespan")
+	}
+}
+
+func TestDrawOrderSortsByY(t *testing.T) {
+	m := NewManager()
+	low := m.Spawn(common.Vector2{Y: 300})
+	high := m.Spawn(common.Vector2{Y: -50})
+	middle := m.Spawn(common.Vector2{Y: 100})
+
+	// Sorted by its feet, which are below the low entity
+	tall := m.Spawn(common.Vector2{Y: 280})
+	tall.SetSprite(NewSpriteComponent())
+	tall.GetSprite().SetSortOffset(40)
+
+	if got := m.DrawOrder(); !slices.Equal(got, []*Entity{low, high, middle, tall}) {
+		t.Errorf("unsorted draw order %v, want spawn order", got)
+	}
+
+	m.SetYSort(true)
+	if got := m.DrawOrder(); !slices.Equal(got, []*Entity{high, middle, low, tall}) {
+		t.Errorf("draw order %v, want ascending Y plus sort offset", got)
+	}
+}
@@ -0,0 +1,145 @@
+package entity
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"math"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/rendering"
+	"time"
+)
+
+// MeleeConfig contains melee attack tuning parameters
+type MeleeConfig struct {
+	Range        float64       // Reach from the attacker's center in world units
+	HalfAngle    float64       // Half-angle of the swing arc in radians
+	Damage       int           // Damage dealt to each entity inside the arc
+	DamageType   DamageType    // How the damage interacts with armor
+	Cooldown     time.Duration // Time between swings
+	ShowDuration time.Duration // How long the swing arc stays visible
+	Color        color.RGBA    // Color of the swing arc
+}
+
+// DefaultMeleeConfig returns default melee attack configuration
+func DefaultMeleeConfig() MeleeConfig {
+	return MeleeConfig{
+		Range:        90.0,
+		HalfAngle:    math.Pi / 3,
+		Damage:       35,
+		DamageType:   DamagePhysical,
+		Cooldown:     400 * time.Millisecond,
+		ShowDuration: 120 * time.Millisecond,
+		Color:        color.RGBA{255, 230, 160, 140},
+	}
+}
+
+// InArc returns whether a circle at pos with the given radius overlaps the
+// arc of reach around origin, within halfAngle of facing. The angle is
+// measured to the circle's center.
+func InArc(origin common.Vector2, facing, halfAngle, reach float64, pos common.Vector2, radius float64) bool {
+	offset := pos.Sub(origin)
+	distance := offset.Magnitude()
+	if distance-radius > reach {
+		return false
+	}
+
+	// Overlapping the attacker counts as in front of it
+	if distance <= radius {
+		return true
+	}
+
+	angle := math.Atan2(offset.Y, offset.X)
+	return math.Abs(common.AngleDifference(facing, angle)) <= halfAngle
+}
+
+// MeleeAttack is a cooldown-limited swing that hits everything inside an
+// arc in front of the attacker
+type MeleeAttack struct {
+	config MeleeConfig
+
+	// Time left before the next swing is allowed
+	cooldown time.Duration
+
+	// Time left to show the last swing, and where it was aimed
+	showing time.Duration
+	origin  common.Vector2
+	facing  float64
+
+	// Reused spatial query buffer
+	nearby []*Entity
+}
+
+// NewMeleeAttack creates a melee attack that is ready to swing
+func NewMeleeAttack(config MeleeConfig) *MeleeAttack {
+	return &MeleeAttack{config: config}
+}
+
+// GetConfig returns the attack's configuration
+func (m *MeleeAttack) GetConfig() MeleeConfig {
+	return m.config
+}
+
+// Update counts down the cooldown and the swing display
+func (m *MeleeAttack) Update(dt time.Duration) {
+	m.cooldown = max(m.cooldown-dt, 0)
+	m.showing = max(m.showing-dt, 0)
+}
+
+// Ready returns whether the cooldown has elapsed
+func (m *MeleeAttack) Ready() bool {
+	return m.cooldown <= 0
+}
+
+// CooldownProgress returns the remaining cooldown from 1 (just swung) to 0
+// (ready), as shown by a cooldown ring
+func (m *MeleeAttack) CooldownProgress() float64 {
+	return cooldownProgress(m.cooldown, m.config.Cooldown)
+}
+
+// Swing starts a swing from origin toward facing and the cooldown after it.
+// It returns false without swinging while on cooldown. Use InReach or
+// HitEntities to find what the swing hit.
+func (m *MeleeAttack) Swing(origin common.Vector2, facing float64) bool {
+	if !m.Ready() {
+		return false
+	}
+
+	m.cooldown = m.config.Cooldown
+	m.showing = m.config.ShowDuration
+	m.origin = origin
+	m.facing = facing
+	return true
+}
+
+// InReach returns whether a circle at pos is inside the last swing's arc
+func (m *MeleeAttack) InReach(pos common.Vector2, radius float64) bool {
+	return InArc(m.origin, m.facing, m.config.HalfAngle, m.config.Range, pos, radius)
+}
+
+// HitEntities damages the managed entities inside the last swing's arc,
+// found through the manager's spatial grid, and returns how many were hit
+func (m *MeleeAttack) HitEntities(entities *Manager) int {
+	hits := 0
+	m.nearby = entities.QueryEntities(m.origin, m.config.Range, m.nearby[:0])
+	for _, e := range m.nearby {
+		target := e.GetTargetInfo()
+		if !m.InReach(target.Pos, target.Radius) {
+			continue
+		}
+
+		e.GetHealth().TakeDamage(m.config.Damage, m.config.DamageType)
+		hits++
+	}
+	clear(m.nearby)
+	return hits
+}
+
+// Draw shows the last swing's arc while it is visible, fading out
+func (m *MeleeAttack) Draw(screen *ebiten.Image, renderer Renderer) {
+	if m.showing <= 0 || m.config.ShowDuration <= 0 {
+		return
+	}
+
+	col := rendering.FadeColor(m.config.Color, float64(m.showing)/float64(m.config.ShowDuration))
+	renderer.DrawSector(screen, m.origin, m.config.Range, m.facing-m.config.HalfAngle, m.facing+m.config.HalfAngle, col)
+}
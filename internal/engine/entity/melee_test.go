@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+)
+
+func TestInArc(t *testing.T) {
+	origin := common.Vector2{}
+	halfAngle := math.Pi / 4
+
+	tests := []struct {
+		name   string
+		pos    common.Vector2
+		radius float64
+		want   bool
+	}{
+		{"straight ahead", common.Vector2{X: 50}, 5, true},
+		{"edge reaches into range", common.Vector2{X: 105}, 10, true},
+		{"out of range", common.Vector2{X: 120}, 10, false},
+		{"inside the angle", common.Vector2{X: 50, Y: 40}, 5, true},
+		{"outside the angle", common.Vector2{X: 20, Y: 50}, 5, false},
+		{"behind", common.Vector2{X: -50}, 5, false},
+		{"overlapping the attacker", common.Vector2{X: -5}, 10, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InArc(origin, 0, halfAngle, 100, tt.pos, tt.radius); got != tt.want {
+				t.Errorf("InArc(%v, %v) = %v, want %v", tt.pos, tt.radius, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHitEntitiesOnlyInArc(t *testing.T) {
+	m := NewManager()
+	spawn := func(pos common.Vector2) *Entity {
+		e := m.Spawn(pos)
+		e.SetHealth(NewHealthComponent(100))
+		e.SetCollision(NewCollisionComponent(10))
+		return e
+	}
+	front := spawn(common.Vector2{X: 60})
+	side := spawn(common.Vector2{X: 0, Y: 60})
+	far := spawn(common.Vector2{X: 300})
+
+	config := DefaultMeleeConfig()
+	config.HalfAngle = math.Pi / 4
+	config.DamageType = DamageTrue
+	melee := NewMeleeAttack(config)
+	melee.Swing(common.Vector2{}, 0)
+
+	if hits := melee.HitEntities(m); hits != 1 {
+		t.Errorf("HitEntities() = %d, want 1", hits)
+	}
+	if front.GetHealth().GetCurrent() != 100-config.Damage {
+		t.Errorf("entity in the arc has %d health, want %d", front.GetHealth().GetCurrent(), 100-config.Damage)
+	}
+	for _, e := range []*Entity{side, far} {
+		if e.GetHealth().GetCurrent() != 100 {
+			t.Errorf("entity at %v outside the arc was hit", e.Position)
+		}
+	}
+}
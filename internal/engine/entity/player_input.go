@@ -2,8 +2,12 @@
 package entity
 
 import (
+	"cmp"
 	"math"
 	"novampires-go/internal/common"
+	"slices"
+	"sort"
+	"time"
 )
 
 // PlayerInput processes player input and updates entity state
@@ -23,43 +27,183 @@ type PlayerInput struct {
 	currentTargets []common.TargetInfo
 	autoAim        bool
 
+	// Scales the auto-aim turn rate (0-1), 0 leaves aiming to the player
+	autoAimStrength float64
+
+	// Targets gathered by GatherTargets
+	gathered []common.TargetInfo
+
+	// Spatial query SelectTargets gathers its candidates from, nil when the
+	// targets are passed to UpdateTargets
+	query TargetQuery
+
+	// Reused buffers for SelectTargets
+	candidates []targetCandidate
+	selected   []*common.TargetInfo
+
+	// Multiplier on movement speed and acceleration (e.g. debug noclip)
+	speedMultiplier float64
+
+	// Target auto-aim is currently turning toward
+	lockedTarget common.TargetInfo
+	hasLock      bool
+
+	// Predicted intercept of the locked target when predictive aim is on
+	leadPoint common.Vector2
+	hasLead   bool
+
+	// Aim offset left by recent shots
+	recoil recoilState
+
 	entity *Entity
 }
 
+// TargetingMode decides which targets auto-aim may pick
+type TargetingMode int
+
+const (
+	// TargetNearest picks the closest target in range in any direction
+	TargetNearest TargetingMode = iota
+	// TargetCone only picks targets within a cone around the aim direction
+	TargetCone
+)
+
+func (m TargetingMode) String() string {
+	switch m {
+	case TargetNearest:
+		return "Nearest"
+	case TargetCone:
+		return "Cone"
+	default:
+		return "Unknown"
+	}
+}
+
+// DecelerationMode decides how the player slows down without movement input
+type DecelerationMode int
+
+const (
+	// DecelerationLinear removes Deceleration speed per second
+	DecelerationLinear DecelerationMode = iota
+	// DecelerationExponential multiplies the velocity by Drag every second,
+	// easing out instead of stopping abruptly
+	DecelerationExponential
+	// DecelerationInstant stops the player immediately
+	DecelerationInstant
+)
+
+func (m DecelerationMode) String() string {
+	switch m {
+	case DecelerationLinear:
+		return "Linear"
+	case DecelerationExponential:
+		return "Exponential"
+	case DecelerationInstant:
+		return "Instant"
+	default:
+		return "Unknown"
+	}
+}
+
+// FlipSource decides which direction the sprite faces
+type FlipSource int
+
+const (
+	// FlipAim faces where the player is aiming
+	FlipAim FlipSource = iota
+	// FlipVelocity faces the direction the player is actually moving
+	FlipVelocity
+	// FlipMovementInput faces the direction being pressed, turning before
+	// the velocity catches up
+	FlipMovementInput
+)
+
+func (s FlipSource) String() string {
+	switch s {
+	case FlipAim:
+		return "Aim"
+	case FlipVelocity:
+		return "Velocity"
+	case FlipMovementInput:
+		return "Movement Input"
+	default:
+		return "Unknown"
+	}
+}
+
+// FacingX returns the horizontal direction a flip source points in. Only
+// the sign matters; 0 means keep the current facing.
+func FacingX(source FlipSource, aim, velocity, input common.Vector2) float64 {
+	switch source {
+	case FlipVelocity:
+		return velocity.X
+	case FlipMovementInput:
+		return input.X
+	default:
+		return aim.X
+	}
+}
+
+// stopSpeed is the speed below which exponential deceleration snaps to a halt
+const stopSpeed = 1.0
+
 // PlayerInputConfig contains configuration for player input
 type PlayerInputConfig struct {
-	MaxSpeed      float64
-	Acceleration  float64
-	Deceleration  float64
-	RotationSpeed float64
-	AutoAimRange  float64
+	MaxSpeed         float64
+	Acceleration     float64
+	Deceleration     float64
+	DecelerationMode DecelerationMode
+	Drag             float64 // Fraction of velocity kept per second with DecelerationExponential
+	RotationSpeed    float64 // Auto-aim turn rate in radians per second
+	AutoAimRange     float64
+	Targeting        TargetingMode
+	ConeHalfAngle    float64 // Half-angle of the TargetCone cone in radians
+	FlipSource       FlipSource
+
+	// With PredictiveAim, auto-aim leads moving targets for shots of LeadSpeed
+	PredictiveAim bool
+	LeadSpeed     float64
+
+	// Only the closest MaxCandidates targets in range are ranked, bounding
+	// the per-frame cost with many enemies. 0 ranks every target.
+	MaxCandidates int
 }
 
 // DefaultPlayerInputConfig returns default player input configuration
 func DefaultPlayerInputConfig() PlayerInputConfig {
 	return PlayerInputConfig{
-		MaxSpeed:      5.0,
-		Acceleration:  1.0,
-		Deceleration:  0.5,
-		RotationSpeed: 0.15,
-		AutoAimRange:  400.0,
+		MaxSpeed:         300.0,  // World units per second
+		Acceleration:     3600.0, // Speed gained per second
+		Deceleration:     1800.0, // Speed lost per second
+		DecelerationMode: DecelerationLinear,
+		Drag:             0.0005,
+		RotationSpeed:    9.0,
+		AutoAimRange:     400.0,
+		Targeting:        TargetNearest,
+		ConeHalfAngle:    math.Pi / 6,
+		FlipSource:       FlipAim,
+		PredictiveAim:    false,
+		LeadSpeed:        DefaultProjectileConfig().Speed,
+		MaxCandidates:    32,
 	}
 }
 
 // NewPlayerInput creates a new player input component
 func NewPlayerInput(inputManager common.InputProvider, config PlayerInputConfig, entity *Entity) *PlayerInput {
 	return &PlayerInput{
-		inputManager: inputManager,
-		config:       config,
-		autoAim:      true,
-		entity:       entity,
+		inputManager:    inputManager,
+		config:          config,
+		autoAim:         true,
+		autoAimStrength: 1.0,
+		speedMultiplier: 1.0,
+		entity:          entity,
 	}
 }
 
 // ProcessInput processes player input and updates entity state
-func (p *PlayerInput) ProcessInput(entity *Entity) {
-	p.updateMovement(entity)
-	p.updateAiming(entity)
+func (p *PlayerInput) ProcessInput(entity *Entity, dt time.Duration) {
+	p.updateMovement(entity, dt)
+	p.updateAiming(entity, dt)
 
 	// Update auto-aim state
 	if p.inputManager.JustPressed(common.ActionAutoAttack) {
@@ -76,13 +220,36 @@ func (p *PlayerInput) ProcessInput(entity *Entity) {
 	p.updateAnimation(entity, sprite)
 }
 
-// UpdateTargets updates the list of potential targets for auto-aim
+// targetCandidate is a target in auto-aim range and its squared distance
+type targetCandidate struct {
+	target *common.TargetInfo
+	distSq float64
+}
+
+// UpdateTargets sets the potential targets for auto-aim this frame. The slice
+// is neither copied nor modified and is only read until the next call, so the
+// caller can keep one buffer and refill it every frame.
 func (p *PlayerInput) UpdateTargets(targets []common.TargetInfo) {
 	p.currentTargets = targets
+	p.query = nil
+}
+
+// SetTargetQuery makes auto-aim gather its candidates from query, usually
+// the entity manager's spatial grid, every time it selects targets. This
+// replaces the list given to UpdateTargets.
+func (p *PlayerInput) SetTargetQuery(query TargetQuery) {
+	p.query = query
+}
+
+// GatherTargets queries the potential auto-aim targets within range of the
+// player, as an alternative to building the list for UpdateTargets
+func (p *PlayerInput) GatherTargets(query TargetQuery) {
+	p.gathered = query.QueryTargets(p.entity.GetPosition(), p.config.AutoAimRange, p.gathered[:0])
+	p.currentTargets = p.gathered
 }
 
 // updateMovement handles player movement input
-func (p *PlayerInput) updateMovement(entity *Entity) {
+func (p *PlayerInput) updateMovement(entity *Entity, dt time.Duration) {
 	dx, dy := p.inputManager.GetMovementVector()
 	inputVec := common.Vector2{X: dx, Y: dy}
 	inputMagnitude := inputVec.Magnitude()
@@ -91,9 +258,9 @@ func (p *PlayerInput) updateMovement(entity *Entity) {
 
 	if inputMagnitude > 0 {
 		// Scale max speed by input magnitude
-		targetSpeed := p.config.MaxSpeed * inputMagnitude
+		targetSpeed := p.config.MaxSpeed * inputMagnitude * p.speedMultiplier
 
-		velocity = velocity.Add(inputVec.Scale(p.config.Acceleration))
+		velocity = velocity.Add(inputVec.Scale(p.config.Acceleration * p.speedMultiplier * dt.Seconds()))
 		currentSpeed := velocity.Magnitude()
 
 		// Cap at the scaled max speed
@@ -101,20 +268,38 @@ func (p *PlayerInput) updateMovement(entity *Entity) {
 			velocity = velocity.Normalized().Scale(targetSpeed)
 		}
 	} else {
-		currentSpeed := velocity.Magnitude()
-		if currentSpeed > 0 {
-			newSpeed := math.Max(0, currentSpeed-p.config.Deceleration)
-			if newSpeed > 0 {
-				velocity = velocity.Normalized().Scale(newSpeed)
-			} else {
-				velocity = common.Vector2{}
-			}
-		}
+		velocity = p.decelerate(velocity, dt)
 	}
 
 	entity.SetVelocity(velocity)
 }
 
+// decelerate slows a velocity by dt according to the deceleration mode
+func (p *PlayerInput) decelerate(velocity common.Vector2, dt time.Duration) common.Vector2 {
+	currentSpeed := velocity.Magnitude()
+	if currentSpeed == 0 {
+		return velocity
+	}
+
+	var newSpeed float64
+	switch p.config.DecelerationMode {
+	case DecelerationExponential:
+		newSpeed = currentSpeed * math.Pow(p.config.Drag, dt.Seconds())
+		if newSpeed < stopSpeed {
+			newSpeed = 0
+		}
+	case DecelerationInstant:
+		newSpeed = 0
+	default:
+		newSpeed = math.Max(0, currentSpeed-p.config.Deceleration*dt.Seconds())
+	}
+
+	if newSpeed <= 0 {
+		return common.Vector2{}
+	}
+	return velocity.Scale(newSpeed / currentSpeed)
+}
+
 // GetAimVector returns the normalized aim vector
 func (p *PlayerInput) GetAimVector() (float64, float64) {
 	// Get current mouse position in WORLD coordinates
@@ -128,6 +313,13 @@ func (p *PlayerInput) GetAimVector() (float64, float64) {
 		return dx, dy
 	}
 
+	// Then the keyboard aim keys, which keep their direction once released
+	if dx, dy, ok := p.inputManager.GetKeyboardAim(); ok {
+		p.lastAimDx, p.lastAimDy = dx, dy
+
+		return dx, dy
+	}
+
 	// Check for mouse movement
 	if mx != p.lastMouseX || my != p.lastMouseY {
 		p.usingGamepad = false
@@ -164,50 +356,251 @@ func (p *PlayerInput) GetAimDirection() common.Vector2 {
 }
 
 // updateAiming handles player aiming input
-func (p *PlayerInput) updateAiming(entity *Entity) {
-	if p.autoAim && len(p.currentTargets) > 0 {
-		// Auto-aim logic
-		var closestTarget *common.TargetInfo
-		closestDistSq := p.config.AutoAimRange * p.config.AutoAimRange
-		entityPos := entity.GetPosition()
-
-		for i, target := range p.currentTargets {
-			delta := target.Pos.Sub(entityPos)
-			distSq := delta.MagnitudeSquared()
-
-			if distSq < closestDistSq {
-				closestDistSq = distSq
-				closestTarget = &p.currentTargets[i]
-			}
-		}
+func (p *PlayerInput) updateAiming(entity *Entity, dt time.Duration) {
+	p.hasLock = false
+	p.hasLead = false
+	p.recoil.recover(dt)
+
+	var best []*common.TargetInfo
+	if p.autoAim && p.autoAimStrength > 0 {
+		best = p.SelectTargets(1)
+	}
 
-		if closestTarget != nil {
-			aimDirection := closestTarget.Pos.Sub(entityPos)
-			targetRotation := math.Atan2(aimDirection.Y, aimDirection.X)
-			currentRotation := entity.GetRotation()
-			angleDiff := common.NormalizeAngle(targetRotation - currentRotation)
-			rotationStep := angleDiff * p.config.RotationSpeed
-			entity.SetRotation(common.NormalizeAngle(currentRotation + rotationStep))
+	if len(best) > 0 {
+		// Auto-aim logic, rotation always follows the single best target
+		p.lockedTarget = *best[0]
+		p.hasLock = true
+
+		aimPoint := best[0].Pos
+		if p.config.PredictiveAim && p.config.LeadSpeed > 0 {
+			aimPoint = InterceptPoint(entity.GetPosition(), p.config.LeadSpeed, best[0].Pos, best[0].Vel)
+			p.leadPoint = aimPoint
+			p.hasLead = true
 		}
+
+		aimDirection := aimPoint.Sub(entity.GetPosition())
+		targetRotation := math.Atan2(aimDirection.Y, aimDirection.X) + p.recoil.offset
+		maxStep := p.config.RotationSpeed * p.autoAimStrength * dt.Seconds()
+		entity.SetRotation(rotateTowards(entity.GetRotation(), targetRotation, maxStep))
 	} else {
 		// Manual aim using input manager's aim vector
 		dx, dy := p.GetAimVector()
 
 		if dx != 0 || dy != 0 {
-			entity.SetRotation(math.Atan2(dy, dx))
+			entity.SetRotation(math.Atan2(dy, dx) + p.recoil.offset)
 		}
 	}
 }
 
+// ApplyRecoil kicks the player back from the aim direction and throws the
+// aim off slightly, recovering over the configured time. Weapons call this
+// when they fire.
+func (p *PlayerInput) ApplyRecoil(config RecoilConfig) {
+	rotation := p.entity.GetRotation()
+	p.entity.ApplyImpulse(RecoilImpulse(rotation, config))
+
+	before := p.recoil.offset
+	p.recoil.kick(config)
+	p.entity.SetRotation(rotation + p.recoil.offset - before)
+}
+
+// Halt stops the player and drops the recoil, target lock and pending input
+// built up from earlier input, so nothing carries over when the game resumes
+func (p *PlayerInput) Halt() {
+	if p.inputManager != nil {
+		p.inputManager.Reset()
+	}
+	p.entity.SetVelocity(common.Vector2{})
+	p.entity.SetRotation(p.entity.GetRotation() - p.recoil.offset)
+	p.recoil = recoilState{}
+	p.hasLock = false
+	p.hasLead = false
+}
+
+// GetRecoilOffset returns the aim angle offset left by recent shots
+func (p *PlayerInput) GetRecoilOffset() float64 {
+	return p.recoil.offset
+}
+
+// GetLockedTarget returns the target auto-aim is locked onto, if any
+func (p *PlayerInput) GetLockedTarget() (common.TargetInfo, bool) {
+	return p.lockedTarget, p.hasLock
+}
+
+// GetLeadPoint returns where predictive aim expects a shot to meet the locked
+// target, if predictive aim is leading one
+func (p *PlayerInput) GetLeadPoint() (common.Vector2, bool) {
+	return p.leadPoint, p.hasLead
+}
+
+// SetPredictiveAim sets whether auto-aim leads moving targets
+func (p *PlayerInput) SetPredictiveAim(enabled bool) {
+	p.config.PredictiveAim = enabled
+}
+
+// IsPredictiveAimEnabled returns whether auto-aim leads moving targets
+func (p *PlayerInput) IsPredictiveAimEnabled() bool {
+	return p.config.PredictiveAim
+}
+
+// SetLeadSpeed sets the projectile speed predictive aim leads targets for,
+// usually that of the equipped weapon
+func (p *PlayerInput) SetLeadSpeed(speed float64) {
+	p.config.LeadSpeed = max(speed, 0)
+}
+
+// GetLeadSpeed returns the projectile speed predictive aim leads targets for
+func (p *PlayerInput) GetLeadSpeed() float64 {
+	return p.config.LeadSpeed
+}
+
+// SelectTargets returns up to n targets whose edge is within auto-aim range,
+// closest center first.
+// In TargetCone mode targets outside the aim cone are skipped. With
+// MaxCandidates set, only that many of the closest targets are kept while
+// scanning, so the work stays bounded however many targets are passed in.
+// With a target query set, candidates are gathered through it first so far
+// targets are skipped by the spatial grid.
+// The returned pointers refer to the targets passed to UpdateTargets or
+// gathered from the query, and the returned slice is reused by the next call.
+func (p *PlayerInput) SelectTargets(n int) []*common.TargetInfo {
+	if n <= 0 {
+		return nil
+	}
+	if p.query != nil {
+		p.GatherTargets(p.query)
+	}
+
+	entityPos := p.entity.GetPosition()
+
+	cone := p.config.Targeting == TargetCone
+	var facing float64
+	if cone {
+		facing = p.coneFacing()
+	}
+
+	// Never keep fewer candidates than were asked for
+	limit := p.config.MaxCandidates
+	if limit > 0 {
+		limit = max(limit, n)
+	}
+
+	candidates := p.candidates[:0]
+	for i := range p.currentTargets {
+		target := &p.currentTargets[i]
+		offset := target.Pos.Sub(entityPos)
+		if cone && math.Abs(common.AngleDifference(facing, math.Atan2(offset.Y, offset.X))) > p.config.ConeHalfAngle {
+			continue
+		}
+
+		// Large targets are in range as soon as their edge is
+		distSq := offset.MagnitudeSquared()
+		if math.Sqrt(distSq)-target.Radius > p.config.AutoAimRange {
+			continue
+		}
+
+		candidate := targetCandidate{target: target, distSq: distSq}
+		if limit > 0 {
+			candidates = insertCandidate(candidates, candidate, limit)
+		} else {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	// Bounded candidates are kept sorted as they are inserted
+	if limit <= 0 {
+		slices.SortStableFunc(candidates, func(a, b targetCandidate) int {
+			return cmp.Compare(a.distSq, b.distSq)
+		})
+	}
+
+	selected := p.selected[:0]
+	for _, c := range candidates[:min(n, len(candidates))] {
+		selected = append(selected, c.target)
+	}
+
+	// Keep the grown buffers, dropping pointers into the caller's targets
+	clear(candidates)
+	p.candidates = candidates[:0]
+	p.selected = selected
+	return selected
+}
+
+// insertCandidate adds c to candidates, which are sorted by distance, keeping
+// only the limit closest. Equally distant candidates keep their insertion order.
+func insertCandidate(candidates []targetCandidate, c targetCandidate, limit int) []targetCandidate {
+	if len(candidates) >= limit && c.distSq >= candidates[len(candidates)-1].distSq {
+		return candidates
+	}
+
+	i := sort.Search(len(candidates), func(i int) bool {
+		return candidates[i].distSq > c.distSq
+	})
+
+	// Grow while below the limit, otherwise the farthest falls off the end
+	if len(candidates) < limit {
+		candidates = append(candidates, targetCandidate{})
+	}
+	copy(candidates[i+1:], candidates[i:len(candidates)-1])
+	candidates[i] = c
+	return candidates
+}
+
+// coneFacing returns the angle the targeting cone points in: the player's aim
+// input, or the current rotation when there is none
+func (p *PlayerInput) coneFacing() float64 {
+	dx, dy := p.GetAimVector()
+	if dx == 0 && dy == 0 {
+		return p.entity.GetRotation()
+	}
+	return math.Atan2(dy, dx)
+}
+
+// SetTargetingMode sets how auto-aim picks targets
+func (p *PlayerInput) SetTargetingMode(mode TargetingMode) {
+	p.config.Targeting = mode
+}
+
+// GetTargetingMode returns how auto-aim picks targets
+func (p *PlayerInput) GetTargetingMode() TargetingMode {
+	return p.config.Targeting
+}
+
+// SetFlipSource sets which direction the sprite faces
+func (p *PlayerInput) SetFlipSource(source FlipSource) {
+	p.config.FlipSource = source
+}
+
+// GetFlipSource returns which direction the sprite faces
+func (p *PlayerInput) GetFlipSource() FlipSource {
+	return p.config.FlipSource
+}
+
+// rotateTowards turns current toward target by at most maxStep radians without overshooting
+func rotateTowards(current, target, maxStep float64) float64 {
+	diff := math.Abs(common.AngleDifference(current, target))
+	if diff <= maxStep {
+		return common.NormalizeAngle(target)
+	}
+	return common.LerpAngle(current, target, maxStep/diff)
+}
+
+// WalkAnimationSpeed returns the walk animation playback rate for a movement
+// input magnitude: full tilt plays at normal speed, half tilt at half speed
+func WalkAnimationSpeed(inputMagnitude float64) float64 {
+	return min(max(inputMagnitude, 0), 1)
+}
+
 // updateAnimation updates the entity's animation based on its movement
 func (p *PlayerInput) updateAnimation(entity *Entity, sprite *SpriteComponent) {
 	velocity := entity.GetVelocity()
-	aimDirection := p.GetAimDirection()
+	dx, dy := p.inputManager.GetMovementVector()
 
-	// Update sprite direction based on aim
-	if aimDirection.X < 0 {
+	// Update sprite direction based on the configured source
+	facing := FacingX(p.config.FlipSource, p.GetAimDirection(), velocity, common.Vector2{X: dx, Y: dy})
+	if facing < 0 {
 		sprite.SetFlipX(true)
-	} else if aimDirection.X > 0 {
+	} else if facing > 0 {
 		sprite.SetFlipX(false)
 	}
 
@@ -225,14 +618,25 @@ func (p *PlayerInput) updateAnimation(entity *Entity, sprite *SpriteComponent) {
 		if currentAnim != "walk" {
 			sprite.PlayAnimation("walk")
 		}
+
+		// Partial stick deflection walks slower, so the legs do too. Coasting
+		// with no input keeps the last rate rather than freezing the cycle.
+		if magnitude := (common.Vector2{X: dx, Y: dy}).Magnitude(); magnitude > 0 {
+			sprite.SetAnimationSpeed(WalkAnimationSpeed(magnitude))
+		}
 	} else {
-		// Player is idle
-		if currentAnim != "idle" {
+		// Player is idle, a fidget counts as idling
+		if currentAnim != "idle" && !sprite.IsFidgeting() {
 			sprite.PlayAnimation("idle")
 		}
 	}
 }
 
+// SetSpeedMultiplier scales movement speed and acceleration
+func (p *PlayerInput) SetSpeedMultiplier(multiplier float64) {
+	p.speedMultiplier = multiplier
+}
+
 // IsUsingGamepad returns whether the player is using a gamepad
 func (p *PlayerInput) IsUsingGamepad() bool {
 	return p.usingGamepad
@@ -247,3 +651,29 @@ func (p *PlayerInput) IsAutoAimEnabled() bool {
 func (p *PlayerInput) SetAutoAim(enabled bool) {
 	p.autoAim = enabled
 }
+
+// SetAutoAimStrength sets how strongly auto-aim turns toward targets,
+// clamped to [0, 1]. At 0 aiming is always manual.
+func (p *PlayerInput) SetAutoAimStrength(strength float64) {
+	p.autoAimStrength = min(max(strength, 0), 1)
+}
+
+// SetMaxCandidates sets how many of the closest targets auto-aim ranks,
+// 0 to rank every target in range
+func (p *PlayerInput) SetMaxCandidates(k int) {
+	p.config.MaxCandidates = max(k, 0)
+}
+
+// GetMaxCandidates returns how many of the closest targets auto-aim ranks
+func (p *PlayerInput) GetMaxCandidates() int {
+	return p.config.MaxCandidates
+}
+
+// GetAutoAimStrength returns the effective auto-aim strength, 0 while
+// auto-aim is toggled off
+func (p *PlayerInput) GetAutoAimStrength() float64 {
+	if !p.autoAim {
+		return 0
+	}
+	return p.autoAimStrength
+}
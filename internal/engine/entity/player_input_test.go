@@ -0,0 +1,485 @@
+package entity
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/input"
+	"slices"
+	"testing"
+	"time"
+)
+
+// newAimer creates player input for an entity at the origin with auto-aim
+// ranking every target in range
+func newAimer() *PlayerInput {
+	config := DefaultPlayerInputConfig()
+	config.MaxCandidates = 0
+	return NewPlayerInput(nil, config, NewEntity(1, common.Vector2{}))
+}
+
+// spawnTarget adds a living target to m
+func spawnTarget(m *Manager, pos common.Vector2) *Entity {
+	e := m.Spawn(pos)
+	e.SetHealth(NewHealthComponent(10))
+	e.SetCollision(NewCollisionComponent(10))
+	return e
+}
+
+// crowdManager returns a manager with n targets scattered over a square of
+// the given size centered on the origin
+func crowdManager(n int, size float64) *Manager {
+	rng := common.NewRng(1)
+	m := NewManager()
+	for range n {
+		spawnTarget(m, common.Vector2{X: rng.Range(-size/2, size/2), Y: rng.Range(-size/2, size/2)})
+	}
+	return m
+}
+
+func TestSelectTargetsFromQuery(t *testing.T) {
+	m := NewManager()
+	far := spawnTarget(m, common.Vector2{X: 1000})
+	second := spawnTarget(m, common.Vector2{Y: 300})
+	first := spawnTarget(m, common.Vector2{X: -100})
+
+	p := newAimer()
+	p.SetTargetQuery(m)
+
+	got := p.SelectTargets(5)
+	if len(got) != 2 || got[0].ID != first.ID || got[1].ID != second.ID {
+		t.Fatalf("selected %v, want %d then %d", got, first.ID, second.ID)
+	}
+
+	// Targets that move into range are picked up on the next selection
+	far.Position = common.Vector2{X: 50}
+	m.gridDirty = true
+	if got := p.SelectTargets(1); len(got) != 1 || got[0].ID != far.ID {
+		t.Errorf("selected %v after moving, want %d", got, far.ID)
+	}
+}
+
+func TestSelectTargetsReturnsBestInOrder(t *testing.T) {
+	targets := []common.TargetInfo{
+		{ID: 1, Pos: common.Vector2{X: 250}},
+		{ID: 2, Pos: common.Vector2{Y: -60}},
+		{ID: 3, Pos: common.Vector2{X: -400}},
+		{ID: 4, Pos: common.Vector2{X: 90, Y: 90}},
+		{ID: 5, Pos: common.Vector2{Y: 180}},
+	}
+	want := []uint64{2, 4, 5}
+
+	tests := []struct {
+		name          string
+		maxCandidates int
+	}{
+		{"unbounded", 0},
+		{"bounded below n", 2},
+		{"bounded above n", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newAimer()
+			p.SetMaxCandidates(tt.maxCandidates)
+			p.UpdateTargets(targets)
+
+			got := p.SelectTargets(3)
+			if len(got) != len(want) {
+				t.Fatalf("selected %d targets, want %d", len(got), len(want))
+			}
+			for i, id := range want {
+				if got[i].ID != id {
+					t.Errorf("target %d = %d, want %d", i, got[i].ID, id)
+				}
+			}
+		})
+	}
+}
+
+func TestConeExcludesTargetsOutsideAngle(t *testing.T) {
+	targets := []common.TargetInfo{
+		{ID: 1, Pos: common.Vector2{X: -30}},          // Close behind
+		{ID: 2, Pos: common.Vector2{Y: 40}},           // Close, a right angle off
+		{ID: 3, Pos: common.Vector2{X: 50, Y: 50}},    // Close, 45 degrees off
+		{ID: 4, Pos: common.Vector2{X: 300, Y: 50}},   // Far, about 9 degrees off
+		{ID: 5, Pos: common.Vector2{X: 200, Y: -100}}, // About 27 degrees off
+		{ID: 6, Pos: common.Vector2{X: 100, Y: -100}}, // 45 degrees off the other way
+	}
+
+	tests := []struct {
+		name string
+		mode TargetingMode
+		aim  common.Vector2
+		want []uint64
+	}{
+		{"nearest ignores facing", TargetNearest, common.Vector2{X: 1}, []uint64{1, 2, 3, 6, 5, 4}},
+		{"cone facing right", TargetCone, common.Vector2{X: 1}, []uint64{5, 4}},
+		{"cone facing down", TargetCone, common.Vector2{Y: 1}, []uint64{2}},
+		{"cone facing left", TargetCone, common.Vector2{X: -1}, []uint64{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			im := input.New()
+			im.ApplySnapshot(input.InputSnapshot{AimX: tt.aim.X, AimY: tt.aim.Y, HasAim: true, UsingGamepad: true})
+
+			config := DefaultPlayerInputConfig()
+			config.MaxCandidates = 0
+			config.Targeting = tt.mode
+			p := NewPlayerInput(im, config, NewEntity(1, common.Vector2{}))
+			p.UpdateTargets(targets)
+
+			got := p.SelectTargets(len(targets))
+			var ids []uint64
+			for _, target := range got {
+				ids = append(ids, target.ID)
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("selected %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestLargeTargetInRangeByEdge(t *testing.T) {
+	aimRange := DefaultPlayerInputConfig().AutoAimRange
+
+	tests := []struct {
+		name    string
+		dist    float64
+		radius  float64
+		inRange bool
+	}{
+		{"small target inside", aimRange - 1, 5, true},
+		{"small target just outside", aimRange + 10, 5, false},
+		{"large target at the same distance", aimRange + 10, 40, true},
+		{"large target edge on the boundary", aimRange + 40, 40, true},
+		{"large target edge just outside", aimRange + 41, 40, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newAimer()
+			p.UpdateTargets([]common.TargetInfo{{ID: 2, Pos: common.Vector2{X: tt.dist}, Radius: tt.radius}})
+
+			if got := len(p.SelectTargets(1)) == 1; got != tt.inRange {
+				t.Errorf("in range = %v, want %v", got, tt.inRange)
+			}
+		})
+	}
+}
+
+func TestUpdateTargetsReplacesQuery(t *testing.T) {
+	m := NewManager()
+	spawnTarget(m, common.Vector2{X: 100})
+
+	p := newAimer()
+	p.SetTargetQuery(m)
+	p.UpdateTargets([]common.TargetInfo{{ID: 99, Pos: common.Vector2{X: 200}}})
+
+	if got := p.SelectTargets(5); len(got) != 1 || got[0].ID != 99 {
+		t.Errorf("selected %v, want only the listed target", got)
+	}
+}
+
+func TestMaxCandidatesKeepsClosest(t *testing.T) {
+	m := crowdManager(500, 800)
+	p := newAimer()
+	p.SetTargetQuery(m)
+	want := append([]*common.TargetInfo(nil), p.SelectTargets(3)...)
+	wantIDs := []uint64{want[0].ID, want[1].ID, want[2].ID}
+
+	p.SetMaxCandidates(3)
+	got := p.SelectTargets(3)
+	for i := range wantIDs {
+		if got[i].ID != wantIDs[i] {
+			t.Fatalf("bounded selection %d = %d, want %d", i, got[i].ID, wantIDs[i])
+		}
+	}
+}
+
+func BenchmarkSelectTargets(b *testing.B) {
+	const n = 5000
+	m := crowdManager(n, 8000)
+
+	all := make([]common.TargetInfo, 0, n)
+	for _, e := range m.Entities() {
+		all = append(all, e.GetTargetInfo())
+	}
+
+	b.Run("scan all", func(b *testing.B) {
+		p := newAimer()
+		p.UpdateTargets(all)
+		p.SelectTargets(1)
+		b.ReportAllocs()
+		for b.Loop() {
+			p.SelectTargets(1)
+		}
+	})
+
+	b.Run("query K=32", func(b *testing.B) {
+		p := newAimer()
+		p.SetMaxCandidates(32)
+		p.SetTargetQuery(m)
+		p.SelectTargets(1)
+		b.ReportAllocs()
+		for b.Loop() {
+			p.SelectTargets(1)
+		}
+	})
+}
+
+// targetFrames returns per-frame auto-aim updates for a fixed crowd, one
+// refilling a reused target list as the scene does and one gathering from
+// the manager
+func targetFrames() []struct {
+	name  string
+	frame func()
+} {
+	m := crowdManager(1000, 2000)
+
+	listed := newAimer()
+	var targets []common.TargetInfo
+	queried := newAimer()
+	queried.SetTargetQuery(m)
+
+	return []struct {
+		name  string
+		frame func()
+	}{
+		{"list", func() {
+			targets = targets[:0]
+			for _, e := range m.Entities() {
+				targets = append(targets, e.GetTargetInfo())
+			}
+			listed.UpdateTargets(targets)
+			listed.SelectTargets(3)
+		}},
+		{"query", func() {
+			queried.SelectTargets(3)
+		}},
+	}
+}
+
+func TestTargetFrameDoesNotAllocate(t *testing.T) {
+	for _, tt := range targetFrames() {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.frame() // Grow the buffers once
+			if allocs := testing.AllocsPerRun(100, tt.frame); allocs != 0 {
+				t.Errorf("%v allocations per frame, want 0", allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkTargetFrame(b *testing.B) {
+	for _, bb := range targetFrames() {
+		b.Run(bb.name, func(b *testing.B) {
+			bb.frame()
+			b.ReportAllocs()
+			for b.Loop() {
+				bb.frame()
+			}
+		})
+	}
+}
+
+func TestLeadPointIsIntercept(t *testing.T) {
+	im := input.New()
+	p := NewPlayerInput(im, DefaultPlayerInputConfig(), NewEntity(1, common.Vector2{}))
+	p.SetPredictiveAim(true)
+
+	// Crossing the line of fire from below
+	target := common.TargetInfo{ID: 2, Pos: common.Vector2{X: 200, Y: 100}, Vel: common.Vector2{Y: -150}, Radius: 10}
+	p.UpdateTargets([]common.TargetInfo{target})
+
+	for _, speed := range []float64{600, 420, 380} {
+		p.SetLeadSpeed(speed)
+		p.ProcessInput(p.entity, 16*time.Millisecond)
+
+		lead, ok := p.GetLeadPoint()
+		if !ok {
+			t.Fatalf("speed %v: no lead point with predictive aim on", speed)
+		}
+		if want := InterceptPoint(p.entity.GetPosition(), speed, target.Pos, target.Vel); lead != want {
+			t.Errorf("speed %v: lead point = %v, want the intercept %v", speed, lead, want)
+		}
+		if lead == target.Pos {
+			t.Errorf("speed %v: lead point is the target's position", speed)
+		}
+	}
+
+	p.SetPredictiveAim(false)
+	p.ProcessInput(p.entity, 16*time.Millisecond)
+	if _, ok := p.GetLeadPoint(); ok {
+		t.Error("lead point shown with predictive aim off")
+	}
+}
+
+func TestHaltLeavesPlayerStationary(t *testing.T) {
+	im := input.New()
+	e := NewEntity(1, common.Vector2{})
+	p := NewPlayerInput(im, DefaultPlayerInputConfig(), e)
+
+	// Build up speed, then stop with a press still pending
+	im.ApplySnapshot(input.InputSnapshot{MoveX: 1})
+	for range 10 {
+		p.ProcessInput(e, 16*time.Millisecond)
+	}
+	im.ApplySnapshot(input.InputSnapshot{Actions: map[common.Action]common.ActionState{
+		common.ActionAutoAttack: {Active: true, JustPressed: true},
+	}})
+
+	autoAim := p.IsAutoAimEnabled()
+	p.Halt()
+	p.ProcessInput(e, 16*time.Millisecond)
+
+	if v := e.GetVelocity(); v != (common.Vector2{}) {
+		t.Errorf("velocity = %v after Halt, want zero", v)
+	}
+	if p.IsAutoAimEnabled() != autoAim {
+		t.Error("press from before Halt toggled auto-aim")
+	}
+}
+
+func TestWalkAnimationSpeed(t *testing.T) {
+	tests := []struct {
+		magnitude float64
+		want      float64
+	}{
+		{1, 1},
+		{0.5, 0.5},
+		{0.1, 0.1},
+		{0, 0},
+		{1.4, 1},
+	}
+
+	for _, tt := range tests {
+		if got := WalkAnimationSpeed(tt.magnitude); got != tt.want {
+			t.Errorf("WalkAnimationSpeed(%v) = %v, want %v", tt.magnitude, got, tt.want)
+		}
+	}
+}
+
+// stoppingDistance returns how far the player coasts from full speed with no
+// input, stepping by dt
+func stoppingDistance(config PlayerInputConfig, dt time.Duration) float64 {
+	p := NewPlayerInput(nil, config, NewEntity(1, common.Vector2{}))
+	velocity := common.Vector2{X: config.MaxSpeed}
+
+	var distance float64
+	for velocity != (common.Vector2{}) {
+		velocity = p.decelerate(velocity, dt)
+		distance += velocity.Magnitude() * dt.Seconds()
+	}
+	return distance
+}
+
+func TestStoppingDistanceByMode(t *testing.T) {
+	config := DefaultPlayerInputConfig()
+	speed := config.MaxSpeed
+
+	tests := []struct {
+		mode DecelerationMode
+		want float64
+	}{
+		// v² / 2a
+		{DecelerationLinear, speed * speed / (2 * config.Deceleration)},
+		// Integral of v·drag^t, v / -ln(drag)
+		{DecelerationExponential, speed / -math.Log(config.Drag)},
+		{DecelerationInstant, 0},
+	}
+
+	distances := make(map[DecelerationMode]float64)
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			config.DecelerationMode = tt.mode
+			got := stoppingDistance(config, time.Millisecond)
+			if math.Abs(got-tt.want) > 0.5 {
+				t.Errorf("stopped after %.2f units, want %.2f", got, tt.want)
+			}
+			distances[tt.mode] = got
+		})
+	}
+
+	// With the defaults the drag coasts furthest and instant not at all
+	if !(distances[DecelerationInstant] < distances[DecelerationLinear] &&
+		distances[DecelerationLinear] < distances[DecelerationExponential]) {
+		t.Errorf("stopping distances %v, want instant < linear < exponential", distances)
+	}
+}
+
+func TestFacingXBySource(t *testing.T) {
+	// Aiming right while backpedalling left
+	aim := common.Vector2{X: 1}
+	velocity := common.Vector2{X: -200, Y: 50}
+	moveInput := common.Vector2{X: -1}
+
+	tests := []struct {
+		source   FlipSource
+		input    common.Vector2
+		wantSign float64
+	}{
+		{FlipAim, moveInput, 1},
+		{FlipVelocity, moveInput, -1},
+		{FlipMovementInput, moveInput, -1},
+		// Still sliding left after turning the stick right
+		{FlipVelocity, common.Vector2{X: 1}, -1},
+		{FlipMovementInput, common.Vector2{X: 1}, 1},
+		// No input keeps the current facing
+		{FlipMovementInput, common.Vector2{}, 0},
+	}
+
+	for _, tt := range tests {
+		got := FacingX(tt.source, aim, velocity, tt.input)
+		var sign float64
+		if got != 0 {
+			sign = math.Copysign(1, got)
+		}
+		if sign != tt.wantSign {
+			t.Errorf("FacingX(%v, input %v) = %v, want sign %v", tt.source, tt.input, got, tt.wantSign)
+		}
+	}
+}
+
+func TestAutoAimTurnRateIgnoresStepSize(t *testing.T) {
+	const simulated = 100 * time.Millisecond
+	target := []common.TargetInfo{{ID: 2, Pos: common.Vector2{Y: 200}, Radius: 10}}
+
+	// Not long enough to reach the target, so every step size is mid-turn
+	want := DefaultPlayerInputConfig().RotationSpeed * simulated.Seconds()
+
+	for _, step := range []time.Duration{time.Millisecond, 5 * time.Millisecond, 20 * time.Millisecond, 50 * time.Millisecond} {
+		p := newAimer()
+		p.UpdateTargets(target)
+		for elapsed := time.Duration(0); elapsed < simulated; elapsed += step {
+			p.updateAiming(p.entity, step)
+		}
+
+		if got := p.entity.GetRotation(); math.Abs(got-want) > 1e-9 {
+			t.Errorf("step %v: rotation = %v, want %v", step, got, want)
+		}
+	}
+}
+
+func TestRotateTowardsDoesNotOvershoot(t *testing.T) {
+	tests := []struct {
+		name                     string
+		current, target, maxStep float64
+		want                     float64
+	}{
+		{"partial turn", 0, 1, 0.25, 0.25},
+		{"reaches target", 0, 0.2, 0.25, 0.2},
+		{"turns the short way across pi", 3, -3, 0.1, 3.1},
+		{"negative direction", 0.5, -0.5, 0.3, 0.2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rotateTowards(tt.current, tt.target, tt.maxStep)
+			if math.Abs(common.AngleDifference(got, tt.want)) > 1e-9 {
+				t.Errorf("rotateTowards() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
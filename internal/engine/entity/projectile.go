@@ -0,0 +1,336 @@
+package entity
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"math"
+	"novampires-go/internal/common"
+	"time"
+)
+
+// ProjectileConfig contains the flight parameters of a weapon's projectiles
+type ProjectileConfig struct {
+	Speed    float64       // Launch speed in world units per second
+	Radius   float64       // Drawn and hit size
+	Lifetime time.Duration // Time before the projectile expires
+	Color    color.RGBA
+
+	// Number of recent positions drawn as a fading trail, 0 for none
+	TrailLength int
+
+	// Damage dealt to whatever the projectile hits
+	Damage     int
+	DamageType DamageType
+
+	// Chance from 0 to 1 that a hit is critical, multiplying its damage
+	CritChance     float64
+	CritMultiplier float64
+
+	// Status effect applied to what the projectile hits, none if its
+	// duration is 0
+	Effect StatusEffect
+
+	// Downward acceleration in world units per second squared, 0 for a
+	// straight shot
+	Gravity float64
+
+	// Homing projectiles steer toward the nearest target within HomingRange,
+	// turning at most TurnRate radians per second
+	Homing      bool
+	HomingRange float64
+	TurnRate    float64
+}
+
+// DefaultProjectileConfig returns a straight, fast shot
+func DefaultProjectileConfig() ProjectileConfig {
+	return ProjectileConfig{
+		Speed:    600.0,
+		Radius:   4.0,
+		Lifetime: 2 * time.Second,
+		Color:    color.RGBA{255, 255, 255, 255},
+
+		TrailLength: 6,
+
+		Damage:     10,
+		DamageType: DamagePhysical,
+
+		CritChance:     0.1,
+		CritMultiplier: 2.0,
+	}
+}
+
+// RollDamage returns the damage of a single hit and whether it was critical.
+// The roll is only drawn from rng when the config can crit.
+func (c ProjectileConfig) RollDamage(rng *common.Rng) (int, bool) {
+	if c.CritChance <= 0 || rng == nil || rng.Float64() >= c.CritChance {
+		return c.Damage, false
+	}
+	return int(math.Round(float64(c.Damage) * c.CritMultiplier)), true
+}
+
+// ArcingProjectileConfig returns a slower lobbed shot that falls under gravity
+// and sets what it hits burning
+func ArcingProjectileConfig() ProjectileConfig {
+	config := DefaultProjectileConfig()
+	config.Speed = 420.0
+	config.Radius = 6.0
+	config.Gravity = 600.0
+	config.Damage = 25
+	config.Effect = Burning()
+	return config
+}
+
+// HomingProjectileConfig returns a shot that curves toward nearby targets and
+// poisons them
+func HomingProjectileConfig() ProjectileConfig {
+	config := DefaultProjectileConfig()
+	config.Speed = 380.0
+	config.Lifetime = 3 * time.Second
+	config.Homing = true
+	config.HomingRange = 350.0
+	config.TurnRate = math.Pi
+	config.Damage = 8
+	config.Effect = Poison()
+	return config
+}
+
+// InterceptPoint returns where to aim a projectile of the given speed fired
+// from origin so it meets a target moving at constant velocity. If the
+// target can't be caught it returns the target's current position.
+func InterceptPoint(origin common.Vector2, speed float64, targetPos, targetVel common.Vector2) common.Vector2 {
+	offset := targetPos.Sub(origin)
+
+	// Solve |offset + targetVel*t| = speed*t for the earliest positive t
+	a := targetVel.Dot(targetVel) - speed*speed
+	b := 2 * offset.Dot(targetVel)
+	c := offset.Dot(offset)
+
+	var t float64
+	if math.Abs(a) < 1e-9 {
+		if b >= 0 {
+			return targetPos
+		}
+		t = -c / b
+	} else {
+		disc := b*b - 4*a*c
+		if disc < 0 {
+			return targetPos
+		}
+		sqrtDisc := math.Sqrt(disc)
+		t1 := (-b - sqrtDisc) / (2 * a)
+		t2 := (-b + sqrtDisc) / (2 * a)
+		t = min(t1, t2)
+		if t <= 0 {
+			t = max(t1, t2)
+		}
+	}
+	if t <= 0 {
+		return targetPos
+	}
+
+	return targetPos.Add(targetVel.Scale(t))
+}
+
+// SteerTowards turns velocity toward a point by at most maxTurn radians,
+// keeping its speed
+func SteerTowards(position, velocity, point common.Vector2, maxTurn float64) common.Vector2 {
+	speed := velocity.Magnitude()
+	toPoint := point.Sub(position)
+	if speed == 0 || toPoint.MagnitudeSquared() == 0 {
+		return velocity
+	}
+
+	angle := rotateTowards(velocity.Angle(), toPoint.Angle(), maxTurn)
+	return common.Vector2{X: math.Cos(angle) * speed, Y: math.Sin(angle) * speed}
+}
+
+// Projectile is a single shot in flight
+type Projectile struct {
+	Position common.Vector2
+	Velocity common.Vector2
+
+	config ProjectileConfig
+	age    time.Duration
+	trail  *TrailComponent
+
+	// Reused homing query buffer
+	nearby []common.TargetInfo
+}
+
+// NewProjectile launches a projectile from pos in a direction
+func NewProjectile(pos, direction common.Vector2, config ProjectileConfig) *Projectile {
+	p := &Projectile{
+		Position: pos,
+		Velocity: direction.Normalized().Scale(config.Speed),
+		config:   config,
+	}
+	if config.TrailLength > 0 {
+		p.trail = NewTrailComponent(config.TrailLength, config.Radius, config.Color)
+	}
+	return p
+}
+
+// GetConfig returns the projectile's configuration
+func (p *Projectile) GetConfig() ProjectileConfig {
+	return p.config
+}
+
+// GetTrail returns the projectile's trail, nil if its config has none
+func (p *Projectile) GetTrail() *TrailComponent {
+	return p.trail
+}
+
+// Expired returns whether the projectile has outlived its lifetime
+func (p *Projectile) Expired() bool {
+	return p.age >= p.config.Lifetime
+}
+
+// Update applies gravity and homing and moves the projectile. Homing
+// targets are found through query, which may be nil for unguided shots.
+func (p *Projectile) Update(dt time.Duration, query TargetQuery) {
+	p.age += dt
+	seconds := dt.Seconds()
+
+	if p.config.Gravity != 0 {
+		p.Velocity.Y += p.config.Gravity * seconds
+	}
+
+	if p.config.Homing && query != nil {
+		p.nearby = query.QueryTargets(p.Position, p.config.HomingRange, p.nearby[:0])
+		if target, ok := nearestTarget(p.Position, p.nearby); ok {
+			aim := InterceptPoint(p.Position, p.Velocity.Magnitude(), target.Pos, target.Vel)
+			p.Velocity = SteerTowards(p.Position, p.Velocity, aim, p.config.TurnRate*seconds)
+		}
+	}
+
+	p.Position = p.Position.Add(p.Velocity.Scale(seconds))
+	if p.trail != nil {
+		p.trail.Record(p.Position)
+	}
+}
+
+// nearestTarget returns the target closest to pos
+func nearestTarget(pos common.Vector2, targets []common.TargetInfo) (common.TargetInfo, bool) {
+	best := -1
+	bestDistSq := math.Inf(1)
+	for i, t := range targets {
+		if distSq := t.Pos.DistanceSquared(pos); distSq < bestDistSq {
+			best = i
+			bestDistSq = distSq
+		}
+	}
+	if best < 0 {
+		return common.TargetInfo{}, false
+	}
+	return targets[best], true
+}
+
+// Draw draws the projectile as a filled circle over its trail
+func (p *Projectile) Draw(screen *ebiten.Image, renderer Renderer) {
+	if p.trail != nil {
+		p.trail.Draw(screen, renderer, p.Position)
+	}
+	renderer.DrawCircle(screen, p.Position, p.config.Radius, p.config.Color)
+}
+
+// DefaultProjectileCullMargin is how far outside the bounds a projectile may
+// fly before it is removed, leaving room for arcing shots to fall back in
+const DefaultProjectileCullMargin = 200.0
+
+// Projectiles owns the shots in flight
+type Projectiles struct {
+	active []*Projectile
+	query  TargetQuery
+
+	// Projectiles further than margin outside bounds are removed
+	bounds    common.Rectangle
+	margin    float64
+	hasBounds bool
+}
+
+// NewProjectiles creates an empty set. Homing shots find targets through
+// query, usually the entity manager.
+func NewProjectiles(query TargetQuery) *Projectiles {
+	return &Projectiles{query: query}
+}
+
+// Fire launches a projectile and returns it
+func (s *Projectiles) Fire(pos, direction common.Vector2, config ProjectileConfig) *Projectile {
+	p := NewProjectile(pos, direction, config)
+	s.active = append(s.active, p)
+	return p
+}
+
+// Count returns the number of projectiles in flight
+func (s *Projectiles) Count() int {
+	return len(s.active)
+}
+
+// All returns the projectiles in flight. The slice is only valid until the
+// next Update.
+func (s *Projectiles) All() []*Projectile {
+	return s.active
+}
+
+// SetBounds removes projectiles once they are more than margin outside
+// bounds, usually the camera viewport or the arena, even before their
+// lifetime runs out. Call it again whenever the bounds move.
+func (s *Projectiles) SetBounds(bounds common.Rectangle, margin float64) {
+	s.bounds = bounds
+	s.margin = max(margin, 0)
+	s.hasBounds = true
+}
+
+// ClearBounds lets projectiles fly anywhere until their lifetime runs out
+func (s *Projectiles) ClearBounds() {
+	s.hasBounds = false
+}
+
+// Update moves every projectile and removes expired ones and those that
+// left the bounds
+func (s *Projectiles) Update(dt time.Duration) {
+	keep := common.Rectangle{
+		Pos:  common.Vector2{X: s.bounds.Pos.X - s.margin, Y: s.bounds.Pos.Y - s.margin},
+		Size: common.Vector2{X: s.bounds.Size.X + s.margin*2, Y: s.bounds.Size.Y + s.margin*2},
+	}
+
+	alive := s.active[:0]
+	for _, p := range s.active {
+		p.Update(dt, s.query)
+		if p.Expired() || (s.hasBounds && !keep.Contains(p.Position)) {
+			continue
+		}
+		alive = append(alive, p)
+	}
+	clear(s.active[len(alive):])
+	s.active = alive
+}
+
+// Collide calls hit for every projectile in flight and removes the ones it
+// reports as having hit something. It returns how many were removed.
+func (s *Projectiles) Collide(hit func(p *Projectile) bool) int {
+	alive := s.active[:0]
+	for _, p := range s.active {
+		if hit(p) {
+			continue
+		}
+		alive = append(alive, p)
+	}
+	removed := len(s.active) - len(alive)
+	clear(s.active[len(alive):])
+	s.active = alive
+	return removed
+}
+
+// Clear removes every projectile in flight
+func (s *Projectiles) Clear() {
+	clear(s.active)
+	s.active = s.active[:0]
+}
+
+// Draw draws every projectile in flight
+func (s *Projectiles) Draw(screen *ebiten.Image, renderer Renderer) {
+	for _, p := range s.active {
+		p.Draw(screen, renderer)
+	}
+}
@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+	"time"
+)
+
+// fixedTargets is a TargetQuery that always returns the same targets
+type fixedTargets []common.TargetInfo
+
+func (f fixedTargets) QueryTargets(center common.Vector2, radius float64, out []common.TargetInfo) []common.TargetInfo {
+	return append(out, f...)
+}
+
+// angleTo returns the angle between the projectile's heading and point
+func angleTo(p *Projectile, point common.Vector2) float64 {
+	diff := math.Abs(p.Velocity.Angle() - point.Sub(p.Position).Angle())
+	return math.Min(diff, 2*math.Pi-diff)
+}
+
+func TestProjectileHomingTurnsTowardTarget(t *testing.T) {
+	target := common.Vector2{X: 0, Y: 300}
+	query := fixedTargets{{ID: 1, Pos: target, Radius: 10}}
+	p := NewProjectile(common.Vector2{}, common.Vector2{X: 1}, HomingProjectileConfig())
+
+	prev := angleTo(p, target)
+	for step := range 5 {
+		p.Update(16*time.Millisecond, query)
+		got := angleTo(p, target)
+		if got >= prev {
+			t.Fatalf("step %d: angle to target %.3f, want less than %.3f", step, got, prev)
+		}
+		prev = got
+	}
+}
+
+func TestProjectileHomingKeepsSpeed(t *testing.T) {
+	config := HomingProjectileConfig()
+	query := fixedTargets{{ID: 1, Pos: common.Vector2{X: 0, Y: 300}, Radius: 10}}
+	p := NewProjectile(common.Vector2{}, common.Vector2{X: 1}, config)
+
+	p.Update(16*time.Millisecond, query)
+	if got := p.Velocity.Magnitude(); math.Abs(got-config.Speed) > 1e-6 {
+		t.Errorf("speed = %v, want %v", got, config.Speed)
+	}
+}
+
+func TestProjectileWithoutTargetsFliesStraight(t *testing.T) {
+	p := NewProjectile(common.Vector2{}, common.Vector2{X: 1}, HomingProjectileConfig())
+	p.Update(16*time.Millisecond, fixedTargets{})
+
+	if p.Velocity.Y != 0 {
+		t.Errorf("velocity = %v, want it unchanged along X", p.Velocity)
+	}
+}
+
+func TestProjectileGravityIncreasesFallSpeed(t *testing.T) {
+	p := NewProjectile(common.Vector2{}, common.Vector2{X: 1, Y: -1}, ArcingProjectileConfig())
+
+	prev := p.Velocity.Y
+	for step := range 5 {
+		p.Update(16*time.Millisecond, nil)
+		if p.Velocity.Y <= prev {
+			t.Fatalf("step %d: vertical velocity %.3f, want more than %.3f", step, p.Velocity.Y, prev)
+		}
+		prev = p.Velocity.Y
+	}
+}
+
+func TestProjectilesCollide(t *testing.T) {
+	projectiles := NewProjectiles(nil)
+	hit := projectiles.Fire(common.Vector2{X: 10}, common.Vector2{X: 1}, DefaultProjectileConfig())
+	miss := projectiles.Fire(common.Vector2{X: -10}, common.Vector2{X: 1}, DefaultProjectileConfig())
+
+	removed := projectiles.Collide(func(p *Projectile) bool { return p == hit })
+	if removed != 1 {
+		t.Errorf("Collide removed %d, want 1", removed)
+	}
+	if all := projectiles.All(); len(all) != 1 || all[0] != miss {
+		t.Errorf("remaining projectiles = %v, want only the miss", all)
+	}
+}
+
+func TestProjectilesRemovedOutsideBounds(t *testing.T) {
+	config := DefaultProjectileConfig()
+	projectiles := NewProjectiles(nil)
+	projectiles.SetBounds(common.Rectangle{Size: common.Vector2{X: 100, Y: 100}}, 10)
+
+	p := projectiles.Fire(common.Vector2{X: 50, Y: 50}, common.Vector2{X: 1}, config)
+	for projectiles.Count() > 0 {
+		if p.Expired() {
+			t.Fatal("projectile outlived its lifetime inside the bounds")
+		}
+		projectiles.Update(16 * time.Millisecond)
+	}
+
+	if p.Position.X <= 110 {
+		t.Errorf("removed at x = %v, still inside the margin", p.Position.X)
+	}
+	if p.Expired() {
+		t.Error("removed by lifetime instead of bounds")
+	}
+}
+
+func TestProjectilesWithoutBoundsLiveOutLifetime(t *testing.T) {
+	config := DefaultProjectileConfig()
+	projectiles := NewProjectiles(nil)
+	projectiles.SetBounds(common.Rectangle{Size: common.Vector2{X: 100, Y: 100}}, 10)
+	projectiles.ClearBounds()
+
+	p := projectiles.Fire(common.Vector2{X: 50, Y: 50}, common.Vector2{X: 1}, config)
+	projectiles.Update(config.Lifetime - time.Millisecond)
+	if projectiles.Count() != 1 {
+		t.Fatalf("projectile at %v removed before its lifetime without bounds", p.Position)
+	}
+
+	projectiles.Update(time.Millisecond)
+	if projectiles.Count() != 0 {
+		t.Error("projectile kept after its lifetime")
+	}
+}
+
+func TestRollDamage(t *testing.T) {
+	config := DefaultProjectileConfig()
+
+	tests := []struct {
+		name     string
+		chance   float64
+		want     int
+		critical bool
+	}{
+		{"never crits", 0, config.Damage, false},
+		{"always crits", 1, int(float64(config.Damage) * config.CritMultiplier), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.CritChance = tt.chance
+			rng := common.NewRng(1)
+			got, critical := config.RollDamage(rng)
+			if got != tt.want || critical != tt.critical {
+				t.Errorf("RollDamage = %d, %v, want %d, %v", got, critical, tt.want, tt.critical)
+			}
+			if tt.chance == 0 && rng.Draws() != 0 {
+				t.Errorf("drew %d numbers without a crit chance", rng.Draws())
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"time"
+)
+
+// RecoilConfig describes the kick a weapon gives its wielder when fired
+type RecoilConfig struct {
+	Kick     float64       // Velocity pushed opposite the aim direction, world units per second
+	Climb    float64       // Aim angle thrown off per shot in radians, alternating sides
+	Recovery time.Duration // Time for the aim to settle back after a shot
+}
+
+// DefaultRecoilConfig returns a subtle recoil suitable for light weapons
+func DefaultRecoilConfig() RecoilConfig {
+	return RecoilConfig{
+		Kick:     40.0,
+		Climb:    0.05,
+		Recovery: 150 * time.Millisecond,
+	}
+}
+
+// recoilState tracks the aim offset left by recent shots
+type recoilState struct {
+	offset   float64 // Current aim angle offset in radians
+	recovery float64 // Radians recovered per second
+	side     float64 // Side the next shot throws the aim to
+}
+
+// kick adds a shot's aim offset
+func (r *recoilState) kick(config RecoilConfig) {
+	if r.side == 0 {
+		r.side = 1
+	}
+	r.offset += config.Climb * r.side
+	r.side = -r.side
+
+	if config.Recovery > 0 {
+		r.recovery = math.Abs(r.offset) / config.Recovery.Seconds()
+	} else {
+		r.offset = 0
+	}
+}
+
+// recover moves the aim offset back toward zero
+func (r *recoilState) recover(dt time.Duration) {
+	step := r.recovery * dt.Seconds()
+	if math.Abs(r.offset) <= step {
+		r.offset = 0
+		return
+	}
+	r.offset -= math.Copysign(step, r.offset)
+}
+
+// RecoilImpulse returns the velocity change a shot applies to its shooter,
+// pointing opposite the aim direction
+func RecoilImpulse(aimAngle float64, config RecoilConfig) common.Vector2 {
+	return common.Vector2{
+		X: -math.Cos(aimAngle) * config.Kick,
+		Y: -math.Sin(aimAngle) * config.Kick,
+	}
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+	"time"
+)
+
+func TestRecoilImpulseOpposesAim(t *testing.T) {
+	config := DefaultRecoilConfig()
+
+	tests := []struct {
+		name string
+		aim  float64
+	}{
+		{"right", 0},
+		{"down", math.Pi / 2},
+		{"left", math.Pi},
+		{"up left", -3 * math.Pi / 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aim := common.Vector2{X: math.Cos(tt.aim), Y: math.Sin(tt.aim)}
+			impulse := RecoilImpulse(tt.aim, config)
+
+			if got := impulse.Magnitude(); math.Abs(got-config.Kick) > 1e-9 {
+				t.Errorf("impulse magnitude = %v, want %v", got, config.Kick)
+			}
+			if got := impulse.Dot(aim); math.Abs(got+config.Kick) > 1e-9 {
+				t.Errorf("impulse %v is not opposite the aim %v", impulse, aim)
+			}
+		})
+	}
+}
+
+func TestRecoilKickAlternatesAndRecovers(t *testing.T) {
+	config := RecoilConfig{Climb: 0.1, Recovery: 100 * time.Millisecond}
+	var r recoilState
+
+	r.kick(config)
+	if r.offset <= 0 {
+		t.Fatalf("first kick offset = %v, want positive", r.offset)
+	}
+	r.kick(config)
+	if r.offset != 0 {
+		t.Errorf("second kick offset = %v, want it thrown back to 0", r.offset)
+	}
+
+	r.kick(config)
+	r.recover(config.Recovery)
+	if r.offset != 0 {
+		t.Errorf("offset after recovery = %v, want 0", r.offset)
+	}
+}
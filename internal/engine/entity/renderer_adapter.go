@@ -27,9 +27,11 @@ func (r *RendererAdapter) DrawSprite(
 	position common.Vector2,
 	rotation float64,
 	scale float64,
+	stretch common.Vector2,
+	origin common.Vector2,
 	flipX bool,
 ) {
-	r.renderer.DrawPlayerSprite(screen, sprite, position, rotation, scale, flipX)
+	r.renderer.DrawPlayerSprite(screen, sprite, position, rotation, scale, stretch, origin, flipX)
 }
 
 // DrawLayeredSprite draws a sprite with an overlay (like eyes) with the wrapped renderer
@@ -38,6 +40,8 @@ func (r *RendererAdapter) DrawLayeredSprite(
 	baseSprite, overlaySprite *ebiten.Image,
 	position, overlayOffset common.Vector2,
 	rotation, scale float64,
+	stretch common.Vector2,
+	origin common.Vector2,
 	flipX bool,
 ) {
 	r.renderer.DrawLayeredPlayerSprite(
@@ -48,6 +52,8 @@ func (r *RendererAdapter) DrawLayeredSprite(
 		overlayOffset,
 		rotation,
 		scale,
+		stretch,
+		origin,
 		flipX,
 	)
 }
@@ -62,6 +68,15 @@ func (r *RendererAdapter) DrawAimLine(
 	r.renderer.DrawAimLine(screen, start, direction, length)
 }
 
+// DrawReticle draws an aim reticle at a world position
+func (r *RendererAdapter) DrawReticle(
+	screen *ebiten.Image,
+	worldPos common.Vector2,
+	style rendering.ReticleStyle,
+) {
+	r.renderer.DrawReticle(screen, worldPos, style)
+}
+
 // DrawCircle draws a filled circle in world coordinates
 func (r *RendererAdapter) DrawCircle(
 	screen *ebiten.Image,
@@ -72,6 +87,38 @@ func (r *RendererAdapter) DrawCircle(
 	r.renderer.DrawCircle(screen, position, radius, fill)
 }
 
+// DrawCircleBlend draws a filled circle in world coordinates with the given blend mode
+func (r *RendererAdapter) DrawCircleBlend(
+	screen *ebiten.Image,
+	position common.Vector2,
+	radius float64,
+	fill color.RGBA,
+	mode rendering.BlendMode,
+) {
+	r.renderer.DrawCircleBlend(screen, position, radius, fill, mode)
+}
+
+// DrawArc draws part of a circle outline in world coordinates
+func (r *RendererAdapter) DrawArc(
+	screen *ebiten.Image,
+	center common.Vector2,
+	radius, startAngle, endAngle float64,
+	lineWidth float64,
+	stroke color.RGBA,
+) {
+	r.renderer.DrawArc(screen, center, radius, startAngle, endAngle, lineWidth, stroke)
+}
+
+// DrawSector draws a filled pie slice in world coordinates
+func (r *RendererAdapter) DrawSector(
+	screen *ebiten.Image,
+	center common.Vector2,
+	radius, startAngle, endAngle float64,
+	fill color.RGBA,
+) {
+	r.renderer.DrawSector(screen, center, radius, startAngle, endAngle, fill)
+}
+
 // DrawLine draws a line in world coordinates
 func (r *RendererAdapter) DrawLine(
 	screen *ebiten.Image,
@@ -82,6 +129,36 @@ func (r *RendererAdapter) DrawLine(
 	r.renderer.DrawLine(screen, start, end, lineWidth, stroke)
 }
 
+// DrawLineBlend draws a line in world coordinates with the given blend mode
+func (r *RendererAdapter) DrawLineBlend(
+	screen *ebiten.Image,
+	start, end common.Vector2,
+	lineWidth float64,
+	stroke color.RGBA,
+	mode rendering.BlendMode,
+) {
+	r.renderer.DrawLineBlend(screen, start, end, lineWidth, stroke, mode)
+}
+
+// DrawRect draws a filled rectangle in world coordinates
+func (r *RendererAdapter) DrawRect(
+	screen *ebiten.Image,
+	rect common.Rectangle,
+	fill color.RGBA,
+) {
+	r.renderer.DrawRect(screen, rect, fill)
+}
+
+// DrawRectOutline draws a rectangle outline in world coordinates
+func (r *RendererAdapter) DrawRectOutline(
+	screen *ebiten.Image,
+	rect common.Rectangle,
+	lineWidth float64,
+	stroke color.RGBA,
+) {
+	r.renderer.DrawRectOutline(screen, rect, lineWidth, stroke)
+}
+
 // DrawHealthBar draws a health bar in world coordinates
 func (r *RendererAdapter) DrawHealthBar(
 	screen *ebiten.Image,
@@ -92,7 +169,71 @@ func (r *RendererAdapter) DrawHealthBar(
 	r.renderer.DrawHealthBar(screen, position, width, height, percent)
 }
 
+// DrawFramedHealthBar draws a bordered, optionally rounded health bar in world coordinates
+func (r *RendererAdapter) DrawFramedHealthBar(
+	screen *ebiten.Image,
+	position common.Vector2,
+	width, height float64,
+	percent float64,
+	frame rendering.HealthBarFrame,
+) {
+	r.renderer.DrawFramedHealthBar(screen, position, width, height, percent, frame)
+}
+
+// DrawWorldText draws text centered on a world position
+func (r *RendererAdapter) DrawWorldText(
+	screen *ebiten.Image,
+	text string,
+	position common.Vector2,
+	scale float64,
+	fill color.RGBA,
+	outline bool,
+) {
+	r.renderer.DrawWorldText(screen, text, position, scale, fill, outline)
+}
+
+// DrawWorldLabel draws debug text anchored to a world position
+func (r *RendererAdapter) DrawWorldLabel(
+	screen *ebiten.Image,
+	text string,
+	worldPos common.Vector2,
+	col color.RGBA,
+) {
+	r.renderer.DrawWorldLabel(screen, text, worldPos, col)
+}
+
+// DrawHUDText draws text at a screen position, scaled by the UI scale
+func (r *RendererAdapter) DrawHUDText(screen *ebiten.Image, text string, pos common.Vector2, col color.RGBA) {
+	r.renderer.DrawHUDText(screen, text, pos, col)
+}
+
+// DrawHUDTextScaled draws text centered on a screen position, scaled on top
+// of the UI scale
+func (r *RendererAdapter) DrawHUDTextScaled(screen *ebiten.Image, text string, pos common.Vector2, scale float64, col color.RGBA) {
+	r.renderer.DrawHUDTextScaled(screen, text, pos, scale, col)
+}
+
+// DrawCooldownRing draws an ability cooldown ring on the HUD
+func (r *RendererAdapter) DrawCooldownRing(screen *ebiten.Image, center common.Vector2, radius float64, progress float64) {
+	r.renderer.DrawCooldownRing(screen, center, radius, progress)
+}
+
+// DrawOffscreenIndicators draws arrows at the screen edge toward off-screen targets
+func (r *RendererAdapter) DrawOffscreenIndicators(targets []common.TargetInfo) {
+	r.renderer.DrawOffscreenIndicators(targets)
+}
+
 // DrawGrid draws a reference grid
 func (r *RendererAdapter) DrawGrid(screen *ebiten.Image) {
 	r.renderer.DrawGrid(screen)
 }
+
+// WithAntiAlias runs draw with anti-aliasing forced on or off
+func (r *RendererAdapter) WithAntiAlias(aa rendering.AntiAlias, draw func()) {
+	r.renderer.WithAntiAlias(aa, draw)
+}
+
+// WithFont runs draw with every text drawn in font
+func (r *RendererAdapter) WithFont(font rendering.Font, draw func()) {
+	r.renderer.WithFont(font, draw)
+}
@@ -1,9 +1,11 @@
 package entity
 
 import (
+	"fmt"
 	"github.com/hajimehoshi/ebiten/v2"
 	"image"
 	"novampires-go/internal/common"
+	"novampires-go/internal/engine/rendering"
 	"novampires-go/internal/engine/sprite"
 	"time"
 )
@@ -15,39 +17,55 @@ type SpriteComponent struct {
 	sprite      *ebiten.Image
 
 	// Animation management
-	animations     map[string]*sprite.Animation
-	currentAnim    string
-	lastUpdateTime time.Time
+	animations  map[string]*sprite.Animation
+	currentAnim string
 
 	// Rendering properties
-	scale float64
-	flipX bool
+	scale     float64
+	worldSize float64 // frame width in world units, 0 draws at native pixel size
+	flipX     bool
+	origin    common.Vector2 // normalized (0..1) pivot within the frame
+
+	// Added to the entity's Y when depth sorting, e.g. to sort by the feet
+	sortOffset float64
 
 	// Secondary sprite layers (e.g., eyes)
 	secondarySprite      *ebiten.Image
 	secondarySpriteSheet *ebiten.Image
 	secondaryOffset      common.Vector2
 	secondaryController  AnimationController
+
+	// One-shot animation played now and then while idle
+	fidgetAnim    string
+	idleAnim      string
+	fidgetTrigger *sprite.IntervalTrigger
+	fidgetArmed   bool // Trigger restarted since the sprite last became idle
+
+	// Optional speed and impulse based deformation, nil draws undeformed
+	squash *SquashStretch
 }
 
 // NewSpriteComponent creates a new sprite component
 func NewSpriteComponent() *SpriteComponent {
 	return &SpriteComponent{
-		animations:     make(map[string]*sprite.Animation),
-		scale:          1.0,
-		lastUpdateTime: time.Now(),
+		animations: make(map[string]*sprite.Animation),
+		scale:      1.0,
+		origin:     rendering.CenterOrigin,
 	}
 }
 
 // Update updates the sprite and animations
-func (s *SpriteComponent) Update(entity *Entity) {
-	currentTime := time.Now()
-	deltaTime := currentTime.Sub(s.lastUpdateTime)
-	s.lastUpdateTime = currentTime
+func (s *SpriteComponent) Update(entity *Entity, deltaTime time.Duration) {
+	// Fidget only counts down while idle
+	s.updateFidget(deltaTime)
 
 	// Update animation
 	s.updateAnimation(deltaTime)
 
+	if s.squash != nil {
+		s.squash.Update(entity.Velocity, deltaTime)
+	}
+
 	// Update secondary animation controller if available
 	if s.secondaryController != nil {
 		s.secondaryController.Update(deltaTime)
@@ -62,7 +80,7 @@ func (s *SpriteComponent) updateAnimation(deltaTime time.Duration) {
 	}
 
 	// Update the current animation
-	if anim, exists := s.animations[s.currentAnim]; exists && anim != nil {
+	if anim, exists := s.animations[s.currentAnim]; exists {
 		anim.Update(deltaTime)
 
 		// Update the sprite frame based on current animation
@@ -80,6 +98,60 @@ func (s *SpriteComponent) updateAnimation(deltaTime time.Duration) {
 	}
 }
 
+// SetFidget plays the one-shot animation fidget every min to min+spread of
+// uninterrupted idle time, returning to idle once it finishes. Both
+// animations must already be added and fidget must not loop.
+func (s *SpriteComponent) SetFidget(fidget, idle string, min, spread time.Duration, rng *common.Rng) error {
+	anim, ok := s.animations[fidget]
+	if !ok {
+		return fmt.Errorf("fidget animation %q not found", fidget)
+	}
+	if anim.Loop {
+		return fmt.Errorf("fidget animation %q loops", fidget)
+	}
+	if _, ok := s.animations[idle]; !ok {
+		return fmt.Errorf("idle animation %q not found", idle)
+	}
+
+	s.fidgetAnim = fidget
+	s.idleAnim = idle
+	s.fidgetArmed = false
+	s.fidgetTrigger = sprite.NewIntervalTrigger(min, spread, rng, func() {
+		s.PlayAnimation(s.fidgetAnim)
+	})
+	anim.OnFinish = func() {
+		if s.currentAnim == s.fidgetAnim {
+			s.PlayAnimation(s.idleAnim)
+		}
+	}
+	return nil
+}
+
+// updateFidget counts down to the next fidget while idle
+func (s *SpriteComponent) updateFidget(dt time.Duration) {
+	if s.fidgetTrigger == nil {
+		return
+	}
+
+	switch s.currentAnim {
+	case s.idleAnim:
+		// Each stretch of idling waits a fresh delay
+		if !s.fidgetArmed {
+			s.fidgetTrigger.Reset()
+			s.fidgetArmed = true
+		}
+		s.fidgetTrigger.Update(dt)
+	case s.fidgetAnim:
+	default:
+		s.fidgetArmed = false
+	}
+}
+
+// IsFidgeting returns whether the idle fidget animation is playing
+func (s *SpriteComponent) IsFidgeting() bool {
+	return s.fidgetTrigger != nil && s.currentAnim == s.fidgetAnim
+}
+
 // SetSpriteSheet sets the main sprite sheet
 func (s *SpriteComponent) SetSpriteSheet(sheet *ebiten.Image) {
 	s.spriteSheet = sheet
@@ -97,7 +169,7 @@ func (s *SpriteComponent) setupDefaultAnimations() {
 	idleFrames := []sprite.FrameData{
 		{SrcX: 0, SrcY: 0, SrcWidth: 96, SrcHeight: 96, Duration: 150},
 	}
-	s.animations["idle"] = sprite.NewAnimation(idleFrames, true)
+	s.animations["idle"] = sprite.MustAnimation(sprite.NewAnimation(idleFrames, true))
 	s.currentAnim = "idle"
 }
 
@@ -113,19 +185,25 @@ func (s *SpriteComponent) SetSecondaryOffset(offset common.Vector2) {
 }
 
 // AddAnimation adds an animation to the sprite
-func (s *SpriteComponent) AddAnimation(name string, frames []sprite.FrameData, loop bool) {
-	s.animations[name] = sprite.NewAnimation(frames, loop)
+func (s *SpriteComponent) AddAnimation(name string, frames []sprite.FrameData, loop bool) error {
+	anim, err := sprite.NewAnimation(frames, loop)
+	if err != nil {
+		return fmt.Errorf("animation %q: %w", name, err)
+	}
+	s.animations[name] = anim
 
 	// If this is the first animation, set it as current
 	if s.currentAnim == "" {
 		s.currentAnim = name
 		s.animations[name].Reset()
 	}
+
+	return nil
 }
 
 // PlayAnimation changes the current animation
 func (s *SpriteComponent) PlayAnimation(name string) {
-	if anim, exists := s.animations[name]; exists && anim != nil {
+	if anim, exists := s.animations[name]; exists {
 		s.currentAnim = name
 		anim.Reset()
 	}
@@ -154,11 +232,61 @@ func (s *SpriteComponent) SetScale(scale float64) {
 	s.scale = scale
 }
 
+// SetWorldSize sets how many world units wide a frame is drawn, independent
+// of the sprite sheet's resolution. Zero draws frames at their native size.
+func (s *SpriteComponent) SetWorldSize(size float64) {
+	s.worldSize = size
+}
+
+// GetWorldSize returns the frame width in world units (0 = native size)
+func (s *SpriteComponent) GetWorldSize() float64 {
+	return s.worldSize
+}
+
+// DrawScale returns the scale the current frame is drawn at, before camera zoom
+func (s *SpriteComponent) DrawScale() float64 {
+	if s.sprite == nil {
+		return s.scale
+	}
+	return rendering.SpriteDrawScale(float64(s.sprite.Bounds().Dx()), s.worldSize, s.scale)
+}
+
 // GetScale returns the sprite scale
 func (s *SpriteComponent) GetScale() float64 {
 	return s.scale
 }
 
+// SetSquash enables squash and stretch, or disables it when nil
+func (s *SpriteComponent) SetSquash(squash *SquashStretch) {
+	s.squash = squash
+}
+
+// GetSquash returns the squash and stretch state, or nil if disabled
+func (s *SpriteComponent) GetSquash() *SquashStretch {
+	return s.squash
+}
+
+// SetSortOffset sets the offset added to the entity's Y when depth sorting
+func (s *SpriteComponent) SetSortOffset(offset float64) {
+	s.sortOffset = offset
+}
+
+// GetSortOffset returns the depth sort offset
+func (s *SpriteComponent) GetSortOffset() float64 {
+	return s.sortOffset
+}
+
+// SetOrigin sets the sprite origin as a normalized point within the frame.
+// (0.5, 0.5) is the center, (0.5, 1) anchors the sprite at its feet.
+func (s *SpriteComponent) SetOrigin(origin common.Vector2) {
+	s.origin = origin
+}
+
+// GetOrigin returns the normalized sprite origin
+func (s *SpriteComponent) GetOrigin() common.Vector2 {
+	return s.origin
+}
+
 // SetFlipX sets whether the sprite should be flipped horizontally
 func (s *SpriteComponent) SetFlipX(flip bool) {
 	s.flipX = flip
@@ -179,23 +307,84 @@ func (s *SpriteComponent) GetCurrentAnimation() string {
 	return s.currentAnim
 }
 
+// FrameOffset returns the current frame's draw offset in world units,
+// scaled like the sprite and mirrored when it is flipped
+func (s *SpriteComponent) FrameOffset() common.Vector2 {
+	anim, exists := s.animations[s.currentAnim]
+	if !exists {
+		return common.Vector2{}
+	}
+
+	frame := anim.GetCurrentFrame()
+	if frame.OffsetX == 0 && frame.OffsetY == 0 {
+		return common.Vector2{}
+	}
+
+	scale := s.DrawScale()
+	offset := common.Vector2{
+		X: float64(frame.OffsetX) * scale,
+		Y: float64(frame.OffsetY) * scale,
+	}
+	if s.flipX {
+		offset.X = -offset.X
+	}
+	return offset
+}
+
 func (s *SpriteComponent) GetCurrentFrame() int {
-	if anim, exists := s.animations[s.currentAnim]; exists && anim != nil {
+	if anim, exists := s.animations[s.currentAnim]; exists {
 		return anim.GetCurrentFrameInt()
 	}
 	return 0
 }
 
+// GetFrameCount returns the number of frames in the current animation
+func (s *SpriteComponent) GetFrameCount() int {
+	if anim, exists := s.animations[s.currentAnim]; exists {
+		return anim.FrameCount()
+	}
+	return 0
+}
+
+// SetFrame jumps the current animation to frame i
+func (s *SpriteComponent) SetFrame(i int) {
+	if anim, exists := s.animations[s.currentAnim]; exists {
+		anim.SetFrame(i)
+	}
+}
+
+// SetPaused stops or resumes the current animation
+func (s *SpriteComponent) SetPaused(paused bool) {
+	if anim, exists := s.animations[s.currentAnim]; exists {
+		anim.SetPaused(paused)
+	}
+}
+
+// IsPaused returns whether the current animation is paused
+func (s *SpriteComponent) IsPaused() bool {
+	if anim, exists := s.animations[s.currentAnim]; exists {
+		return anim.IsPaused()
+	}
+	return false
+}
+
 // ReverseAnimation reverses the current animation
 func (s *SpriteComponent) ReverseAnimation() {
-	if anim, exists := s.animations[s.currentAnim]; exists && anim != nil {
+	if anim, exists := s.animations[s.currentAnim]; exists {
 		anim.Reverse()
 	}
 }
 
+// SetAnimationSpeed sets the playback rate of the current animation
+func (s *SpriteComponent) SetAnimationSpeed(speed float64) {
+	if anim, exists := s.animations[s.currentAnim]; exists {
+		anim.SetSpeed(speed)
+	}
+}
+
 // IsReversed returns whether the current animation is reversed
 func (s *SpriteComponent) IsReversed() bool {
-	if anim, exists := s.animations[s.currentAnim]; exists && anim != nil {
+	if anim, exists := s.animations[s.currentAnim]; exists {
 		return anim.IsReversed()
 	}
 	return false
@@ -207,6 +396,14 @@ func (s *SpriteComponent) Draw(screen *ebiten.Image, renderer Renderer, entity *
 		return
 	}
 
+	scale := s.DrawScale()
+	position := entity.Position.Add(s.FrameOffset())
+
+	stretch := common.Vector2{X: 1, Y: 1}
+	if s.squash != nil {
+		stretch = s.squash.Scale()
+	}
+
 	// Draw main sprite and secondary sprite if available
 	if s.secondaryController != nil || s.secondarySprite != nil {
 		secondarySprite := s.GetSecondarySprite()
@@ -214,19 +411,23 @@ func (s *SpriteComponent) Draw(screen *ebiten.Image, renderer Renderer, entity *
 			screen,
 			s.sprite,
 			secondarySprite,
-			entity.Position,
+			position,
 			s.secondaryOffset,
 			entity.Rotation,
-			s.scale,
+			scale,
+			stretch,
+			s.origin,
 			s.flipX,
 		)
 	} else {
 		renderer.DrawSprite(
 			screen,
 			s.sprite,
-			entity.Position,
+			position,
 			entity.Rotation,
-			s.scale,
+			scale,
+			stretch,
+			s.origin,
 			s.flipX)
 	}
 }
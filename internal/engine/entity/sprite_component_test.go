@@ -0,0 +1,197 @@
+package entity
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/sprite"
+	"testing"
+	"time"
+)
+
+// fidgetDelay is how long the test sprite idles before fidgeting
+const fidgetDelay = time.Second
+
+// newFidgetSprite returns an idling sprite with a walk cycle and a 200ms
+// fidget played after fidgetDelay of idling
+func newFidgetSprite(t *testing.T) *SpriteComponent {
+	t.Helper()
+	frames := func(n int) []sprite.FrameData {
+		out := make([]sprite.FrameData, n)
+		for i := range out {
+			out[i] = sprite.FrameData{SrcX: i * 16, SrcWidth: 16, SrcHeight: 16, Duration: 100}
+		}
+		return out
+	}
+
+	s := NewSpriteComponent()
+	s.SetSpriteSheet(ebiten.NewImage(64, 16))
+	for _, anim := range []struct {
+		name   string
+		frames int
+		loop   bool
+	}{{"idle", 1, true}, {"walk", 4, true}, {"fidget", 2, false}} {
+		if err := s.AddAnimation(anim.name, frames(anim.frames), anim.loop); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.PlayAnimation("idle")
+
+	if err := s.SetFidget("fidget", "idle", fidgetDelay, 0, common.NewRng(1)); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// advance updates the sprite in 100ms steps for d
+func advance(s *SpriteComponent, d time.Duration) {
+	e := NewEntity(1, common.Vector2{})
+	for step := 100 * time.Millisecond; d > 0; d -= step {
+		s.Update(e, step)
+	}
+}
+
+func TestFidgetFiresAfterIdleDelay(t *testing.T) {
+	s := newFidgetSprite(t)
+
+	advance(s, fidgetDelay-100*time.Millisecond)
+	if s.IsFidgeting() {
+		t.Fatal("fidgeting before the delay")
+	}
+
+	advance(s, 100*time.Millisecond)
+	if !s.IsFidgeting() {
+		t.Fatalf("playing %q after the delay, want the fidget", s.GetCurrentAnimation())
+	}
+
+	// The fidget's OnFinish hands back to idle
+	advance(s, 200*time.Millisecond)
+	if s.IsFidgeting() || s.GetCurrentAnimation() != "idle" {
+		t.Errorf("playing %q after the fidget finished, want idle", s.GetCurrentAnimation())
+	}
+}
+
+func TestFidgetRearmsAfterWalking(t *testing.T) {
+	s := newFidgetSprite(t)
+	advance(s, 600*time.Millisecond)
+
+	// Walking never fidgets
+	s.PlayAnimation("walk")
+	advance(s, 2*fidgetDelay)
+	if s.IsFidgeting() {
+		t.Fatal("fidgeted while walking")
+	}
+
+	// Idling again waits a full delay, not what was left before walking
+	s.PlayAnimation("idle")
+	advance(s, 600*time.Millisecond)
+	if s.IsFidgeting() {
+		t.Fatal("fidgeted before a fresh delay after walking")
+	}
+
+	advance(s, fidgetDelay-600*time.Millisecond)
+	if !s.IsFidgeting() {
+		t.Errorf("playing %q after a fresh delay, want the fidget", s.GetCurrentAnimation())
+	}
+}
+
+func TestSetFidgetRejects(t *testing.T) {
+	tests := []struct {
+		name         string
+		fidget, idle string
+	}{
+		{"missing fidget", "wave", "idle"},
+		{"looping fidget", "walk", "idle"},
+		{"missing idle", "fidget", "rest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newFidgetSprite(t)
+			if err := s.SetFidget(tt.fidget, tt.idle, fidgetDelay, 0, common.NewRng(1)); err == nil {
+				t.Error("SetFidget() = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestWorldSizeDrawScale(t *testing.T) {
+	s := NewSpriteComponent()
+	s.SetSpriteSheet(ebiten.NewImage(192, 96))
+	err := s.AddAnimation("idle", []sprite.FrameData{{SrcWidth: 96, SrcHeight: 96, Duration: 100}}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.PlayAnimation("idle")
+	s.Update(NewEntity(1, common.Vector2{}), 0)
+
+	if got := s.DrawScale(); got != 1 {
+		t.Errorf("native draw scale = %v, want 1", got)
+	}
+
+	s.SetWorldSize(48)
+	if got := s.DrawScale(); got != 0.5 {
+		t.Errorf("draw scale at a 48 unit world size = %v, want 0.5", got)
+	}
+}
+
+// spriteRenderer records where sprites are drawn
+type spriteRenderer struct {
+	Renderer
+	positions []common.Vector2
+}
+
+func (r *spriteRenderer) DrawSprite(screen *ebiten.Image, sprite *ebiten.Image, position common.Vector2, rotation float64, scale float64, stretch common.Vector2, origin common.Vector2, flipX bool) {
+	r.positions = append(r.positions, position)
+}
+
+func TestFrameOffsetAppliedToDraw(t *testing.T) {
+	frames := []sprite.FrameData{
+		{SrcWidth: 96, SrcHeight: 96, Duration: 100},
+		{SrcX: 96, SrcWidth: 96, SrcHeight: 96, Duration: 100, OffsetX: 8, OffsetY: -4},
+	}
+
+	tests := []struct {
+		name  string
+		frame int
+		flipX bool
+		want  common.Vector2
+	}{
+		{"no offset", 0, false, common.Vector2{}},
+		{"offset scaled to world size", 1, false, common.Vector2{X: 4, Y: -2}},
+		{"offset mirrored when flipped", 1, true, common.Vector2{X: -4, Y: -2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSpriteComponent()
+			s.SetSpriteSheet(ebiten.NewImage(192, 96))
+			if err := s.AddAnimation("swing", frames, true); err != nil {
+				t.Fatal(err)
+			}
+			s.SetWorldSize(48)
+			s.PlayAnimation("swing")
+			s.SetFlipX(tt.flipX)
+
+			e := NewEntity(1, common.Vector2{X: 100, Y: 50})
+			s.Update(e, 0)
+			s.animations["swing"].SetFrame(tt.frame)
+			s.Update(e, 0)
+
+			if got := s.animations["swing"].GetCurrentFrame(); got.OffsetX != frames[tt.frame].OffsetX || got.OffsetY != frames[tt.frame].OffsetY {
+				t.Errorf("current frame offset = %d,%d, want %d,%d", got.OffsetX, got.OffsetY, frames[tt.frame].OffsetX, frames[tt.frame].OffsetY)
+			}
+			if got := s.FrameOffset(); got != tt.want {
+				t.Errorf("FrameOffset() = %v, want %v", got, tt.want)
+			}
+
+			renderer := &spriteRenderer{}
+			s.Draw(nil, renderer, e)
+			if len(renderer.positions) != 1 {
+				t.Fatalf("drew %d sprites, want 1", len(renderer.positions))
+			}
+			if got, want := renderer.positions[0], e.Position.Add(tt.want); got != want {
+				t.Errorf("drawn at %v, want %v", got, want)
+			}
+		})
+	}
+}
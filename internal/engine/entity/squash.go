@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"time"
+)
+
+// SquashConfig contains squash and stretch tuning parameters
+type SquashConfig struct {
+	SpeedStretch float64 // Stretch amount per world unit per second of speed
+	MaxAmount    float64 // Largest squash or stretch amount either way
+	Recovery     float64 // Rate impulses decay toward 0, per second
+}
+
+// DefaultSquashConfig returns default squash and stretch configuration
+func DefaultSquashConfig() SquashConfig {
+	return SquashConfig{
+		SpeedStretch: 0.0005,
+		MaxAmount:    0.35,
+		Recovery:     10.0,
+	}
+}
+
+// SquashScale returns the X/Y draw scale for a squash amount. Positive
+// amounts stretch the sprite taller and thinner, negative ones squash it
+// shorter and wider, keeping its area the same.
+func SquashScale(amount float64) common.Vector2 {
+	y := 1 + max(amount, -0.9)
+	return common.Vector2{X: 1 / y, Y: y}
+}
+
+// SpeedStretch returns the stretch amount for moving at speed
+func SpeedStretch(speed float64, config SquashConfig) float64 {
+	return min(speed*config.SpeedStretch, config.MaxAmount)
+}
+
+// SquashStretch deforms a sprite with speed and with impulses such as
+// landing, easing back to its normal shape
+type SquashStretch struct {
+	config SquashConfig
+
+	// Stretch from the entity's current speed
+	speedAmount float64
+
+	// Decaying squash or stretch from impulses
+	impulse float64
+}
+
+// NewSquashStretch creates an undeformed squash and stretch state
+func NewSquashStretch(config SquashConfig) *SquashStretch {
+	return &SquashStretch{config: config}
+}
+
+// Update follows the entity's speed and decays impulses by dt
+func (q *SquashStretch) Update(velocity common.Vector2, dt time.Duration) {
+	q.speedAmount = SpeedStretch(velocity.Magnitude(), q.config)
+	q.impulse *= math.Exp(-q.config.Recovery * dt.Seconds())
+}
+
+// Impulse adds a momentary deformation, negative to squash (e.g. landing
+// from a dash) and positive to stretch
+func (q *SquashStretch) Impulse(amount float64) {
+	q.impulse += amount
+}
+
+// Amount returns the combined squash amount, limited to MaxAmount
+func (q *SquashStretch) Amount() float64 {
+	return min(max(q.speedAmount+q.impulse, -q.config.MaxAmount), q.config.MaxAmount)
+}
+
+// Scale returns the X/Y draw scale for the current amount
+func (q *SquashStretch) Scale() common.Vector2 {
+	return SquashScale(q.Amount())
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+	"time"
+)
+
+func TestSpeedStretchClamps(t *testing.T) {
+	config := DefaultSquashConfig()
+
+	tests := []struct {
+		name  string
+		speed float64
+		want  float64
+	}{
+		{"standing", 0, 0},
+		{"walking", 300, 300 * config.SpeedStretch},
+		{"at the limit", config.MaxAmount / config.SpeedStretch, config.MaxAmount},
+		{"far past the limit", 100000, config.MaxAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SpeedStretch(tt.speed, config); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("SpeedStretch(%v) = %v, want %v", tt.speed, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImpulseDecaysToZero(t *testing.T) {
+	q := NewSquashStretch(DefaultSquashConfig())
+	q.Impulse(-0.3)
+
+	previous := math.Abs(q.Amount())
+	for range 60 {
+		q.Update(common.Vector2{}, 16*time.Millisecond)
+		amount := math.Abs(q.Amount())
+		if amount > previous {
+			t.Fatalf("impulse grew from %v to %v", previous, amount)
+		}
+		previous = amount
+	}
+
+	if previous > 1e-3 {
+		t.Errorf("amount = %v after a second, want close to 0", previous)
+	}
+}
+
+func TestImpulseClampsToMaxAmount(t *testing.T) {
+	config := DefaultSquashConfig()
+	q := NewSquashStretch(config)
+
+	q.Impulse(-5)
+	if got := q.Amount(); got != -config.MaxAmount {
+		t.Errorf("Amount() = %v after a huge squash, want %v", got, -config.MaxAmount)
+	}
+}
+
+func TestSquashScalePreservesArea(t *testing.T) {
+	for _, amount := range []float64{-0.9, -0.35, -0.1, 0, 0.1, 0.35, 1} {
+		scale := SquashScale(amount)
+		if area := scale.X * scale.Y; math.Abs(area-1) > 1e-9 {
+			t.Errorf("SquashScale(%v) = %v, area %v, want 1", amount, scale, area)
+		}
+	}
+}
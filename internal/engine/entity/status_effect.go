@@ -0,0 +1,173 @@
+package entity
+
+import "time"
+
+// StackRule decides what happens when an effect is applied while the same
+// effect is already active
+type StackRule int
+
+const (
+	// StackRefresh restarts the existing effect's duration
+	StackRefresh StackRule = iota
+	// StackIntensify adds a stack, up to MaxStacks, multiplying the tick
+	// damage, and restarts the duration
+	StackIntensify
+	// StackIndependent runs each application as its own effect
+	StackIndependent
+)
+
+func (r StackRule) String() string {
+	switch r {
+	case StackRefresh:
+		return "Refresh"
+	case StackIntensify:
+		return "Intensify"
+	case StackIndependent:
+		return "Independent"
+	default:
+		return "Unknown"
+	}
+}
+
+// StatusEffect describes damage dealt over time, such as burning or poison
+type StatusEffect struct {
+	Name          string
+	DamagePerTick int
+	TickInterval  time.Duration
+	Duration      time.Duration
+	DamageType    DamageType
+	Stacking      StackRule
+	MaxStacks     int // Stack limit for StackIntensify, at least 1
+}
+
+// Burning returns a short, strong fire effect that refreshes on reapplication
+func Burning() StatusEffect {
+	return StatusEffect{
+		Name:          "burning",
+		DamagePerTick: 4,
+		TickInterval:  250 * time.Millisecond,
+		Duration:      2 * time.Second,
+		DamageType:    DamageTrue,
+		Stacking:      StackRefresh,
+		MaxStacks:     1,
+	}
+}
+
+// Poison returns a long, weak effect that stacks up to five times
+func Poison() StatusEffect {
+	return StatusEffect{
+		Name:          "poison",
+		DamagePerTick: 1,
+		TickInterval:  500 * time.Millisecond,
+		Duration:      5 * time.Second,
+		DamageType:    DamageTrue,
+		Stacking:      StackIntensify,
+		MaxStacks:     5,
+	}
+}
+
+// activeEffect is an applied status effect and its progress
+type activeEffect struct {
+	effect    StatusEffect
+	stacks    int
+	remaining time.Duration
+	sinceTick time.Duration
+}
+
+// StatusEffectComponent holds the status effects active on an entity and
+// applies their damage to its health
+type StatusEffectComponent struct {
+	active []activeEffect
+}
+
+// NewStatusEffectComponent creates a component with no active effects
+func NewStatusEffectComponent() *StatusEffectComponent {
+	return &StatusEffectComponent{}
+}
+
+// ApplyEffect starts an effect, combining it with an active effect of the
+// same name according to the effect's stack rule
+func (s *StatusEffectComponent) ApplyEffect(effect StatusEffect) {
+	if effect.Duration <= 0 {
+		return
+	}
+
+	if effect.Stacking != StackIndependent {
+		for i := range s.active {
+			active := &s.active[i]
+			if active.effect.Name != effect.Name {
+				continue
+			}
+
+			if effect.Stacking == StackIntensify {
+				active.stacks = min(active.stacks+1, max(effect.MaxStacks, 1))
+			}
+			active.effect = effect
+			active.remaining = effect.Duration
+			return
+		}
+	}
+
+	s.active = append(s.active, activeEffect{
+		effect:    effect,
+		stacks:    1,
+		remaining: effect.Duration,
+	})
+}
+
+// Update advances every effect by dt, dealing tick damage to health and
+// dropping expired effects. It returns the health removed. A tick that
+// lands exactly as an effect expires still counts.
+func (s *StatusEffectComponent) Update(health *HealthComponent, dt time.Duration) int {
+	total := 0
+	active := s.active[:0]
+	for _, a := range s.active {
+		step := min(dt, a.remaining)
+		a.remaining -= step
+
+		if a.effect.TickInterval > 0 {
+			a.sinceTick += step
+			for a.sinceTick >= a.effect.TickInterval {
+				a.sinceTick -= a.effect.TickInterval
+				if health != nil {
+					total += health.TakeDamage(a.effect.DamagePerTick*a.stacks, a.effect.DamageType)
+				}
+			}
+		}
+
+		if a.remaining > 0 {
+			active = append(active, a)
+		}
+	}
+
+	clear(s.active[len(active):])
+	s.active = active
+	return total
+}
+
+// Has returns whether an effect with the given name is active
+func (s *StatusEffectComponent) Has(name string) bool {
+	return s.Stacks(name) > 0
+}
+
+// Stacks returns the total stacks of the named effect across its active
+// applications, 0 if it is not active
+func (s *StatusEffectComponent) Stacks(name string) int {
+	stacks := 0
+	for _, a := range s.active {
+		if a.effect.Name == name {
+			stacks += a.stacks
+		}
+	}
+	return stacks
+}
+
+// Count returns the number of active effect applications
+func (s *StatusEffectComponent) Count() int {
+	return len(s.active)
+}
+
+// Clear removes all active effects
+func (s *StatusEffectComponent) Clear() {
+	s.active = s.active[:0]
+}
@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+// tickEffect returns a true-damage effect dealing 1 every 100ms for 1s
+func tickEffect(name string, stacking StackRule) StatusEffect {
+	return StatusEffect{
+		Name:          name,
+		DamagePerTick: 1,
+		TickInterval:  100 * time.Millisecond,
+		Duration:      time.Second,
+		DamageType:    DamageTrue,
+		Stacking:      stacking,
+		MaxStacks:     3,
+	}
+}
+
+func TestStatusEffectTicksAccumulate(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []time.Duration
+		want  int
+	}{
+		{"short of a tick", []time.Duration{99 * time.Millisecond}, 0},
+		{"exactly one tick", []time.Duration{100 * time.Millisecond}, 1},
+		{"small steps add up", []time.Duration{40 * time.Millisecond, 40 * time.Millisecond, 40 * time.Millisecond}, 1},
+		{"long step deals several ticks", []time.Duration{350 * time.Millisecond}, 3},
+		{"tick on expiry counts", []time.Duration{time.Second}, 10},
+		{"nothing after expiry", []time.Duration{time.Second, time.Second}, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health := NewHealthComponent(100)
+			status := NewStatusEffectComponent()
+			status.ApplyEffect(tickEffect("burn", StackRefresh))
+
+			total := 0
+			for _, dt := range tt.steps {
+				total += status.Update(health, dt)
+			}
+			if total != tt.want {
+				t.Errorf("damage = %d, want %d", total, tt.want)
+			}
+			if got := 100 - health.GetCurrent(); got != tt.want {
+				t.Errorf("health lost = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusEffectExpires(t *testing.T) {
+	status := NewStatusEffectComponent()
+	status.ApplyEffect(tickEffect("burn", StackRefresh))
+
+	status.Update(nil, time.Second-time.Millisecond)
+	if !status.Has("burn") {
+		t.Fatal("effect expired before its duration")
+	}
+	status.Update(nil, time.Millisecond)
+	if status.Has("burn") || status.Count() != 0 {
+		t.Error("effect still active after its duration")
+	}
+}
+
+func TestStatusEffectStacking(t *testing.T) {
+	tests := []struct {
+		name       string
+		stacking   StackRule
+		applies    int
+		wantStacks int
+		wantCount  int
+	}{
+		{"refresh keeps one stack", StackRefresh, 3, 1, 1},
+		{"intensify adds stacks", StackIntensify, 2, 2, 1},
+		{"intensify is capped", StackIntensify, 5, 3, 1},
+		{"independent runs separately", StackIndependent, 3, 3, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := NewStatusEffectComponent()
+			for range tt.applies {
+				status.ApplyEffect(tickEffect("poison", tt.stacking))
+			}
+			if got := status.Stacks("poison"); got != tt.wantStacks {
+				t.Errorf("stacks = %d, want %d", got, tt.wantStacks)
+			}
+			if got := status.Count(); got != tt.wantCount {
+				t.Errorf("applications = %d, want %d", got, tt.wantCount)
+			}
+
+			health := NewHealthComponent(100)
+			if got := status.Update(health, 100*time.Millisecond); got != tt.wantStacks {
+				t.Errorf("tick damage = %d, want %d", got, tt.wantStacks)
+			}
+		})
+	}
+}
+
+func TestStatusEffectReapplyRestartsDuration(t *testing.T) {
+	status := NewStatusEffectComponent()
+	status.ApplyEffect(tickEffect("burn", StackRefresh))
+	status.Update(nil, 900*time.Millisecond)
+
+	status.ApplyEffect(tickEffect("burn", StackRefresh))
+	status.Update(nil, 900*time.Millisecond)
+	if !status.Has("burn") {
+		t.Error("reapplied effect expired on the original schedule")
+	}
+}
@@ -0,0 +1,94 @@
+package entity
+
+import "novampires-go/internal/common"
+
+// targetGridCellSize is the cell size of the manager's target grid in world units
+const targetGridCellSize = 256.0
+
+// TargetQuery finds auto-aim targets near a point, such as a Manager
+type TargetQuery interface {
+	// QueryTargets appends the targets whose edge lies within radius of
+	// center to out and returns it
+	QueryTargets(center common.Vector2, radius float64, out []common.TargetInfo) []common.TargetInfo
+}
+
+// GetTargetInfo describes the entity as an auto-aim target, sized by its
+// collision radius
+func (e *Entity) GetTargetInfo() common.TargetInfo {
+	var radius float64
+	if e.collision != nil {
+		radius = e.collision.Radius
+	}
+
+	return common.TargetInfo{
+		ID:     e.ID,
+		Pos:    e.Position,
+		Vel:    e.Velocity,
+		Radius: radius,
+	}
+}
+
+// isTarget returns whether auto-aim may pick the entity: it has health left
+// and is not being removed
+func (e *Entity) isTarget() bool {
+	return e.health != nil && !e.isDead() && !e.removed
+}
+
+// QueryTargets appends the living entities with health whose edge lies
+// within radius of center to out and returns it
+func (m *Manager) QueryTargets(center common.Vector2, radius float64, out []common.TargetInfo) []common.TargetInfo {
+	m.found = m.QueryEntities(center, radius, m.found[:0])
+	for _, e := range m.found {
+		out = append(out, e.GetTargetInfo())
+	}
+	clear(m.found)
+	return out
+}
+
+// QueryEntities appends the living entities with health whose edge lies
+// within radius of center to out and returns it
+func (m *Manager) QueryEntities(center common.Vector2, radius float64, out []*Entity) []*Entity {
+	// Entities are being compacted during an update pass, the grid is
+	// rebuilt once it is done
+	if m.gridDirty && !m.updating {
+		m.rebuildGrid()
+	}
+
+	// The grid holds centers, so widen the query by the largest target
+	m.nearby = m.grid.QueryRadius(center, radius+m.gridMaxRadius, m.nearby[:0])
+	for _, entry := range m.nearby {
+		e := m.gridEntities[entry.ID]
+		if !e.isTarget() {
+			continue
+		}
+
+		target := e.GetTargetInfo()
+		if target.Pos.Sub(center).Magnitude()-target.Radius <= radius {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// rebuildGrid indexes the current targets by position. Entries store an
+// index into gridEntities rather than the entity ID.
+func (m *Manager) rebuildGrid() {
+	m.grid.Clear()
+	clear(m.gridEntities)
+	m.gridEntities = m.gridEntities[:0]
+	m.gridMaxRadius = 0
+
+	for _, e := range m.entities {
+		if !e.isTarget() {
+			continue
+		}
+
+		m.grid.Insert(uint64(len(m.gridEntities)), e.Position)
+		m.gridEntities = append(m.gridEntities, e)
+		if e.collision != nil {
+			m.gridMaxRadius = max(m.gridMaxRadius, e.collision.Radius)
+		}
+	}
+
+	m.gridDirty = false
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/rendering"
+)
+
+// TrailComponent remembers an entity's recent positions and draws them as a
+// tapered, fading line behind it
+type TrailComponent struct {
+	// Line width at the entity end of the trail, tapering to zero at the tail
+	Width float64
+
+	// Color at the entity end of the trail
+	Color color.RGBA
+
+	// Opacity multiplier at the tail end (0 = fully faded, 1 = no fade)
+	Fade float64
+
+	// Ring buffer of recorded positions
+	points []common.Vector2
+	head   int // Index the next sample is written to
+	count  int
+}
+
+// NewTrailComponent creates a trail remembering the last length positions
+func NewTrailComponent(length int, width float64, col color.RGBA) *TrailComponent {
+	return &TrailComponent{
+		Width:  width,
+		Color:  col,
+		Fade:   0,
+		points: make([]common.Vector2, max(length, 0)),
+	}
+}
+
+// Record adds a position, dropping the oldest once the trail is full
+func (t *TrailComponent) Record(pos common.Vector2) {
+	if len(t.points) == 0 {
+		return
+	}
+
+	t.points[t.head] = pos
+	t.head = (t.head + 1) % len(t.points)
+	t.count = min(t.count+1, len(t.points))
+}
+
+// Clear forgets all recorded positions
+func (t *TrailComponent) Clear() {
+	t.head = 0
+	t.count = 0
+}
+
+// Len returns the number of recorded positions
+func (t *TrailComponent) Len() int {
+	return t.count
+}
+
+// Cap returns the maximum number of positions the trail remembers
+func (t *TrailComponent) Cap() int {
+	return len(t.points)
+}
+
+// Points appends the recorded positions to dst, oldest first
+func (t *TrailComponent) Points(dst []common.Vector2) []common.Vector2 {
+	for i := 0; i < t.count; i++ {
+		dst = append(dst, t.at(i))
+	}
+	return dst
+}
+
+// at returns the i-th recorded position, oldest first
+func (t *TrailComponent) at(i int) common.Vector2 {
+	start := t.head - t.count + len(t.points)
+	return t.points[(start+i)%len(t.points)]
+}
+
+// Draw draws the trail from the oldest sample up to head, the current
+// position of whatever leaves it
+func (t *TrailComponent) Draw(screen *ebiten.Image, renderer Renderer, head common.Vector2) {
+	if t.count == 0 {
+		return
+	}
+
+	for i := 0; i < t.count; i++ {
+		start := t.at(i)
+		end := head
+		if i+1 < t.count {
+			end = t.at(i + 1)
+		}
+
+		// 0 at the tail, 1 at the entity
+		progress := float64(i+1) / float64(t.count)
+
+		col := rendering.FadeColor(t.Color, t.Fade+(1-t.Fade)*progress)
+		if col.A == 0 {
+			continue
+		}
+
+		renderer.DrawLine(screen, start, end, t.Width*progress, col)
+	}
+}
@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"novampires-go/internal/common"
+	"slices"
+	"testing"
+	"time"
+)
+
+// positions returns x positions 1..n on the x axis
+func positions(n int) []common.Vector2 {
+	out := make([]common.Vector2, n)
+	for i := range out {
+		out[i] = common.Vector2{X: float64(i + 1)}
+	}
+	return out
+}
+
+func TestTrailRecordsOldestFirst(t *testing.T) {
+	tests := []struct {
+		name     string
+		length   int
+		recorded int
+		want     []common.Vector2
+	}{
+		{"partly filled", 4, 3, positions(3)},
+		{"exactly full", 4, 4, positions(4)},
+		{"wrapped once", 4, 6, positions(6)[2:]},
+		{"wrapped many times", 3, 11, positions(11)[8:]},
+		{"zero length", 0, 5, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trail := NewTrailComponent(tt.length, 2, color.RGBA{255, 255, 255, 255})
+			for _, pos := range positions(tt.recorded) {
+				trail.Record(pos)
+			}
+
+			if got := trail.Len(); got != len(tt.want) {
+				t.Errorf("Len() = %d, want %d", got, len(tt.want))
+			}
+			if got := trail.Points(nil); !slices.Equal(got, tt.want) {
+				t.Errorf("Points() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrailDropsSamplesPastLength(t *testing.T) {
+	trail := NewTrailComponent(3, 2, color.RGBA{255, 255, 255, 255})
+	for _, pos := range positions(3) {
+		trail.Record(pos)
+	}
+
+	trail.Record(common.Vector2{X: 4})
+	got := trail.Points(nil)
+	if slices.Contains(got, common.Vector2{X: 1}) {
+		t.Errorf("Points() = %v still holds the oldest sample past the length", got)
+	}
+	if trail.Len() != trail.Cap() {
+		t.Errorf("Len() = %d, want it capped at %d", trail.Len(), trail.Cap())
+	}
+
+	trail.Clear()
+	trail.Record(common.Vector2{X: 9})
+	if got := trail.Points(nil); !slices.Equal(got, []common.Vector2{{X: 9}}) {
+		t.Errorf("Points() = %v after Clear, want only the new sample", got)
+	}
+}
+
+// lineRenderer records the lines drawn
+type lineRenderer struct {
+	Renderer
+	widths []float64
+	colors []color.RGBA
+}
+
+func (r *lineRenderer) DrawLine(screen *ebiten.Image, start, end common.Vector2, lineWidth float64, stroke color.RGBA) {
+	r.widths = append(r.widths, lineWidth)
+	r.colors = append(r.colors, stroke)
+}
+
+func (r *lineRenderer) DrawCircle(screen *ebiten.Image, position common.Vector2, radius float64, fill color.RGBA) {
+}
+
+func TestTrailDrawFadesAndTapers(t *testing.T) {
+	trail := NewTrailComponent(4, 8, color.RGBA{200, 100, 50, 255})
+	for _, pos := range positions(4) {
+		trail.Record(pos)
+	}
+
+	renderer := &lineRenderer{}
+	trail.Draw(nil, renderer, common.Vector2{X: 5})
+	if len(renderer.colors) != 4 {
+		t.Fatalf("drew %d segments, want 4", len(renderer.colors))
+	}
+
+	for i, col := range renderer.colors {
+		if col.R > col.A || col.G > col.A || col.B > col.A {
+			t.Errorf("segment %d color %v exceeds its alpha", i, col)
+		}
+		if i > 0 && (col.A <= renderer.colors[i-1].A || renderer.widths[i] <= renderer.widths[i-1]) {
+			t.Errorf("segment %d is not more opaque and wider than the one behind it", i)
+		}
+	}
+	if last := renderer.colors[3]; last != trail.Color {
+		t.Errorf("segment at the head = %v, want the full color %v", last, trail.Color)
+	}
+}
+
+func TestProjectileLeavesTrail(t *testing.T) {
+	config := DefaultProjectileConfig()
+	p := NewProjectile(common.Vector2{}, common.Vector2{X: 1}, config)
+	trail := p.GetTrail()
+	if trail == nil || trail.Cap() != config.TrailLength {
+		t.Fatalf("trail = %v, want one remembering %d positions", trail, config.TrailLength)
+	}
+
+	for range config.TrailLength + 2 {
+		p.Update(16*time.Millisecond, nil)
+	}
+	if got := trail.Len(); got != config.TrailLength {
+		t.Errorf("trail length = %d after flying, want %d", got, config.TrailLength)
+	}
+
+	renderer := &lineRenderer{}
+	p.Draw(nil, renderer)
+	if len(renderer.colors) != config.TrailLength {
+		t.Errorf("drew %d trail segments, want %d", len(renderer.colors), config.TrailLength)
+	}
+
+	config.TrailLength = 0
+	if p := NewProjectile(common.Vector2{}, common.Vector2{X: 1}, config); p.GetTrail() != nil {
+		t.Error("projectile without a trail length has a trail")
+	}
+}
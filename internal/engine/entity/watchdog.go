@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"novampires-go/internal/common"
+)
+
+// WatchdogComponent returns an entity to safety when collision resolution
+// leaves it outside the world bounds or wedged in place
+type WatchdogComponent struct {
+	// World area the entity must stay inside, nil to skip the bounds check
+	Bounds *common.Rectangle
+
+	// Where a stuck entity is teleported to
+	Home common.Vector2
+
+	// Frames an entity may fail to move while trying to before it is sent home
+	StuckFrames int
+
+	// Smallest distance per frame that counts as moving
+	MinMovement float64
+
+	lastPos    common.Vector2
+	hasLastPos bool
+	stuckCount int
+}
+
+// NewWatchdogComponent creates a watchdog that sends the entity to home
+// after a second (at 60 TPS) of being stuck
+func NewWatchdogComponent(home common.Vector2, bounds *common.Rectangle) *WatchdogComponent {
+	return &WatchdogComponent{
+		Bounds:      bounds,
+		Home:        home,
+		StuckFrames: 60,
+		MinMovement: 0.1,
+	}
+}
+
+// Check moves the entity back inside the bounds or home if it is stuck, and
+// returns whether it had to intervene. Call it once per frame after
+// collisions are resolved.
+func (w *WatchdogComponent) Check(e *Entity) bool {
+	if w.Bounds != nil && !w.Bounds.Contains(e.Position) {
+		e.Position = clampToRect(e.Position, *w.Bounds)
+		e.Velocity = common.Vector2{}
+		w.reset(e)
+		return true
+	}
+
+	trying := e.Velocity.MagnitudeSquared() > 0
+	moved := !w.hasLastPos || e.Position.Sub(w.lastPos).Magnitude() >= w.MinMovement
+	if trying && !moved {
+		w.stuckCount++
+	} else {
+		w.stuckCount = 0
+	}
+
+	if w.StuckFrames > 0 && w.stuckCount >= w.StuckFrames {
+		e.Position = w.Home
+		e.Velocity = common.Vector2{}
+		w.reset(e)
+		return true
+	}
+
+	w.lastPos = e.Position
+	w.hasLastPos = true
+	return false
+}
+
+// reset restarts stuck detection from the entity's current position
+func (w *WatchdogComponent) reset(e *Entity) {
+	w.lastPos = e.Position
+	w.hasLastPos = true
+	w.stuckCount = 0
+}
+
+// clampToRect returns the closest point to p inside rect
+func clampToRect(p common.Vector2, rect common.Rectangle) common.Vector2 {
+	return common.Vector2{
+		X: min(max(p.X, rect.Pos.X), rect.Pos.X+rect.Size.X),
+		Y: min(max(p.Y, rect.Pos.Y), rect.Pos.Y+rect.Size.Y),
+	}
+}
+
+// CheckWatchdogs runs the watchdog of every entity that has one
+func CheckWatchdogs(entities []*Entity) {
+	for _, e := range entities {
+		if e.watchdog != nil {
+			e.watchdog.Check(e)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"novampires-go/internal/common"
+	"testing"
+)
+
+func TestWatchdogReturnsOutOfBoundsInside(t *testing.T) {
+	bounds := common.Rectangle{Size: common.Vector2{X: 100, Y: 50}}
+
+	tests := []struct {
+		name string
+		pos  common.Vector2
+		want common.Vector2
+	}{
+		{"left", common.Vector2{X: -20, Y: 25}, common.Vector2{X: 0, Y: 25}},
+		{"right", common.Vector2{X: 130, Y: 10}, common.Vector2{X: 100, Y: 10}},
+		{"above", common.Vector2{X: 40, Y: -5}, common.Vector2{X: 40, Y: 0}},
+		{"below a corner", common.Vector2{X: 150, Y: 90}, common.Vector2{X: 100, Y: 50}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEntity(1, tt.pos)
+			e.Velocity = common.Vector2{X: 10}
+			e.SetWatchdog(NewWatchdogComponent(common.Vector2{X: 50, Y: 25}, &bounds))
+
+			CheckWatchdogs([]*Entity{e})
+			if e.Position != tt.want || !bounds.Contains(e.Position) {
+				t.Errorf("position = %v, want %v inside the bounds", e.Position, tt.want)
+			}
+			if e.Velocity != (common.Vector2{}) {
+				t.Errorf("velocity = %v after the rescue, want zero", e.Velocity)
+			}
+		})
+	}
+}
+
+func TestWatchdogLeavesInBoundsEntities(t *testing.T) {
+	bounds := common.Rectangle{Size: common.Vector2{X: 100, Y: 50}}
+	e := NewEntity(1, common.Vector2{X: 30, Y: 30})
+	watchdog := NewWatchdogComponent(common.Vector2{}, &bounds)
+
+	if watchdog.Check(e) || e.Position != (common.Vector2{X: 30, Y: 30}) {
+		t.Errorf("watchdog moved an entity inside the bounds to %v", e.Position)
+	}
+}
+
+func TestWatchdogSendsStuckEntityHome(t *testing.T) {
+	home := common.Vector2{X: 5, Y: 5}
+	watchdog := NewWatchdogComponent(home, nil)
+	watchdog.StuckFrames = 3
+
+	// Trying to move but held in place
+	e := NewEntity(1, common.Vector2{X: 40, Y: 40})
+	e.Velocity = common.Vector2{X: 100}
+	for frame := range watchdog.StuckFrames {
+		if watchdog.Check(e) {
+			t.Fatalf("sent home on frame %d, before being stuck for %d frames", frame, watchdog.StuckFrames)
+		}
+	}
+	if !watchdog.Check(e) || e.Position != home {
+		t.Fatalf("position = %v after being stuck, want home %v", e.Position, home)
+	}
+
+	// Standing still on purpose is not being stuck
+	idle := NewEntity(2, common.Vector2{X: 40, Y: 40})
+	for range watchdog.StuckFrames * 3 {
+		watchdog.Check(idle)
+	}
+	if idle.Position != (common.Vector2{X: 40, Y: 40}) {
+		t.Errorf("idle entity moved to %v", idle.Position)
+	}
+}
+
+func TestWatchdogResetsWhenMoving(t *testing.T) {
+	watchdog := NewWatchdogComponent(common.Vector2{}, nil)
+	watchdog.StuckFrames = 3
+
+	e := NewEntity(1, common.Vector2{X: 40, Y: 40})
+	e.Velocity = common.Vector2{X: 100}
+	for frame := range watchdog.StuckFrames * 3 {
+		// Stuck for two frames, then a real step
+		if frame%3 == 2 {
+			e.Position.X += 1
+		}
+		if watchdog.Check(e) {
+			t.Fatalf("sent home on frame %d although it kept moving", frame)
+		}
+	}
+}
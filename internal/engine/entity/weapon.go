@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"novampires-go/internal/common"
+	"time"
+)
+
+// WeaponConfig describes a ranged weapon
+type WeaponConfig struct {
+	Name         string
+	Projectile   ProjectileConfig
+	FireInterval time.Duration // Time between shots
+	Recoil       RecoilConfig  // Kick given to the wielder on every shot
+}
+
+// BlasterWeaponConfig returns a rapid straight shooter
+func BlasterWeaponConfig() WeaponConfig {
+	return WeaponConfig{
+		Name:         "Blaster",
+		Projectile:   DefaultProjectileConfig(),
+		FireInterval: 250 * time.Millisecond,
+		Recoil:       DefaultRecoilConfig(),
+	}
+}
+
+// LobberWeaponConfig returns a slow weapon whose shots arc under gravity
+func LobberWeaponConfig() WeaponConfig {
+	return WeaponConfig{
+		Name:         "Lobber",
+		Projectile:   ArcingProjectileConfig(),
+		FireInterval: 700 * time.Millisecond,
+		Recoil: RecoilConfig{
+			Kick:     120.0,
+			Climb:    0.12,
+			Recovery: 300 * time.Millisecond,
+		},
+	}
+}
+
+// SeekerWeaponConfig returns a weapon whose shots home in on targets
+func SeekerWeaponConfig() WeaponConfig {
+	return WeaponConfig{
+		Name:         "Seeker",
+		Projectile:   HomingProjectileConfig(),
+		FireInterval: 400 * time.Millisecond,
+		Recoil:       DefaultRecoilConfig(),
+	}
+}
+
+// Weapon fires projectiles no faster than its fire interval
+type Weapon struct {
+	config WeaponConfig
+
+	// Time left before the next shot is allowed
+	cooldown time.Duration
+}
+
+// NewWeapon creates a weapon that is ready to fire
+func NewWeapon(config WeaponConfig) *Weapon {
+	return &Weapon{config: config}
+}
+
+// GetConfig returns the weapon's configuration
+func (w *Weapon) GetConfig() WeaponConfig {
+	return w.config
+}
+
+// Update counts down the time to the next shot
+func (w *Weapon) Update(dt time.Duration) {
+	w.cooldown = max(w.cooldown-dt, 0)
+}
+
+// Ready returns whether the weapon can fire
+func (w *Weapon) Ready() bool {
+	return w.cooldown <= 0
+}
+
+// CooldownProgress returns the remaining cooldown from 1 (just fired) to 0
+// (ready), as shown by a cooldown ring
+func (w *Weapon) CooldownProgress() float64 {
+	return cooldownProgress(w.cooldown, w.config.FireInterval)
+}
+
+// cooldownProgress returns the fraction of total still remaining
+func cooldownProgress(remaining, total time.Duration) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return min(max(float64(remaining)/float64(total), 0), 1)
+}
+
+// Fire launches a projectile from origin in direction into projectiles and
+// starts the cooldown. It returns nil without firing while on cooldown or
+// without a direction.
+func (w *Weapon) Fire(projectiles *Projectiles, origin, direction common.Vector2) *Projectile {
+	if !w.Ready() || direction.MagnitudeSquared() == 0 {
+		return nil
+	}
+
+	w.cooldown = w.config.FireInterval
+	return projectiles.Fire(origin, direction, w.config.Projectile)
+}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+	"time"
+)
+
+func TestWeaponCooldown(t *testing.T) {
+	config := BlasterWeaponConfig()
+	weapon := NewWeapon(config)
+	projectiles := NewProjectiles(nil)
+	right := common.Vector2{X: 1}
+
+	if weapon.Fire(projectiles, common.Vector2{}, right) == nil {
+		t.Fatal("first shot did not fire")
+	}
+	if weapon.Fire(projectiles, common.Vector2{}, right) != nil {
+		t.Error("fired again while on cooldown")
+	}
+
+	weapon.Update(config.FireInterval - time.Millisecond)
+	if weapon.Ready() {
+		t.Error("ready before the fire interval passed")
+	}
+
+	weapon.Update(time.Millisecond)
+	if weapon.Fire(projectiles, common.Vector2{}, right) == nil {
+		t.Error("did not fire after the fire interval")
+	}
+	if got := projectiles.Count(); got != 2 {
+		t.Errorf("projectiles in flight = %d, want 2", got)
+	}
+}
+
+func TestWeaponNeedsDirection(t *testing.T) {
+	weapon := NewWeapon(BlasterWeaponConfig())
+	if weapon.Fire(NewProjectiles(nil), common.Vector2{}, common.Vector2{}) != nil {
+		t.Error("fired without a direction")
+	}
+	if !weapon.Ready() {
+		t.Error("cooldown started without firing")
+	}
+}
+
+func TestCooldownProgress(t *testing.T) {
+	weapon := NewWeapon(BlasterWeaponConfig())
+	melee := NewMeleeAttack(DefaultMeleeConfig())
+
+	tests := []struct {
+		name     string
+		trigger  func()
+		update   func(time.Duration)
+		progress func() float64
+		total    time.Duration
+	}{
+		{
+			name:     "weapon",
+			trigger:  func() { weapon.Fire(NewProjectiles(nil), common.Vector2{}, common.Vector2{X: 1}) },
+			update:   weapon.Update,
+			progress: weapon.CooldownProgress,
+			total:    weapon.GetConfig().FireInterval,
+		},
+		{
+			name:     "melee",
+			trigger:  func() { melee.Swing(common.Vector2{}, 0) },
+			update:   melee.Update,
+			progress: melee.CooldownProgress,
+			total:    melee.GetConfig().Cooldown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.progress(); got != 0 {
+				t.Errorf("progress = %v before use, want 0", got)
+			}
+
+			tt.trigger()
+			if got := tt.progress(); got != 1 {
+				t.Errorf("progress = %v just after use, want 1", got)
+			}
+
+			tt.update(tt.total / 4)
+			if got := tt.progress(); math.Abs(got-0.75) > 1e-9 {
+				t.Errorf("progress = %v a quarter through, want 0.75", got)
+			}
+
+			tt.update(tt.total)
+			if got := tt.progress(); got != 0 {
+				t.Errorf("progress = %v once ready, want 0", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package events
+
+// Handler is called for every published event it is subscribed to
+type Handler func(event Event)
+
+// Subscription identifies a registered handler so it can be removed later
+type Subscription uint64
+
+type subscriber struct {
+	id      Subscription
+	handler Handler
+}
+
+// Bus delivers published events to the handlers subscribed to their type.
+// It is meant to be used from the game loop goroutine only.
+type Bus struct {
+	handlers map[Type][]subscriber
+	nextID   Subscription
+}
+
+// NewBus creates an empty event bus
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[Type][]subscriber),
+	}
+}
+
+// Subscribe registers a handler for an event type
+func (b *Bus) Subscribe(eventType Type, handler Handler) Subscription {
+	b.nextID++
+	b.handlers[eventType] = append(b.handlers[eventType], subscriber{
+		id:      b.nextID,
+		handler: handler,
+	})
+	return b.nextID
+}
+
+// Unsubscribe removes a previously registered handler
+func (b *Bus) Unsubscribe(id Subscription) {
+	for eventType, subs := range b.handlers {
+		for i, sub := range subs {
+			if sub.id != id {
+				continue
+			}
+
+			// Copy so a Publish in progress keeps iterating its own snapshot
+			remaining := make([]subscriber, 0, len(subs)-1)
+			remaining = append(remaining, subs[:i]...)
+			remaining = append(remaining, subs[i+1:]...)
+			b.handlers[eventType] = remaining
+			return
+		}
+	}
+}
+
+// Publish delivers an event to all handlers subscribed to its type
+func (b *Bus) Publish(event Event) {
+	for _, sub := range b.handlers[event.Type()] {
+		sub.handler(event)
+	}
+}
@@ -0,0 +1,77 @@
+package events
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPublishDeliversToSubscribers(t *testing.T) {
+	bus := NewBus()
+
+	var got []string
+	bus.Subscribe(TypeEnemyKilled, func(e Event) {
+		got = append(got, "first")
+		if killed, ok := e.(EnemyKilled); !ok || killed.EnemyID != 7 {
+			t.Errorf("handler got %#v, want EnemyKilled{EnemyID: 7}", e)
+		}
+	})
+	bus.Subscribe(TypeEnemyKilled, func(Event) { got = append(got, "second") })
+	bus.Subscribe(TypePlayerDamaged, func(Event) { got = append(got, "damaged") })
+
+	bus.Publish(EnemyKilled{EnemyID: 7})
+	if want := []string{"first", "second"}; !slices.Equal(got, want) {
+		t.Errorf("delivered to %v, want %v in subscription order", got, want)
+	}
+
+	got = got[:0]
+	bus.Publish(ProjectileFired{})
+	if len(got) != 0 {
+		t.Errorf("event without subscribers delivered to %v", got)
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	bus := NewBus()
+
+	var first, second int
+	sub := bus.Subscribe(TypePlayerDamaged, func(Event) { first++ })
+	bus.Subscribe(TypePlayerDamaged, func(Event) { second++ })
+
+	bus.Publish(PlayerDamaged{Amount: 5})
+	bus.Unsubscribe(sub)
+	bus.Publish(PlayerDamaged{Amount: 5})
+
+	if first != 1 || second != 2 {
+		t.Errorf("deliveries = %d, %d, want 1 for the removed handler and 2 for the other", first, second)
+	}
+
+	// Removing it again, or an unknown subscription, does nothing
+	bus.Unsubscribe(sub)
+	bus.Unsubscribe(Subscription(999))
+	bus.Publish(PlayerDamaged{Amount: 5})
+	if first != 1 || second != 3 {
+		t.Errorf("deliveries = %d, %d after removing unknown subscriptions, want 1 and 3", first, second)
+	}
+}
+
+func TestUnsubscribeDuringPublish(t *testing.T) {
+	bus := NewBus()
+
+	var calls int
+	var sub Subscription
+	sub = bus.Subscribe(TypeComboChanged, func(Event) {
+		calls++
+		bus.Unsubscribe(sub)
+	})
+	bus.Subscribe(TypeComboChanged, func(Event) { calls++ })
+
+	bus.Publish(ComboChanged{Count: 1})
+	if calls != 2 {
+		t.Errorf("calls = %d, want both handlers to run for the event in progress", calls)
+	}
+
+	bus.Publish(ComboChanged{Count: 2})
+	if calls != 3 {
+		t.Errorf("calls = %d, want only the remaining handler on the next event", calls)
+	}
+}
@@ -0,0 +1,125 @@
+// internal/engine/events/events.go
+package events
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"novampires-go/internal/common"
+)
+
+// Type identifies a kind of game event
+type Type uint8
+
+const (
+	TypeEnemyKilled Type = iota
+	TypePlayerDamaged
+	TypeProjectileFired
+	TypePickupCollected
+	TypeGamepadConnected
+	TypeGamepadDisconnected
+	TypeCriticalHit
+	TypeBossPhaseChanged
+	TypeComboChanged
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeEnemyKilled:
+		return "Enemy Killed"
+	case TypePlayerDamaged:
+		return "Player Damaged"
+	case TypeProjectileFired:
+		return "Projectile Fired"
+	case TypePickupCollected:
+		return "Pickup Collected"
+	case TypeGamepadConnected:
+		return "Gamepad Connected"
+	case TypeGamepadDisconnected:
+		return "Gamepad Disconnected"
+	case TypeCriticalHit:
+		return "Critical Hit"
+	case TypeBossPhaseChanged:
+		return "Boss Phase Changed"
+	case TypeComboChanged:
+		return "Combo Changed"
+	default:
+		return "Unknown Event"
+	}
+}
+
+// Event is implemented by every event published on the bus
+type Event interface {
+	Type() Type
+}
+
+// EnemyKilled is published when an enemy dies
+type EnemyKilled struct {
+	EnemyID  uint64
+	Position common.Vector2
+}
+
+func (EnemyKilled) Type() Type { return TypeEnemyKilled }
+
+// PlayerDamaged is published when the player takes damage
+type PlayerDamaged struct {
+	Amount   int
+	Position common.Vector2
+}
+
+func (PlayerDamaged) Type() Type { return TypePlayerDamaged }
+
+// ProjectileFired is published when any projectile is spawned
+type ProjectileFired struct {
+	OwnerID   uint64
+	Position  common.Vector2
+	Direction common.Vector2
+}
+
+func (ProjectileFired) Type() Type { return TypeProjectileFired }
+
+// PickupCollected is published when the player collects a pickup
+type PickupCollected struct {
+	Value    int
+	Position common.Vector2
+}
+
+func (PickupCollected) Type() Type { return TypePickupCollected }
+
+// GamepadConnected is published when a gamepad is plugged in
+type GamepadConnected struct {
+	ID ebiten.GamepadID
+}
+
+func (GamepadConnected) Type() Type { return TypeGamepadConnected }
+
+// GamepadDisconnected is published when a gamepad is unplugged
+type GamepadDisconnected struct {
+	ID ebiten.GamepadID
+}
+
+func (GamepadDisconnected) Type() Type { return TypeGamepadDisconnected }
+
+// CriticalHit is published when a critical hit lands
+type CriticalHit struct {
+	Amount   int
+	Position common.Vector2
+}
+
+func (CriticalHit) Type() Type { return TypeCriticalHit }
+
+// BossPhaseChanged is published when a boss's health drops into a new phase
+type BossPhaseChanged struct {
+	BossID   uint64
+	Phase    int
+	Position common.Vector2
+}
+
+func (BossPhaseChanged) Type() Type { return TypeBossPhaseChanged }
+
+// ComboChanged is published when the kill streak grows or resets. Count is
+// 0 after a reset.
+type ComboChanged struct {
+	Count      int
+	Multiplier float64
+}
+
+func (ComboChanged) Type() Type { return TypeComboChanged }
@@ -66,7 +66,7 @@ func (w *DebugWindow) Draw() {
 		// Connected gamepads section
 		debug.CollapsingSection("Connected Devices", func() {
 			// Show connected gamepads
-			gamepads := ebiten.AppendGamepadIDs(nil)
+			gamepads := w.manager.ConnectedGamepads()
 			if len(gamepads) == 0 {
 				imgui.Text("No gamepads connected")
 			} else {
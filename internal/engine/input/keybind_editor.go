@@ -4,9 +4,10 @@ import (
 	"fmt"
 	imgui "github.com/gabstv/cimgui-go"
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"novampires-go/internal/common"
+	"slices"
 	"sort"
+	"strings"
 )
 
 type KeyBindingEditorWindow struct {
@@ -20,6 +21,13 @@ type KeyBindingEditorWindow struct {
 	gamepadBindings []GamepadActionPair
 	needsRefresh    bool
 	showGamepad     bool
+	selectedGamepad ebiten.GamepadID
+
+	// Waiting for a button press on the selected gamepad
+	listeningGamepad bool
+
+	// Set on the frame listening starts so the click that started it is ignored
+	listenJustStarted bool
 }
 
 // KeyActionPair represents a keyboard binding
@@ -115,6 +123,14 @@ func (w *KeyBindingEditorWindow) Draw() {
 	//imgui.SetNextWindowSize(imgui.Vec2{X: 450, Y: 500})
 
 	if imgui.BeginV("Key Binding Editor", &w.open, imgui.WindowFlagsNone) {
+		if imgui.Button("Reset to Defaults") {
+			w.manager.ResetToDefaults()
+			w.stopListening()
+			w.listeningGamepad = false
+			w.needsRefresh = true
+		}
+		imgui.Separator()
+
 		// Tab bar for keyboard/gamepad
 		if imgui.BeginTabBar("##input_tabs") {
 			if imgui.BeginTabItem("Keyboard") {
@@ -151,12 +167,17 @@ func (w *KeyBindingEditorWindow) drawKeyboardBindings() {
 		// Add Button with Color
 		imgui.PushStyleColorVec4(imgui.ColButton, imgui.Vec4{X: 0.5, Y: 0.5, Z: 0.5, W: 1})
 		if imgui.Button("Add") {
-			w.listening = true
-			w.rebindMode = false
-			w.selectedAction = action
+			w.startListening(action, false)
 		}
 		imgui.PopStyleColor()
 
+		// Clear removes every keyboard and gamepad input for the action
+		imgui.SameLine()
+		if imgui.Button("Clear") {
+			w.manager.UnbindAction(action)
+			w.needsRefresh = true
+		}
+
 		// Collect and sort all bindings
 		type binding struct {
 			displayName string
@@ -184,6 +205,16 @@ func (w *KeyBindingEditorWindow) drawKeyboardBindings() {
 						keyName = keyName[3:]
 					}
 					displayName = fmt.Sprintf("%s+%s", modName, keyName)
+				case Sequence:
+					names := make([]string, 0, v.Length)
+					for _, key := range v.Steps() {
+						keyName := key.String()
+						if len(keyName) > 3 && keyName[:3] == "Key" {
+							keyName = keyName[3:]
+						}
+						names = append(names, keyName)
+					}
+					displayName = strings.Join(names, ", ")
 				}
 				// Only add if we have a display name
 				if displayName != "" {
@@ -210,9 +241,7 @@ func (w *KeyBindingEditorWindow) drawKeyboardBindings() {
 
 			imgui.PushIDStr(b.displayName)
 			if imgui.Button(b.displayName) {
-				w.listening = true
-				w.rebindMode = true
-				w.selectedAction = action
+				w.startListening(action, true)
 				switch v := b.input.(type) {
 				case KeyboardKey:
 					w.oldKey = v.Key
@@ -234,7 +263,7 @@ func (w *KeyBindingEditorWindow) drawKeyboardBindings() {
 		// Show prompt if listening for key press
 		if w.listening && w.selectedAction == action {
 			imgui.SameLine()
-			imgui.Text("Press Any Key...")
+			imgui.Text("Press Any Key... (Esc to cancel)")
 		}
 
 		imgui.Separator()
@@ -257,7 +286,19 @@ func (w *KeyBindingEditorWindow) getKeyNamesForAction(action common.Action) []st
 }
 
 // Helper to get standard gamepad button names
-func getGamepadButtonName(button ebiten.StandardGamepadButton) string {
+// getGamepadButtonName returns the label for a button on a gamepad, using
+// generic labels when the gamepad has no standard layout
+func getGamepadButtonName(id ebiten.GamepadID, button ebiten.StandardGamepadButton) string {
+	return gamepadButtonName(button, ebiten.IsStandardGamepadLayoutAvailable(id))
+}
+
+// gamepadButtonName returns the label for a standard button. Without a
+// standard layout the W3C positions mean nothing, so only the index is shown.
+func gamepadButtonName(button ebiten.StandardGamepadButton, standard bool) string {
+	if !standard {
+		return fmt.Sprintf("Button %d", int(button))
+	}
+
 	// W3C standard button indices
 	switch button {
 	case ebiten.StandardGamepadButtonRightBottom:
@@ -285,13 +326,65 @@ func getGamepadButtonName(button ebiten.StandardGamepadButton) string {
 	}
 }
 
+// rawGamepadButtonName returns the generic label for a button read through
+// the non-standard gamepad API
+func rawGamepadButtonName(button ebiten.GamepadButton) string {
+	return fmt.Sprintf("Raw %d", int(button))
+}
+
+// pressedRawGamepadButtons returns the labels of the raw buttons held on a
+// gamepad
+func pressedRawGamepadButtons(id ebiten.GamepadID) []string {
+	var names []string
+	for b := ebiten.GamepadButton(0); int(b) < ebiten.GamepadButtonCount(id); b++ {
+		if ebiten.IsGamepadButtonPressed(id, b) {
+			names = append(names, rawGamepadButtonName(b))
+		}
+	}
+	return names
+}
+
 func (w *KeyBindingEditorWindow) drawGamepadBindings() {
-	gamepads := ebiten.AppendGamepadIDs(nil)
+	gamepads := w.manager.ConnectedGamepads()
 	if len(gamepads) == 0 {
 		imgui.Text("No gamepads connected")
 		return
 	}
 
+	// Keep the selection valid when the selected gamepad is unplugged
+	if !slices.Contains(gamepads, w.selectedGamepad) {
+		w.selectedGamepad = gamepads[0]
+	}
+
+	// Gamepad selection
+	if len(gamepads) > 1 {
+		for i, id := range gamepads {
+			imgui.PushIDInt(int32(5000 + i))
+			if imgui.RadioButtonBool(fmt.Sprintf("Gamepad %d", id), id == w.selectedGamepad) {
+				w.selectedGamepad = id
+			}
+			imgui.PopID()
+			if i < len(gamepads)-1 {
+				imgui.SameLine()
+			}
+		}
+		imgui.Separator()
+	}
+
+	// Standard button positions are unreliable without a standard layout, so
+	// show the raw buttons to help identify them
+	standard := ebiten.IsStandardGamepadLayoutAvailable(w.selectedGamepad)
+	if !standard {
+		imgui.Text(fmt.Sprintf("Gamepad %d has no standard layout; buttons are shown by index", w.selectedGamepad))
+		pressed := pressedRawGamepadButtons(w.selectedGamepad)
+		if len(pressed) == 0 {
+			imgui.Text("Pressed: none")
+		} else {
+			imgui.Text("Pressed: " + strings.Join(pressed, ", "))
+		}
+		imgui.Separator()
+	}
+
 	// Show current gamepad bindings
 	if imgui.CollapsingHeaderTreeNodeFlagsV("Current Gamepad Bindings", imgui.TreeNodeFlagsDefaultOpen) {
 		if len(w.gamepadBindings) == 0 {
@@ -299,7 +392,7 @@ func (w *KeyBindingEditorWindow) drawGamepadBindings() {
 		} else {
 			for i, binding := range w.gamepadBindings {
 				actionStr := binding.Action.String()
-				buttonName := getGamepadButtonName(binding.Button)
+				buttonName := getGamepadButtonName(binding.GamepadID, binding.Button)
 				displayName := fmt.Sprintf("Gamepad %d: %s", binding.GamepadID, buttonName)
 
 				imgui.PushIDInt(int32(2000 + i))
@@ -341,8 +434,27 @@ func (w *KeyBindingEditorWindow) drawGamepadBindings() {
 		imgui.Text(fmt.Sprintf("Selected: %s", w.selectedAction.String()))
 		imgui.Separator()
 
+		// Bind by pressing a button on the gamepad
+		if w.listeningGamepad {
+			imgui.Text(fmt.Sprintf("Press a button on gamepad %d... (Esc to cancel)", w.selectedGamepad))
+			if key, ok := w.manager.AnyKeyJustPressed(); ok && key == ebiten.KeyEscape {
+				w.listeningGamepad = false
+			} else if button, ok := w.manager.AnyGamepadButtonJustPressed(w.selectedGamepad); ok {
+				w.manager.Bind(GamepadButton{
+					GamepadID: w.selectedGamepad,
+					Button:    button,
+				}, w.selectedAction)
+				w.needsRefresh = true
+				w.listeningGamepad = false
+			}
+		} else if imgui.Button("Press Button to Bind") {
+			w.listeningGamepad = true
+		}
+
+		imgui.Separator()
+
 		// Button selection
-		imgui.Text("Select button to bind:")
+		imgui.Text("Or select button to bind:")
 		standardButtons := []ebiten.StandardGamepadButton{
 			ebiten.StandardGamepadButtonRightBottom,
 			ebiten.StandardGamepadButtonRightRight,
@@ -357,11 +469,11 @@ func (w *KeyBindingEditorWindow) drawGamepadBindings() {
 		}
 
 		for i, button := range standardButtons {
-			buttonName := getGamepadButtonName(button)
+			buttonName := gamepadButtonName(button, standard)
 			imgui.PushIDInt(int32(4000 + i))
 			if imgui.Button(buttonName) {
 				w.manager.Bind(GamepadButton{
-					GamepadID: gamepads[0], // Use first gamepad
+					GamepadID: w.selectedGamepad,
 					Button:    button,
 				}, w.selectedAction)
 				w.needsRefresh = true
@@ -374,71 +486,77 @@ func (w *KeyBindingEditorWindow) drawGamepadBindings() {
 	}
 }
 
+// startListening waits for the next key press to bind to action
+func (w *KeyBindingEditorWindow) startListening(action common.Action, rebind bool) {
+	w.listening = true
+	w.listenJustStarted = true
+	w.rebindMode = rebind
+	w.selectedAction = action
+}
+
+// stopListening stops waiting for a key press
+func (w *KeyBindingEditorWindow) stopListening() {
+	w.listening = false
+	w.listenJustStarted = false
+	w.rebindMode = false
+}
+
 func (w *KeyBindingEditorWindow) listenForKeyPress() {
-	// For combo key detection
-	if ebiten.IsKeyPressed(ebiten.KeyControl) {
-		// Wait for a non-modifier key to be newly pressed
-		for key := ebiten.KeyA; key <= ebiten.KeyMax; key++ {
-			if key != ebiten.KeyControl && inpututil.IsKeyJustPressed(key) {
-				if w.rebindMode {
-					// Remove old binding
-					for input, act := range w.manager.bindings {
-						if act == w.selectedAction {
-							switch v := input.(type) {
-							case KeyboardKey:
-								if v.Key == w.oldKey {
-									w.manager.Unbind(input)
-								}
-							case ComboKey:
-								if v.Key == w.oldKey {
-									w.manager.Unbind(input)
-								}
-							}
-						}
-					}
-				}
+	// Ignore the frame listening started so its click doesn't cancel it
+	if w.listenJustStarted {
+		w.listenJustStarted = false
+		return
+	}
 
-				// Add new combo binding
-				w.manager.Bind(ComboKey{
-					Modifier: ebiten.KeyControl,
-					Key:      key,
-				}, w.selectedAction)
+	// Clicking anywhere else cancels
+	if imgui.IsMouseClickedBool(imgui.MouseButtonLeft) {
+		w.stopListening()
+		return
+	}
 
-				w.needsRefresh = true
-				w.listening = false
-				w.rebindMode = false
-				return
-			}
-		}
+	key, ok := w.manager.AnyKeyJustPressed()
+	if !ok {
+		return
+	}
+
+	if key == ebiten.KeyEscape {
+		w.stopListening()
+		return
+	}
+
+	if w.rebindMode {
+		w.removeOldBinding()
+	}
+
+	if ebiten.IsKeyPressed(ebiten.KeyControl) {
+		// Add new combo binding
+		w.manager.Bind(ComboKey{
+			Modifier: ebiten.KeyControl,
+			Key:      key,
+		}, w.selectedAction)
 	} else {
-		// Regular key binding
-		for key := ebiten.KeyA; key <= ebiten.KeyMax; key++ {
-			if inpututil.IsKeyJustPressed(key) {
-				if w.rebindMode {
-					// Remove old binding
-					for input, act := range w.manager.bindings {
-						if act == w.selectedAction {
-							switch v := input.(type) {
-							case KeyboardKey:
-								if v.Key == w.oldKey {
-									w.manager.Unbind(input)
-								}
-							case ComboKey:
-								if v.Key == w.oldKey {
-									w.manager.Unbind(input)
-								}
-							}
-						}
-					}
-				}
+		// Add new regular binding
+		w.manager.Bind(KeyboardKey{Key: key}, w.selectedAction)
+	}
 
-				// Add new regular binding
-				w.manager.Bind(KeyboardKey{Key: key}, w.selectedAction)
+	w.needsRefresh = true
+	w.stopListening()
+}
 
-				w.needsRefresh = true
-				w.listening = false
-				w.rebindMode = false
-				return
+// removeOldBinding unbinds the key being replaced from the selected action
+func (w *KeyBindingEditorWindow) removeOldBinding() {
+	for input, act := range w.manager.bindings {
+		if act != w.selectedAction {
+			continue
+		}
+		switch v := input.(type) {
+		case KeyboardKey:
+			if v.Key == w.oldKey {
+				w.manager.Unbind(input)
+			}
+		case ComboKey:
+			if v.Key == w.oldKey {
+				w.manager.Unbind(input)
 			}
 		}
 	}
@@ -0,0 +1,90 @@
+package input
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"maps"
+	"novampires-go/internal/common"
+	"testing"
+)
+
+func TestGamepadButtonName(t *testing.T) {
+	tests := []struct {
+		name     string
+		button   ebiten.StandardGamepadButton
+		standard bool
+		want     string
+	}{
+		{"standard face button", ebiten.StandardGamepadButtonRightBottom, true, "Button 0 (Bottom right)"},
+		{"standard d-pad", ebiten.StandardGamepadButtonLeftTop, true, "Button 12 (Top left)"},
+		{"standard unlabelled", ebiten.StandardGamepadButtonRightStick, true, "Button 11"},
+		{"non-standard face button", ebiten.StandardGamepadButtonRightBottom, false, "Button 0"},
+		{"non-standard d-pad", ebiten.StandardGamepadButtonLeftTop, false, "Button 12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gamepadButtonName(tt.button, tt.standard); got != tt.want {
+				t.Errorf("gamepadButtonName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListeningCancel(t *testing.T) {
+	tests := []struct {
+		name   string
+		frames [][]ebiten.Key // Keys newly pressed on each frame while listening
+		rebind bool
+	}{
+		{"escape", [][]ebiten.Key{nil, {ebiten.KeyEscape}}, false},
+		{"escape while rebinding", [][]ebiten.Key{nil, {ebiten.KeyEscape}}, true},
+		{"key on the starting frame is ignored", [][]ebiten.Key{{ebiten.KeyQ}, {ebiten.KeyEscape}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			source := &fakeJustPressed{}
+			m.justPressed = source
+			before := maps.Clone(m.bindings)
+
+			w := NewKeyBindingEditorWindow(m)
+			w.oldKey = ebiten.Key1
+			w.startListening(common.ActionUseAbility1, tt.rebind)
+			for _, keys := range tt.frames {
+				source.keys = keys
+				w.listenForKeyPress()
+			}
+
+			if w.listening {
+				t.Error("still listening after escape")
+			}
+			if !maps.Equal(m.bindings, before) {
+				t.Errorf("bindings changed to %v, want %v", m.bindings, before)
+			}
+		})
+	}
+}
+
+func TestListeningBindsNextKey(t *testing.T) {
+	m := New()
+	source := &fakeJustPressed{}
+	m.justPressed = source
+
+	w := NewKeyBindingEditorWindow(m)
+	w.oldKey = ebiten.Key1
+	w.startListening(common.ActionUseAbility1, true)
+	w.listenForKeyPress()
+	source.keys = []ebiten.Key{ebiten.KeyQ}
+	w.listenForKeyPress()
+
+	if w.listening {
+		t.Error("still listening after a key press")
+	}
+	if got := m.bindings[KeyboardKey{Key: ebiten.KeyQ}]; got != common.ActionUseAbility1 {
+		t.Errorf("Q bound to %v, want %v", got, common.ActionUseAbility1)
+	}
+	if _, ok := m.bindings[KeyboardKey{Key: ebiten.Key1}]; ok {
+		t.Error("rebinding kept the old key")
+	}
+}
@@ -3,9 +3,12 @@ package input
 import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"maps"
 	"math"
 	"novampires-go/internal/common"
 	"novampires-go/internal/engine/camera"
+	"novampires-go/internal/engine/events"
+	"time"
 )
 
 // InputID represents any type of input (keyboard, gamepad, etc)
@@ -44,21 +47,184 @@ type ComboKey struct {
 
 func (c ComboKey) isInputID() {}
 
+// CursorMapping converts window cursor positions into render area
+// coordinates, such as a rendering.Letterbox. It is declared here so input
+// doesn't depend on the renderer.
+type CursorMapping interface {
+	ToInner(pos common.Vector2) common.Vector2
+}
+
+// SOCDMode selects how simultaneous opposing cardinal directions
+// (e.g. left and right held together) are resolved
+type SOCDMode int
+
+const (
+	// SOCDNeutral cancels opposing directions to zero
+	SOCDNeutral SOCDMode = iota
+	// SOCDLastInput favors the most recently pressed direction
+	SOCDLastInput
+	// SOCDFirstInput favors the direction that was held first
+	SOCDFirstInput
+)
+
+func (m SOCDMode) String() string {
+	switch m {
+	case SOCDNeutral:
+		return "Neutral"
+	case SOCDLastInput:
+		return "Last Input"
+	case SOCDFirstInput:
+		return "First Input"
+	default:
+		return "Unknown"
+	}
+}
+
+// KeyboardAimMode selects which keys, if any, aim independently of movement
+type KeyboardAimMode int
+
+const (
+	// KeyboardAimOff leaves aiming to the mouse and gamepad
+	KeyboardAimOff KeyboardAimMode = iota
+	// KeyboardAimArrows aims with the arrow keys, which then no longer move
+	KeyboardAimArrows
+	// KeyboardAimIJKL aims with I, J, K and L
+	KeyboardAimIJKL
+)
+
+func (m KeyboardAimMode) String() string {
+	switch m {
+	case KeyboardAimOff:
+		return "Off"
+	case KeyboardAimArrows:
+		return "Arrows"
+	case KeyboardAimIJKL:
+		return "IJKL"
+	default:
+		return "Unknown"
+	}
+}
+
 // Config holds all configurable input parameters
 type Config struct {
-	Deadzone float64 // Deadzone for analog sticks
+	Deadzone       float64         // Deadzone for analog sticks
+	SOCD           SOCDMode        // Opposing direction resolution for digital movement
+	StickThreshold float64         // Deflection at which a stick direction counts as pushed
+	KeyboardAim    KeyboardAimMode // Keys bound to twin-stick aiming
 }
 
 // DefaultConfig returns a Config with sensible defaults
 func DefaultConfig() *Config {
 	return &Config{
-		Deadzone: 0.2,
+		Deadzone:       0.2,
+		SOCD:           SOCDNeutral,
+		StickThreshold: 0.5,
+		KeyboardAim:    KeyboardAimOff,
+	}
+}
+
+// StickDirection is a cardinal direction of the left analog stick
+type StickDirection int
+
+const (
+	StickUp StickDirection = iota
+	StickDown
+	StickLeft
+	StickRight
+	stickDirectionCount
+)
+
+func (d StickDirection) String() string {
+	switch d {
+	case StickUp:
+		return "Up"
+	case StickDown:
+		return "Down"
+	case StickLeft:
+		return "Left"
+	case StickRight:
+		return "Right"
+	default:
+		return "Unknown"
+	}
+}
+
+// stickEdges tracks when stick directions cross the push threshold, giving
+// analog sticks the same just-pushed edges as digital buttons
+type stickEdges struct {
+	pushed     [stickDirectionCount]bool
+	justPushed [stickDirectionCount]bool
+}
+
+// update records this frame's stick position
+func (s *stickEdges) update(x, y, threshold float64) {
+	now := [stickDirectionCount]bool{
+		StickUp:    y <= -threshold,
+		StickDown:  y >= threshold,
+		StickLeft:  x <= -threshold,
+		StickRight: x >= threshold,
+	}
+	for d := range now {
+		s.justPushed[d] = now[d] && !s.pushed[d]
+	}
+	s.pushed = now
+}
+
+// axisHistory remembers press order on one digital movement axis.
+// Directions are -1 (negative), 1 (positive) or 0 (none).
+type axisHistory struct {
+	first int
+	last  int
+}
+
+// update records the held state of both directions for this frame
+func (h *axisHistory) update(negHeld, posHeld, negJustPressed, posJustPressed bool) {
+	switch {
+	case !negHeld && !posHeld:
+		h.first, h.last = 0, 0
+	case negHeld && !posHeld:
+		h.first, h.last = -1, -1
+	case posHeld && !negHeld:
+		h.first, h.last = 1, 1
+	default:
+		// Both held: a fresh press takes over as the latest input
+		if negJustPressed && !posJustPressed {
+			h.last = -1
+		} else if posJustPressed && !negJustPressed {
+			h.last = 1
+		}
+		if h.first == 0 {
+			h.first = h.last
+		}
+	}
+}
+
+// resolve returns the axis value for the held directions under a SOCD mode
+func (h *axisHistory) resolve(negHeld, posHeld bool, mode SOCDMode) float64 {
+	if negHeld != posHeld {
+		if negHeld {
+			return -1
+		}
+		return 1
+	}
+	if !negHeld {
+		return 0
+	}
+
+	switch mode {
+	case SOCDLastInput:
+		return float64(h.last)
+	case SOCDFirstInput:
+		return float64(h.first)
+	default:
+		return 0
 	}
 }
 
 // Manager handles mapping between physical inputs and game actions
 type Manager struct {
 	bindings     map[InputID]common.Action
+	actionInputs map[common.Action][]InputID // inverse of bindings
 	axisValues   map[GamepadAxis]float64
 	playerPos    common.Vector2
 	usingGamepad bool
@@ -66,6 +232,55 @@ type Manager struct {
 	lastMouseY   int
 	config       *Config
 	camera       *camera.Camera
+
+	// Connected gamepads in connection order
+	gamepads []ebiten.GamepadID
+
+	// Optional bus for connection events
+	events *events.Bus
+
+	// Maps window cursor positions into the letterboxed render area
+	letterbox CursorMapping
+
+	// Press order of digital movement directions, for SOCD resolution
+	horizontal axisHistory
+	vertical   axisHistory
+
+	// Threshold crossings of the primary gamepad's left stick
+	stick stickEdges
+
+	// Entry progress of bound key sequences
+	sequences map[Sequence]*sequenceProgress
+
+	// Actions whose press has been handled this frame
+	consumed map[common.Action]bool
+
+	// Applied snapshot that queries return instead of device state
+	override *InputSnapshot
+
+	// Source of newly pressed keys and buttons, the devices unless replaced by tests
+	justPressed justPressedSource
+
+	// Reused buffers for just-pressed queries
+	justPressedKeys    []ebiten.Key
+	justPressedButtons []ebiten.StandardGamepadButton
+}
+
+// justPressedSource reports the keys and gamepad buttons pressed this frame
+type justPressedSource interface {
+	AppendJustPressedKeys(keys []ebiten.Key) []ebiten.Key
+	AppendJustPressedStandardGamepadButtons(id ebiten.GamepadID, buttons []ebiten.StandardGamepadButton) []ebiten.StandardGamepadButton
+}
+
+// deviceJustPressed reads just-pressed input from the real devices
+type deviceJustPressed struct{}
+
+func (deviceJustPressed) AppendJustPressedKeys(keys []ebiten.Key) []ebiten.Key {
+	return inpututil.AppendJustPressedKeys(keys)
+}
+
+func (deviceJustPressed) AppendJustPressedStandardGamepadButtons(id ebiten.GamepadID, buttons []ebiten.StandardGamepadButton) []ebiten.StandardGamepadButton {
+	return inpututil.AppendJustPressedStandardGamepadButtons(id, buttons)
 }
 
 // New creates a new input manager with default bindings
@@ -73,9 +288,13 @@ func New() *Manager { return NewWithConfig(DefaultConfig()) }
 
 func NewWithConfig(config *Config) *Manager {
 	m := &Manager{
-		bindings:   make(map[InputID]common.Action),
-		axisValues: make(map[GamepadAxis]float64),
-		config:     config,
+		bindings:     make(map[InputID]common.Action),
+		actionInputs: make(map[common.Action][]InputID),
+		axisValues:   make(map[GamepadAxis]float64),
+		sequences:    make(map[Sequence]*sequenceProgress),
+		consumed:     make(map[common.Action]bool),
+		config:       config,
+		justPressed:  deviceJustPressed{},
 	}
 
 	m.setupDefaultBindings()
@@ -94,13 +313,19 @@ func (m *Manager) setupDefaultBindings() {
 		ebiten.Key2:      common.ActionUseAbility2,
 		ebiten.Key3:      common.ActionUseAbility3,
 		ebiten.KeyEscape: common.ActionPause,
+		ebiten.KeyTab:    common.ActionMenu,
 		ebiten.KeyUp:     common.ActionMoveUp,
 		ebiten.KeyDown:   common.ActionMoveDown,
 		ebiten.KeyLeft:   common.ActionMoveLeft,
 		ebiten.KeyRight:  common.ActionMoveRight,
 		ebiten.KeyF1:     common.ActionToggleDebug,
+		ebiten.KeyF5:     common.ActionQuickSave,
+		ebiten.KeyF9:     common.ActionQuickLoad,
 	}
 
+	// Keyboard aim keys, replacing the arrow key movement when they overlap
+	maps.Copy(defaultKeys, keyboardAimKeys(m.config.KeyboardAim))
+
 	defaultGamepadButtons := map[ebiten.StandardGamepadButton]common.Action{
 		ebiten.StandardGamepadButtonLeftTop:    common.ActionMoveUp,
 		ebiten.StandardGamepadButtonLeftRight:  common.ActionMoveRight,
@@ -120,6 +345,8 @@ func (m *Manager) setupDefaultBindings() {
 		{Modifier: ebiten.KeyControl, Key: ebiten.KeyP}: common.ActionTogglePlayerDebug,
 		{Modifier: ebiten.KeyControl, Key: ebiten.KeyI}: common.ActionToggleInputDebug,
 		{Modifier: ebiten.KeyControl, Key: ebiten.KeyB}: common.ActionToggleBindingEditor,
+		{Modifier: ebiten.KeyControl, Key: ebiten.KeyN}: common.ActionToggleNoclip,
+		{Modifier: ebiten.KeyControl, Key: ebiten.KeyK}: common.ActionCyclePalette,
 	}
 
 	for k, v := range defaultKeys {
@@ -135,13 +362,62 @@ func (m *Manager) setupDefaultBindings() {
 	}
 }
 
+// keyboardAimKeys returns the key bindings a keyboard aim mode adds
+func keyboardAimKeys(mode KeyboardAimMode) map[ebiten.Key]common.Action {
+	switch mode {
+	case KeyboardAimArrows:
+		return map[ebiten.Key]common.Action{
+			ebiten.KeyUp:    common.ActionAimUp,
+			ebiten.KeyDown:  common.ActionAimDown,
+			ebiten.KeyLeft:  common.ActionAimLeft,
+			ebiten.KeyRight: common.ActionAimRight,
+		}
+	case KeyboardAimIJKL:
+		return map[ebiten.Key]common.Action{
+			ebiten.KeyI: common.ActionAimUp,
+			ebiten.KeyK: common.ActionAimDown,
+			ebiten.KeyJ: common.ActionAimLeft,
+			ebiten.KeyL: common.ActionAimRight,
+		}
+	default:
+		return nil
+	}
+}
+
+// SetKeyboardAim switches the keys used for keyboard aiming. Keys of the
+// previous mode are unbound, and arrow keys go back to moving.
+func (m *Manager) SetKeyboardAim(mode KeyboardAimMode) {
+	if mode == m.config.KeyboardAim {
+		return
+	}
+
+	for key := range keyboardAimKeys(m.config.KeyboardAim) {
+		m.Unbind(KeyboardKey{Key: key})
+	}
+	if m.config.KeyboardAim == KeyboardAimArrows {
+		m.Bind(KeyboardKey{Key: ebiten.KeyUp}, common.ActionMoveUp)
+		m.Bind(KeyboardKey{Key: ebiten.KeyDown}, common.ActionMoveDown)
+		m.Bind(KeyboardKey{Key: ebiten.KeyLeft}, common.ActionMoveLeft)
+		m.Bind(KeyboardKey{Key: ebiten.KeyRight}, common.ActionMoveRight)
+	}
+
+	m.config.KeyboardAim = mode
+	for key, action := range keyboardAimKeys(mode) {
+		m.Bind(KeyboardKey{Key: key}, action)
+	}
+}
+
+// GetKeyboardAimMode returns the keys currently used for keyboard aiming
+func (m *Manager) GetKeyboardAimMode() KeyboardAimMode {
+	return m.config.KeyboardAim
+}
+
 func (m *Manager) updateGamepadState() {
-	ids := ebiten.AppendGamepadIDs(nil)
 	wasUsingGamepad := m.usingGamepad
 
-	if len(ids) > 0 {
+	if len(m.gamepads) > 0 {
 		// Check for any gamepad input
-		for _, id := range ids {
+		for _, id := range m.gamepads {
 			// Check buttons
 			for b := ebiten.StandardGamepadButtonLeftTop; b <= ebiten.StandardGamepadButtonMax; b++ {
 				if ebiten.IsStandardGamepadButtonPressed(id, b) {
@@ -180,11 +456,127 @@ func (m *Manager) updateGamepadState() {
 	m.usingGamepad = wasUsingGamepad
 }
 
-func (m *Manager) Update() error {
+// updateGamepadConnections tracks gamepads being plugged in and unplugged
+func (m *Manager) updateGamepadConnections() {
+	m.applyGamepadConnections(inpututil.AppendJustConnectedGamepadIDs(nil), inpututil.IsGamepadJustDisconnected)
+}
+
+// applyGamepadConnections adds the gamepads connected this frame and drops
+// the ones justDisconnected reports, publishing an event for each
+func (m *Manager) applyGamepadConnections(justConnected []ebiten.GamepadID, justDisconnected func(ebiten.GamepadID) bool) {
+	// Drop gamepads that were unplugged since the last update
+	connected := m.gamepads[:0]
+	for _, id := range m.gamepads {
+		if justDisconnected(id) {
+			m.publish(events.GamepadDisconnected{ID: id})
+			continue
+		}
+		connected = append(connected, id)
+	}
+	m.gamepads = connected
+
+	// Add newly connected gamepads
+	for _, id := range justConnected {
+		m.gamepads = append(m.gamepads, id)
+		m.publish(events.GamepadConnected{ID: id})
+	}
+
+	// Fall back to keyboard and mouse once the last gamepad is gone
+	if len(m.gamepads) == 0 {
+		m.usingGamepad = false
+	}
+}
+
+// publish sends an event if an event bus is attached
+func (m *Manager) publish(event events.Event) {
+	if m.events != nil {
+		m.events.Publish(event)
+	}
+}
+
+// Update polls the devices. dt is the real time since the last update and
+// times key sequences, so it should not be scaled or paused.
+func (m *Manager) Update(dt time.Duration) error {
+	clear(m.consumed)
+	m.updateGamepadConnections()
 	m.updateGamepadState()
+	m.updateMovementHistory()
+	m.updateStickEdges()
+	m.updateSequences(dt)
 	return nil
 }
 
+// updateSequences advances every bound key sequence by this frame's key presses
+func (m *Manager) updateSequences(dt time.Duration) {
+	if len(m.sequences) == 0 {
+		return
+	}
+
+	m.justPressedKeys = m.justPressed.AppendJustPressedKeys(m.justPressedKeys[:0])
+	for sequence, progress := range m.sequences {
+		progress.advance(sequence, m.justPressedKeys, dt)
+	}
+}
+
+// updateStickEdges samples the primary gamepad's left stick for push edges
+func (m *Manager) updateStickEdges() {
+	var x, y float64
+	if id, ok := m.primaryGamepad(); ok {
+		x = ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickHorizontal)
+		y = ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickVertical)
+	}
+	m.stick.update(x, y, m.config.StickThreshold)
+}
+
+// StickPushed returns whether the left stick is pushed past the threshold in a direction
+func (m *Manager) StickPushed(direction StickDirection) bool {
+	if direction < 0 || direction >= stickDirectionCount {
+		return false
+	}
+	return m.stick.pushed[direction]
+}
+
+// StickJustPushed returns whether the left stick crossed the threshold in a
+// direction this frame, e.g. for flick inputs
+func (m *Manager) StickJustPushed(direction StickDirection) bool {
+	if direction < 0 || direction >= stickDirectionCount {
+		return false
+	}
+	return m.stick.justPushed[direction]
+}
+
+// updateMovementHistory tracks which movement directions were pressed first and last
+func (m *Manager) updateMovementHistory() {
+	m.horizontal.update(
+		m.IsPressed(common.ActionMoveLeft), m.IsPressed(common.ActionMoveRight),
+		m.JustPressed(common.ActionMoveLeft), m.JustPressed(common.ActionMoveRight),
+	)
+	m.vertical.update(
+		m.IsPressed(common.ActionMoveUp), m.IsPressed(common.ActionMoveDown),
+		m.JustPressed(common.ActionMoveUp), m.JustPressed(common.ActionMoveDown),
+	)
+}
+
+// SetEventBus sets the bus gamepad connection events are published on
+func (m *Manager) SetEventBus(bus *events.Bus) {
+	m.events = bus
+}
+
+// ConnectedGamepads returns the connected gamepads in connection order
+func (m *Manager) ConnectedGamepads() []ebiten.GamepadID {
+	ids := make([]ebiten.GamepadID, len(m.gamepads))
+	copy(ids, m.gamepads)
+	return ids
+}
+
+// primaryGamepad returns the gamepad used for analog input
+func (m *Manager) primaryGamepad() (ebiten.GamepadID, bool) {
+	if len(m.gamepads) == 0 {
+		return 0, false
+	}
+	return m.gamepads[0], true
+}
+
 func (m *Manager) Rebind(oldInput InputID, newInput InputID) {
 	binding := m.bindings[oldInput]
 	m.Unbind(oldInput)
@@ -192,11 +584,56 @@ func (m *Manager) Rebind(oldInput InputID, newInput InputID) {
 }
 
 func (m *Manager) Bind(input InputID, binding common.Action) {
+	// An input maps to a single action, drop it from the previous one
+	m.Unbind(input)
+
 	m.bindings[input] = binding
+	m.actionInputs[binding] = append(m.actionInputs[binding], input)
+
+	if sequence, ok := input.(Sequence); ok {
+		m.sequences[sequence] = &sequenceProgress{}
+	}
 }
 
 func (m *Manager) Unbind(input InputID) {
+	action, ok := m.bindings[input]
+	if !ok {
+		return
+	}
 	delete(m.bindings, input)
+	if sequence, ok := input.(Sequence); ok {
+		delete(m.sequences, sequence)
+	}
+
+	inputs := m.actionInputs[action]
+	for i, existing := range inputs {
+		if existing == input {
+			m.actionInputs[action] = append(inputs[:i], inputs[i+1:]...)
+			break
+		}
+	}
+	if len(m.actionInputs[action]) == 0 {
+		delete(m.actionInputs, action)
+	}
+}
+
+// UnbindAction removes every input mapped to an action
+func (m *Manager) UnbindAction(action common.Action) {
+	for _, input := range m.actionInputs[action] {
+		delete(m.bindings, input)
+		if sequence, ok := input.(Sequence); ok {
+			delete(m.sequences, sequence)
+		}
+	}
+	delete(m.actionInputs, action)
+}
+
+// ResetToDefaults discards all bindings and restores the default set
+func (m *Manager) ResetToDefaults() {
+	clear(m.bindings)
+	clear(m.actionInputs)
+	clear(m.sequences)
+	m.setupDefaultBindings()
 }
 
 func (m *Manager) isInputActive(id InputID) bool {
@@ -207,6 +644,9 @@ func (m *Manager) isInputActive(id InputID) bool {
 		return ebiten.IsStandardGamepadButtonPressed(v.GamepadID, v.Button)
 	case ComboKey:
 		return ebiten.IsKeyPressed(v.Modifier) && ebiten.IsKeyPressed(v.Key)
+	case Sequence:
+		// A sequence has no held state, it is only active the frame it completes
+		return m.sequenceFired(v)
 	default:
 		return false
 	}
@@ -222,6 +662,8 @@ func (m *Manager) isInputJustPressed(id InputID) bool {
 		// For combo keys, detect just pressed when either key is just pressed while the other is held
 		return (ebiten.IsKeyPressed(v.Modifier) && inpututil.IsKeyJustPressed(v.Key)) ||
 			(ebiten.IsKeyPressed(v.Key) && inpututil.IsKeyJustPressed(v.Modifier))
+	case Sequence:
+		return m.sequenceFired(v)
 	default:
 		return false
 	}
@@ -244,9 +686,18 @@ func (m *Manager) isInputJustReleased(id InputID) bool {
 	}
 }
 
+// sequenceFired returns whether a bound sequence was completed this frame
+func (m *Manager) sequenceFired(s Sequence) bool {
+	progress, ok := m.sequences[s]
+	return ok && progress.fired
+}
+
 func (m *Manager) IsPressed(action common.Action) bool {
-	for input, a := range m.bindings {
-		if a == action && m.isInputActive(input) {
+	if m.override != nil {
+		return m.override.Actions[action].Active
+	}
+	for _, input := range m.actionInputs[action] {
+		if m.isInputActive(input) {
 			return true
 		}
 	}
@@ -254,17 +705,43 @@ func (m *Manager) IsPressed(action common.Action) bool {
 }
 
 func (m *Manager) JustPressed(action common.Action) bool {
-	for input, a := range m.bindings {
-		if a == action && m.isInputJustPressed(input) {
+	if m.consumed[action] {
+		return false
+	}
+	if m.override != nil {
+		return m.override.Actions[action].JustPressed
+	}
+	for _, input := range m.actionInputs[action] {
+		if m.isInputJustPressed(input) {
 			return true
 		}
 	}
 	return false
 }
 
+// Consume marks an action's press as handled, so JustPressed returns false
+// for it until the next Update. Whoever polls first gets the press.
+func (m *Manager) Consume(action common.Action) {
+	m.consumed[action] = true
+}
+
+// Reset drops partly entered key sequences and consumes every press of this
+// frame, so input from before a pause or scene change doesn't carry over
+func (m *Manager) Reset() {
+	for _, progress := range m.sequences {
+		*progress = sequenceProgress{}
+	}
+	for _, action := range common.Actions {
+		m.consumed[action] = true
+	}
+}
+
 func (m *Manager) JustReleased(action common.Action) bool {
-	for input, a := range m.bindings {
-		if a == action && m.isInputJustReleased(input) {
+	if m.override != nil {
+		return m.override.Actions[action].JustReleased
+	}
+	for _, input := range m.actionInputs[action] {
+		if m.isInputJustReleased(input) {
 			return true
 		}
 	}
@@ -272,32 +749,27 @@ func (m *Manager) JustReleased(action common.Action) bool {
 }
 
 func (m *Manager) GetMovementVector() (float64, float64) {
-	dx, dy := 0.0, 0.0
-
-	// Digital input (keyboard/d-pad)
-	if m.IsPressed(common.ActionMoveUp) {
-		dy--
-	}
-	if m.IsPressed(common.ActionMoveDown) {
-		dy++
-	}
-	if m.IsPressed(common.ActionMoveLeft) {
-		dx--
-	}
-	if m.IsPressed(common.ActionMoveRight) {
-		dx++
+	if m.override != nil {
+		return m.override.MoveX, m.override.MoveY
 	}
 
+	// Digital input (keyboard/d-pad), with opposing directions resolved by the SOCD mode
+	dx := m.horizontal.resolve(
+		m.IsPressed(common.ActionMoveLeft), m.IsPressed(common.ActionMoveRight), m.config.SOCD,
+	)
+	dy := m.vertical.resolve(
+		m.IsPressed(common.ActionMoveUp), m.IsPressed(common.ActionMoveDown), m.config.SOCD,
+	)
+
 	// If using digital input, normalize to get full magnitude
 	if dx != 0 || dy != 0 {
 		return normalizeVector(dx, dy)
 	}
 
 	// Check analog stick
-	ids := ebiten.AppendGamepadIDs(nil)
-	if len(ids) > 0 {
-		dx = ebiten.StandardGamepadAxisValue(ids[0], ebiten.StandardGamepadAxisLeftStickHorizontal)
-		dy = ebiten.StandardGamepadAxisValue(ids[0], ebiten.StandardGamepadAxisLeftStickVertical)
+	if id, ok := m.primaryGamepad(); ok {
+		dx = ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickHorizontal)
+		dy = ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickVertical)
 
 		// Apply deadzone with smooth transition
 		magnitude := math.Sqrt(dx*dx + dy*dy)
@@ -330,7 +802,21 @@ func (m *Manager) GetMousePositionWorld() (int, int) {
 }
 
 func (m *Manager) GetMousePosition() (int, int) {
-	return ebiten.CursorPosition()
+	if m.override != nil {
+		return m.override.MouseX, m.override.MouseY
+	}
+	x, y := ebiten.CursorPosition()
+	pos := common.Vector2{X: float64(x), Y: float64(y)}
+	if m.letterbox != nil {
+		pos = m.letterbox.ToInner(pos)
+	}
+	return int(math.Floor(pos.X)), int(math.Floor(pos.Y))
+}
+
+// SetLetterbox sets the mapping from window to render area used for the
+// cursor, nil to use window coordinates as they are
+func (m *Manager) SetLetterbox(letterbox CursorMapping) {
+	m.letterbox = letterbox
 }
 
 func (m *Manager) SetCamera(camera *camera.Camera) {
@@ -338,10 +824,12 @@ func (m *Manager) SetCamera(camera *camera.Camera) {
 }
 
 func (m *Manager) GetGamepadAim() (float64, float64, bool) {
-	ids := ebiten.AppendGamepadIDs(nil)
-	if len(ids) > 0 {
-		dx := ebiten.StandardGamepadAxisValue(ids[0], ebiten.StandardGamepadAxisRightStickHorizontal)
-		dy := ebiten.StandardGamepadAxisValue(ids[0], ebiten.StandardGamepadAxisRightStickVertical)
+	if m.override != nil {
+		return m.override.AimX, m.override.AimY, m.override.HasAim
+	}
+	if id, ok := m.primaryGamepad(); ok {
+		dx := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisRightStickHorizontal)
+		dy := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisRightStickVertical)
 
 		if math.Abs(dx) >= m.config.Deadzone || math.Abs(dy) >= m.config.Deadzone {
 			return dx, dy, true
@@ -350,6 +838,30 @@ func (m *Manager) GetGamepadAim() (float64, float64, bool) {
 	return 0, 0, false
 }
 
+// GetKeyboardAim returns the normalized direction of the held aim keys, and
+// false when none are held or opposing keys cancel out
+func (m *Manager) GetKeyboardAim() (float64, float64, bool) {
+	var dx, dy float64
+	if m.IsPressed(common.ActionAimLeft) {
+		dx--
+	}
+	if m.IsPressed(common.ActionAimRight) {
+		dx++
+	}
+	if m.IsPressed(common.ActionAimUp) {
+		dy--
+	}
+	if m.IsPressed(common.ActionAimDown) {
+		dy++
+	}
+
+	if dx == 0 && dy == 0 {
+		return 0, 0, false
+	}
+	length := math.Hypot(dx, dy)
+	return dx / length, dy / length, true
+}
+
 // GetAllBindings returns all input bindings, including gamepad bindings
 func (m *Manager) GetAllBindings() map[InputID]common.Action {
 	// Return a copy of all bindings
@@ -370,6 +882,9 @@ func (m *Manager) GetActionState(action common.Action) common.ActionState {
 
 // IsUsingGamepad returns whether the last input came from a gamepad
 func (m *Manager) IsUsingGamepad() bool {
+	if m.override != nil {
+		return m.override.UsingGamepad
+	}
 	return m.usingGamepad
 }
 
@@ -384,6 +899,33 @@ func normalizeVector(x, y float64) (float64, float64) {
 	return x, y
 }
 
+// AnyKeyJustPressed returns a key that was newly pressed this frame. Control
+// keys are skipped since they only act as the modifier of a combo binding.
+func (m *Manager) AnyKeyJustPressed() (ebiten.Key, bool) {
+	m.justPressedKeys = m.justPressed.AppendJustPressedKeys(m.justPressedKeys[:0])
+	for _, key := range m.justPressedKeys {
+		if !isControlKey(key) {
+			return key, true
+		}
+	}
+	return 0, false
+}
+
+// AnyGamepadButtonJustPressed returns a standard button newly pressed this
+// frame on the given gamepad
+func (m *Manager) AnyGamepadButtonJustPressed(id ebiten.GamepadID) (ebiten.StandardGamepadButton, bool) {
+	m.justPressedButtons = m.justPressed.AppendJustPressedStandardGamepadButtons(id, m.justPressedButtons[:0])
+	if len(m.justPressedButtons) == 0 {
+		return 0, false
+	}
+	return m.justPressedButtons[0], true
+}
+
+// isControlKey returns whether key is one of the Control modifier keys
+func isControlKey(key ebiten.Key) bool {
+	return key == ebiten.KeyControl || key == ebiten.KeyControlLeft || key == ebiten.KeyControlRight
+}
+
 func (m *Manager) GetCurrentKey() string {
 	for k := ebiten.Key(0); k <= ebiten.KeyMax; k++ {
 		if ebiten.IsKeyPressed(k) {
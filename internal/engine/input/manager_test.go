@@ -0,0 +1,493 @@
+package input
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"maps"
+	"math"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/events"
+	"slices"
+	"testing"
+	"time"
+)
+
+// pressed returns a snapshot with the given actions held
+func pressed(actions ...common.Action) InputSnapshot {
+	snapshot := InputSnapshot{Actions: make(map[common.Action]common.ActionState)}
+	for _, action := range actions {
+		snapshot.Actions[action] = common.ActionState{Active: true}
+	}
+	return snapshot
+}
+
+func TestGetKeyboardAim(t *testing.T) {
+	diagonal := 1 / math.Sqrt2
+
+	tests := []struct {
+		name   string
+		held   []common.Action
+		wantX  float64
+		wantY  float64
+		wantOK bool
+	}{
+		{"none", nil, 0, 0, false},
+		{"up", []common.Action{common.ActionAimUp}, 0, -1, true},
+		{"right", []common.Action{common.ActionAimRight}, 1, 0, true},
+		{"down left", []common.Action{common.ActionAimDown, common.ActionAimLeft}, -diagonal, diagonal, true},
+		{"opposites cancel", []common.Action{common.ActionAimLeft, common.ActionAimRight}, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.ApplySnapshot(pressed(tt.held...))
+
+			x, y, ok := m.GetKeyboardAim()
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if math.Abs(x-tt.wantX) > 1e-9 || math.Abs(y-tt.wantY) > 1e-9 {
+				t.Errorf("aim = (%v, %v), want (%v, %v)", x, y, tt.wantX, tt.wantY)
+			}
+			if ok && math.Abs(math.Hypot(x, y)-1) > 1e-9 {
+				t.Errorf("aim (%v, %v) is not normalized", x, y)
+			}
+		})
+	}
+}
+
+func TestSetKeyboardAimRebinds(t *testing.T) {
+	m := New()
+	up := KeyboardKey{Key: ebiten.KeyUp}
+	if got := m.GetAllBindings()[up]; got != common.ActionMoveUp {
+		t.Fatalf("arrow up bound to %v by default, want %v", got, common.ActionMoveUp)
+	}
+
+	m.SetKeyboardAim(KeyboardAimArrows)
+	if got := m.GetAllBindings()[up]; got != common.ActionAimUp {
+		t.Fatalf("arrow up bound to %v after switching to arrows, want %v", got, common.ActionAimUp)
+	}
+
+	m.SetKeyboardAim(KeyboardAimIJKL)
+	if got := m.GetAllBindings()[up]; got != common.ActionMoveUp {
+		t.Errorf("arrow up bound to %v after leaving arrows, want %v", got, common.ActionMoveUp)
+	}
+	if m.GetKeyboardAimMode() != KeyboardAimIJKL {
+		t.Errorf("mode = %v, want %v", m.GetKeyboardAimMode(), KeyboardAimIJKL)
+	}
+}
+
+// isPressedScan is IsPressed without the action index, scanning every
+// binding for the action
+func isPressedScan(m *Manager, action common.Action) bool {
+	for input, bound := range m.bindings {
+		if bound == action && m.isInputActive(input) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkActionIndex fails unless the action index is the exact inverse of
+// the bindings
+func checkActionIndex(t *testing.T, m *Manager) {
+	t.Helper()
+	indexed := 0
+	for action, inputs := range m.actionInputs {
+		if len(inputs) == 0 {
+			t.Errorf("empty index entry for %v", action)
+		}
+		for _, input := range inputs {
+			indexed++
+			if bound, ok := m.bindings[input]; !ok || bound != action {
+				t.Errorf("index has %v for %v, bound to %v", input, action, bound)
+			}
+		}
+	}
+	if indexed != len(m.bindings) {
+		t.Errorf("index holds %d inputs, want %d", indexed, len(m.bindings))
+	}
+}
+
+func TestActionIndexFollowsBindings(t *testing.T) {
+	m := New()
+	checkActionIndex(t, m)
+
+	a := KeyboardKey{Key: ebiten.KeyA}
+	z := KeyboardKey{Key: ebiten.KeyZ}
+
+	steps := []struct {
+		name string
+		edit func()
+	}{
+		{"bind", func() { m.Bind(z, common.ActionInteract) }},
+		{"bind moves input", func() { m.Bind(z, common.ActionQuickSave) }},
+		{"rebind", func() { m.Rebind(z, a) }},
+		{"unbind", func() { m.Unbind(a) }},
+		{"unbind missing", func() { m.Unbind(a) }},
+		{"unbind action", func() { m.UnbindAction(common.ActionMoveUp) }},
+		{"reset", m.ResetToDefaults},
+	}
+
+	for _, step := range steps {
+		step.edit()
+		t.Run(step.name, func(t *testing.T) {
+			checkActionIndex(t, m)
+			for _, action := range common.Actions {
+				if got, want := m.IsPressed(action), isPressedScan(m, action); got != want {
+					t.Errorf("IsPressed(%v) = %v, scan gives %v", action, got, want)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkIsPressed(b *testing.B) {
+	m := New()
+
+	b.Run("scan", func(b *testing.B) {
+		for b.Loop() {
+			for _, action := range common.Actions {
+				isPressedScan(m, action)
+			}
+		}
+	})
+
+	b.Run("index", func(b *testing.B) {
+		for b.Loop() {
+			for _, action := range common.Actions {
+				m.IsPressed(action)
+			}
+		}
+	})
+}
+
+func TestResetDropsPendingInput(t *testing.T) {
+	m := New()
+	sequence := NewSequence(0, ebiten.KeyQ, ebiten.KeyE)
+	m.Bind(sequence, common.ActionInteract)
+	m.sequences[sequence].advance(sequence, []ebiten.Key{ebiten.KeyQ}, 0)
+
+	snapshot := pressed(common.ActionAutoAttack)
+	snapshot.Actions[common.ActionAutoAttack] = common.ActionState{Active: true, JustPressed: true}
+	m.ApplySnapshot(snapshot)
+
+	m.Reset()
+	if got := *m.sequences[sequence]; got != (sequenceProgress{}) {
+		t.Errorf("sequence progress = %+v after Reset, want none", got)
+	}
+	if m.JustPressed(common.ActionAutoAttack) {
+		t.Error("press from before Reset is still pending")
+	}
+	if !m.IsPressed(common.ActionAutoAttack) {
+		t.Error("Reset released a held action")
+	}
+}
+
+func TestConsumeLastsUntilUpdate(t *testing.T) {
+	m := New()
+	snapshot := pressed(common.ActionInteract)
+	snapshot.Actions[common.ActionInteract] = common.ActionState{Active: true, JustPressed: true}
+	m.ApplySnapshot(snapshot)
+
+	m.Consume(common.ActionInteract)
+	for range 2 {
+		if m.JustPressed(common.ActionInteract) {
+			t.Fatal("JustPressed = true after Consume in the same frame")
+		}
+	}
+	if !m.IsPressed(common.ActionInteract) {
+		t.Error("Consume released the held action")
+	}
+
+	if err := m.Update(16 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if !m.JustPressed(common.ActionInteract) {
+		t.Error("JustPressed = false after Update, want the consumed state cleared")
+	}
+}
+
+// fakeGamepads simulates gamepads being plugged in and out between updates
+type fakeGamepads struct {
+	connected    []ebiten.GamepadID
+	disconnected map[ebiten.GamepadID]bool
+}
+
+func (f *fakeGamepads) plug(ids ...ebiten.GamepadID) { f.connected = append(f.connected, ids...) }
+
+func (f *fakeGamepads) unplug(ids ...ebiten.GamepadID) {
+	if f.disconnected == nil {
+		f.disconnected = make(map[ebiten.GamepadID]bool)
+	}
+	for _, id := range ids {
+		f.disconnected[id] = true
+	}
+}
+
+// update feeds one frame of transitions to m and clears them
+func (f *fakeGamepads) update(m *Manager) {
+	m.applyGamepadConnections(f.connected, func(id ebiten.GamepadID) bool { return f.disconnected[id] })
+	f.connected = nil
+	clear(f.disconnected)
+}
+
+func TestGamepadConnectionEvents(t *testing.T) {
+	bus := events.NewBus()
+	var got []events.Event
+	record := func(e events.Event) { got = append(got, e) }
+	bus.Subscribe(events.TypeGamepadConnected, record)
+	bus.Subscribe(events.TypeGamepadDisconnected, record)
+
+	m := New()
+	m.SetEventBus(bus)
+	pads := &fakeGamepads{}
+
+	steps := []struct {
+		name       string
+		change     func()
+		want       []events.Event
+		wantPads   []ebiten.GamepadID
+		usingAfter bool
+	}{
+		{
+			name:     "plug two",
+			change:   func() { pads.plug(3, 5) },
+			want:     []events.Event{events.GamepadConnected{ID: 3}, events.GamepadConnected{ID: 5}},
+			wantPads: []ebiten.GamepadID{3, 5},
+		},
+		{
+			name:     "nothing changes",
+			change:   func() {},
+			wantPads: []ebiten.GamepadID{3, 5},
+		},
+		{
+			name:     "unplug the first",
+			change:   func() { pads.unplug(3) },
+			want:     []events.Event{events.GamepadDisconnected{ID: 3}},
+			wantPads: []ebiten.GamepadID{5},
+		},
+		{
+			name:     "swap",
+			change:   func() { pads.unplug(5); pads.plug(3) },
+			want:     []events.Event{events.GamepadDisconnected{ID: 5}, events.GamepadConnected{ID: 3}},
+			wantPads: []ebiten.GamepadID{3},
+		},
+		{
+			name:     "unplug the last",
+			change:   func() { pads.unplug(3) },
+			want:     []events.Event{events.GamepadDisconnected{ID: 3}},
+			wantPads: []ebiten.GamepadID{},
+		},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			got = nil
+			m.usingGamepad = true
+			step.change()
+			pads.update(m)
+
+			if !slices.Equal(got, step.want) {
+				t.Errorf("events = %v, want %v", got, step.want)
+			}
+			if pads := m.ConnectedGamepads(); !slices.Equal(pads, step.wantPads) {
+				t.Errorf("ConnectedGamepads() = %v, want %v", pads, step.wantPads)
+			}
+			if wantUsing := len(step.wantPads) > 0; m.IsUsingGamepad() != wantUsing {
+				t.Errorf("IsUsingGamepad() = %v, want %v", m.IsUsingGamepad(), wantUsing)
+			}
+		})
+	}
+}
+
+func TestSOCDResolution(t *testing.T) {
+	// Each step is one frame of the left and right keys on a single axis
+	type step struct {
+		left, right bool
+	}
+
+	tests := []struct {
+		name  string
+		steps []step
+		want  map[SOCDMode]float64
+	}{
+		{
+			name:  "left then right",
+			steps: []step{{true, false}, {true, true}},
+			want:  map[SOCDMode]float64{SOCDNeutral: 0, SOCDLastInput: 1, SOCDFirstInput: -1},
+		},
+		{
+			name:  "right then left",
+			steps: []step{{false, true}, {true, true}, {true, true}},
+			want:  map[SOCDMode]float64{SOCDNeutral: 0, SOCDLastInput: -1, SOCDFirstInput: 1},
+		},
+		{
+			name:  "pressed together",
+			steps: []step{{true, true}},
+			want:  map[SOCDMode]float64{SOCDNeutral: 0, SOCDLastInput: 0, SOCDFirstInput: 0},
+		},
+		{
+			name:  "first released",
+			steps: []step{{true, false}, {true, true}, {false, true}},
+			want:  map[SOCDMode]float64{SOCDNeutral: 1, SOCDLastInput: 1, SOCDFirstInput: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		for mode, want := range tt.want {
+			t.Run(tt.name+"/"+mode.String(), func(t *testing.T) {
+				var h axisHistory
+				var prev step
+				for _, s := range tt.steps {
+					h.update(s.left, s.right, s.left && !prev.left, s.right && !prev.right)
+					prev = s
+				}
+				if got := h.resolve(prev.left, prev.right, mode); got != want {
+					t.Errorf("resolved to %v, want %v", got, want)
+				}
+			})
+		}
+	}
+}
+
+// fakeJustPressed reports scripted keys and buttons as newly pressed
+type fakeJustPressed struct {
+	keys    []ebiten.Key
+	buttons map[ebiten.GamepadID][]ebiten.StandardGamepadButton
+}
+
+func (f *fakeJustPressed) AppendJustPressedKeys(keys []ebiten.Key) []ebiten.Key {
+	return append(keys, f.keys...)
+}
+
+func (f *fakeJustPressed) AppendJustPressedStandardGamepadButtons(id ebiten.GamepadID, buttons []ebiten.StandardGamepadButton) []ebiten.StandardGamepadButton {
+	return append(buttons, f.buttons[id]...)
+}
+
+func TestAnyKeyJustPressed(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []ebiten.Key
+		want    ebiten.Key
+		wantHit bool
+	}{
+		{"nothing pressed", nil, 0, false},
+		{"single key", []ebiten.Key{ebiten.KeyQ}, ebiten.KeyQ, true},
+		{"control alone", []ebiten.Key{ebiten.KeyControlLeft}, 0, false},
+		{"control skipped", []ebiten.Key{ebiten.KeyControlLeft, ebiten.KeyR}, ebiten.KeyR, true},
+		{"first of several", []ebiten.Key{ebiten.KeyE, ebiten.KeyR}, ebiten.KeyE, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.justPressed = &fakeJustPressed{keys: tt.keys}
+
+			key, ok := m.AnyKeyJustPressed()
+			if ok != tt.wantHit || key != tt.want {
+				t.Errorf("AnyKeyJustPressed() = %v, %v, want %v, %v", key, ok, tt.want, tt.wantHit)
+			}
+		})
+	}
+}
+
+func TestAnyGamepadButtonJustPressed(t *testing.T) {
+	m := New()
+	m.justPressed = &fakeJustPressed{buttons: map[ebiten.GamepadID][]ebiten.StandardGamepadButton{
+		1: {ebiten.StandardGamepadButtonRightBottom, ebiten.StandardGamepadButtonLeftTop},
+	}}
+
+	if button, ok := m.AnyGamepadButtonJustPressed(1); !ok || button != ebiten.StandardGamepadButtonRightBottom {
+		t.Errorf("gamepad 1 = %v, %v, want %v", button, ok, ebiten.StandardGamepadButtonRightBottom)
+	}
+	if button, ok := m.AnyGamepadButtonJustPressed(0); ok {
+		t.Errorf("gamepad 0 reported %v with nothing pressed", button)
+	}
+}
+
+func TestResetToDefaultsRestoresDefaultSet(t *testing.T) {
+	defaults := New()
+
+	m := New()
+	m.Bind(KeyboardKey{Key: ebiten.KeyQ}, common.ActionMoveUp)
+	m.Bind(KeyboardKey{Key: ebiten.KeyW}, common.ActionAutoAttack)
+	m.Bind(NewSequence(time.Second, ebiten.KeyUp, ebiten.KeyUp), common.ActionUseAbility3)
+	m.UnbindAction(common.ActionPause)
+
+	m.ResetToDefaults()
+
+	if !maps.Equal(m.bindings, defaults.bindings) {
+		t.Errorf("bindings after reset = %v, want %v", m.bindings, defaults.bindings)
+	}
+	if len(m.sequences) != len(defaults.sequences) {
+		t.Errorf("%d sequences after reset, want %d", len(m.sequences), len(defaults.sequences))
+	}
+	checkActionIndex(t, m)
+}
+
+func TestUnbindActionRemovesEveryInput(t *testing.T) {
+	m := New()
+	m.Bind(GamepadButton{Button: ebiten.StandardGamepadButtonLeftTop}, common.ActionMoveUp)
+	m.Bind(NewSequence(time.Second, ebiten.KeyW, ebiten.KeyW), common.ActionMoveUp)
+	others := len(m.bindings) - len(m.actionInputs[common.ActionMoveUp])
+
+	m.UnbindAction(common.ActionMoveUp)
+
+	for input, action := range m.bindings {
+		if action == common.ActionMoveUp {
+			t.Errorf("%v still bound to move up", input)
+		}
+	}
+	if got := len(m.bindings); got != others {
+		t.Errorf("%d bindings left, want the %d for other actions", got, others)
+	}
+	for sequence := range m.sequences {
+		if sequence.Keys[0] == ebiten.KeyW {
+			t.Error("unbound sequence still tracked")
+		}
+	}
+	checkActionIndex(t, m)
+}
+
+func TestStickThresholdCrossing(t *testing.T) {
+	// Horizontal stick positions on successive frames and the expected
+	// state of the right direction after each
+	frames := []struct {
+		x          float64
+		pushed     bool
+		justPushed bool
+	}{
+		{0, false, false},
+		{0.3, false, false},  // Below the threshold
+		{0.6, true, true},    // Crossing
+		{0.9, true, false},   // Still held
+		{0.5, true, false},   // Exactly at the threshold
+		{0.2, false, false},  // Released
+		{0.7, true, true},    // Pushed again
+		{-0.8, false, false}, // Flicked to the other side
+	}
+
+	config := DefaultConfig()
+	config.StickThreshold = 0.5
+	m := NewWithConfig(config)
+
+	for i, f := range frames {
+		m.stick.update(f.x, 0, config.StickThreshold)
+
+		if got := m.StickPushed(StickRight); got != f.pushed {
+			t.Errorf("frame %d (x = %v): pushed = %v, want %v", i, f.x, got, f.pushed)
+		}
+		if got := m.StickJustPushed(StickRight); got != f.justPushed {
+			t.Errorf("frame %d (x = %v): just pushed = %v, want %v", i, f.x, got, f.justPushed)
+		}
+	}
+
+	// The flick to the left is a fresh push that way
+	if !m.StickJustPushed(StickLeft) {
+		t.Error("flick to the left was not just pushed")
+	}
+	if m.StickJustPushed(StickDirection(-1)) || m.StickJustPushed(stickDirectionCount) {
+		t.Error("invalid directions reported as pushed")
+	}
+}
@@ -0,0 +1,73 @@
+package input
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"time"
+)
+
+// maxSequenceKeys is the longest key sequence that can be bound
+const maxSequenceKeys = 4
+
+// Sequence represents keys pressed one after another (e.g. Q then E), each
+// within Window of the previous one. Create it with NewSequence.
+type Sequence struct {
+	Keys   [maxSequenceKeys]ebiten.Key
+	Length int
+	Window time.Duration
+}
+
+func (s Sequence) isInputID() {}
+
+// NewSequence creates a sequence of up to maxSequenceKeys keys. Extra keys
+// are dropped.
+func NewSequence(window time.Duration, keys ...ebiten.Key) Sequence {
+	s := Sequence{Window: window}
+	s.Length = copy(s.Keys[:], keys)
+	return s
+}
+
+// Steps returns the keys of the sequence in order
+func (s Sequence) Steps() []ebiten.Key {
+	return s.Keys[:s.Length]
+}
+
+// sequenceProgress tracks how far a sequence has been entered
+type sequenceProgress struct {
+	step      int
+	sinceStep time.Duration // Time since the last step was entered
+	fired     bool          // Completed this frame
+}
+
+// advance feeds the keys pressed this frame, dt after the previous one, into
+// the progress
+func (p *sequenceProgress) advance(s Sequence, pressed []ebiten.Key, dt time.Duration) {
+	p.fired = false
+	if s.Length == 0 {
+		return
+	}
+
+	// Too slow, start over
+	p.sinceStep += dt
+	if p.step > 0 && p.sinceStep > s.Window {
+		p.step = 0
+	}
+
+	for _, key := range pressed {
+		switch {
+		case key == s.Keys[p.step]:
+			p.step++
+		case key == s.Keys[0]:
+			// A wrong key that starts the sequence again counts as its first step
+			p.step = 1
+		default:
+			p.step = 0
+			continue
+		}
+
+		p.sinceStep = 0
+		if p.step == s.Length {
+			p.fired = true
+			p.step = 0
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package input
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"testing"
+	"time"
+)
+
+// frame is the keys pressed in one update and the time since the previous one
+type frame struct {
+	dt   time.Duration
+	keys []ebiten.Key
+}
+
+func TestSequenceAdvance(t *testing.T) {
+	sequence := NewSequence(300*time.Millisecond, ebiten.KeyQ, ebiten.KeyE)
+
+	tests := []struct {
+		name      string
+		frames    []frame
+		wantFired bool
+	}{
+		{
+			name:      "in time",
+			frames:    []frame{{16 * time.Millisecond, []ebiten.Key{ebiten.KeyQ}}, {200 * time.Millisecond, []ebiten.Key{ebiten.KeyE}}},
+			wantFired: true,
+		},
+		{
+			name: "idle frames within the window",
+			frames: []frame{
+				{16 * time.Millisecond, []ebiten.Key{ebiten.KeyQ}},
+				{100 * time.Millisecond, nil},
+				{100 * time.Millisecond, nil},
+				{50 * time.Millisecond, []ebiten.Key{ebiten.KeyE}},
+			},
+			wantFired: true,
+		},
+		{
+			name:   "too slow",
+			frames: []frame{{16 * time.Millisecond, []ebiten.Key{ebiten.KeyQ}}, {400 * time.Millisecond, []ebiten.Key{ebiten.KeyE}}},
+		},
+		{
+			name: "too slow across idle frames",
+			frames: []frame{
+				{16 * time.Millisecond, []ebiten.Key{ebiten.KeyQ}},
+				{200 * time.Millisecond, nil},
+				{200 * time.Millisecond, []ebiten.Key{ebiten.KeyE}},
+			},
+		},
+		{
+			name:   "wrong order",
+			frames: []frame{{16 * time.Millisecond, []ebiten.Key{ebiten.KeyE}}, {16 * time.Millisecond, []ebiten.Key{ebiten.KeyQ}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var progress sequenceProgress
+			for _, f := range tt.frames {
+				progress.advance(sequence, f.keys, f.dt)
+			}
+			if progress.fired != tt.wantFired {
+				t.Errorf("fired = %v, want %v", progress.fired, tt.wantFired)
+			}
+		})
+	}
+}
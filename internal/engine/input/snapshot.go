@@ -0,0 +1,65 @@
+package input
+
+import (
+	"maps"
+	"novampires-go/internal/common"
+)
+
+// InputSnapshot is the complete input state of one frame as plain data, for
+// rollback experiments, replays and tests
+type InputSnapshot struct {
+	Actions map[common.Action]common.ActionState
+
+	// Movement vector as returned by GetMovementVector
+	MoveX, MoveY float64
+
+	// Right stick aim as returned by GetGamepadAim
+	AimX, AimY float64
+	HasAim     bool
+
+	// Cursor position in render area pixels
+	MouseX, MouseY int
+
+	UsingGamepad bool
+}
+
+// Snapshot captures this frame's input. While a snapshot is applied that
+// snapshot is returned instead.
+func (m *Manager) Snapshot() InputSnapshot {
+	if m.override != nil {
+		snapshot := *m.override
+		snapshot.Actions = maps.Clone(m.override.Actions)
+		return snapshot
+	}
+
+	snapshot := InputSnapshot{
+		Actions:      make(map[common.Action]common.ActionState, len(common.Actions)),
+		UsingGamepad: m.usingGamepad,
+	}
+	for _, action := range common.Actions {
+		snapshot.Actions[action] = m.GetActionState(action)
+	}
+	snapshot.MoveX, snapshot.MoveY = m.GetMovementVector()
+	snapshot.AimX, snapshot.AimY, snapshot.HasAim = m.GetGamepadAim()
+	snapshot.MouseX, snapshot.MouseY = m.GetMousePosition()
+	return snapshot
+}
+
+// ApplySnapshot makes input queries return a snapshot's values instead of
+// polling devices, until ClearSnapshot. Actions missing from the snapshot
+// read as released. Consume still hides just-pressed actions. The actions are
+// copied, so the caller may reuse the map.
+func (m *Manager) ApplySnapshot(snapshot InputSnapshot) {
+	snapshot.Actions = maps.Clone(snapshot.Actions)
+	m.override = &snapshot
+}
+
+// ClearSnapshot returns to polling the devices
+func (m *Manager) ClearSnapshot() {
+	m.override = nil
+}
+
+// HasSnapshot returns whether an applied snapshot is overriding the devices
+func (m *Manager) HasSnapshot() bool {
+	return m.override != nil
+}
@@ -0,0 +1,45 @@
+package input
+
+import (
+	"novampires-go/internal/common"
+	"testing"
+)
+
+func TestApplySnapshotOverridesQueries(t *testing.T) {
+	m := New()
+	snapshot := pressed(common.ActionMoveRight)
+	snapshot.MoveX, snapshot.MoveY = 0.6, -0.8
+	m.ApplySnapshot(snapshot)
+
+	if !m.IsPressed(common.ActionMoveRight) {
+		t.Error("IsPressed(MoveRight) = false, want the snapshot's true")
+	}
+	if m.IsPressed(common.ActionMoveLeft) {
+		t.Error("IsPressed(MoveLeft) = true for an action missing from the snapshot")
+	}
+	if x, y := m.GetMovementVector(); x != 0.6 || y != -0.8 {
+		t.Errorf("GetMovementVector() = (%v, %v), want (0.6, -0.8)", x, y)
+	}
+
+	m.ClearSnapshot()
+	if x, y := m.GetMovementVector(); x != 0 || y != 0 {
+		t.Errorf("GetMovementVector() = (%v, %v) after ClearSnapshot, want no movement", x, y)
+	}
+}
+
+func TestApplySnapshotCopiesActions(t *testing.T) {
+	m := New()
+	snapshot := pressed(common.ActionInteract)
+	m.ApplySnapshot(snapshot)
+
+	// Reusing the caller's map must not change the applied snapshot
+	delete(snapshot.Actions, common.ActionInteract)
+	snapshot.Actions[common.ActionPause] = common.ActionState{Active: true}
+
+	if !m.IsPressed(common.ActionInteract) {
+		t.Error("applied snapshot lost an action when the caller's map changed")
+	}
+	if m.IsPressed(common.ActionPause) {
+		t.Error("applied snapshot picked up an action added to the caller's map")
+	}
+}
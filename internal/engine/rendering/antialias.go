@@ -0,0 +1,43 @@
+package rendering
+
+// AntiAlias overrides RenderConfig.AntiAliasing for a group of draw calls,
+// e.g. to keep small UI shapes crisp while effects stay smooth
+type AntiAlias int
+
+const (
+	// AntiAliasDefault uses RenderConfig.AntiAliasing
+	AntiAliasDefault AntiAlias = iota
+	// AntiAliasOn always anti-aliases
+	AntiAliasOn
+	// AntiAliasOff never anti-aliases
+	AntiAliasOff
+)
+
+// Resolve returns whether to anti-alias, falling back to the config value
+// for AntiAliasDefault
+func (a AntiAlias) Resolve(fallback bool) bool {
+	switch a {
+	case AntiAliasOn:
+		return true
+	case AntiAliasOff:
+		return false
+	default:
+		return fallback
+	}
+}
+
+// WithAntiAlias runs draw with anti-aliasing forced on or off for every
+// primitive it draws, restoring the previous setting afterwards
+func (r *Renderer) WithAntiAlias(aa AntiAlias, draw func()) {
+	previous := r.antiAliasOverride
+	r.antiAliasOverride = aa
+	defer func() { r.antiAliasOverride = previous }()
+
+	draw()
+}
+
+// antiAlias returns whether the primitive being drawn is anti-aliased: the
+// WithAntiAlias override if there is one, otherwise the config value
+func (r *Renderer) antiAlias() bool {
+	return r.antiAliasOverride.Resolve(r.config.AntiAliasing)
+}
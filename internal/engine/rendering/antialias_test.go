@@ -0,0 +1,55 @@
+package rendering
+
+import (
+	"novampires-go/internal/engine/camera"
+	"testing"
+)
+
+func TestAntiAliasResolve(t *testing.T) {
+	tests := []struct {
+		aa       AntiAlias
+		fallback bool
+		want     bool
+	}{
+		{AntiAliasDefault, true, true},
+		{AntiAliasDefault, false, false},
+		{AntiAliasOn, false, true},
+		{AntiAliasOff, true, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.aa.Resolve(tt.fallback); got != tt.want {
+			t.Errorf("AntiAlias(%d).Resolve(%v) = %v, want %v", tt.aa, tt.fallback, got, tt.want)
+		}
+	}
+}
+
+func TestWithAntiAliasOverridesConfig(t *testing.T) {
+	config := DefaultRenderConfig()
+	config.AntiAliasing = true
+	r := NewRenderer(config, camera.New())
+
+	if !r.antiAlias() {
+		t.Fatal("antiAlias() = false without an override, want the config value true")
+	}
+
+	r.WithAntiAlias(AntiAliasOff, func() {
+		if r.antiAlias() {
+			t.Error("antiAlias() = true inside WithAntiAlias(AntiAliasOff)")
+		}
+
+		// Nested overrides win until they return
+		r.WithAntiAlias(AntiAliasOn, func() {
+			if !r.antiAlias() {
+				t.Error("antiAlias() = false inside nested WithAntiAlias(AntiAliasOn)")
+			}
+		})
+		if r.antiAlias() {
+			t.Error("nested override was not restored")
+		}
+	})
+
+	if !r.antiAlias() {
+		t.Error("override was not restored after WithAntiAlias returned")
+	}
+}
@@ -0,0 +1,71 @@
+package rendering
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+)
+
+func TestArcSegments(t *testing.T) {
+	tests := []struct {
+		name   string
+		radius float64
+		sweep  float64
+		want   int
+	}{
+		{"small quarter uses the minimum", 20, math.Pi / 2, 3},
+		{"large quarter", 100, math.Pi / 2, 7},
+		{"counterclockwise quarter", 100, -math.Pi / 2, 7},
+		{"small full circle", 20, 2 * math.Pi, 12},
+		{"empty sweep", 100, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := arcSegments(tt.radius, tt.sweep); got != tt.want {
+				t.Errorf("arcSegments(%v, %v) = %d, want %d", tt.radius, tt.sweep, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuarterArcEndpoints(t *testing.T) {
+	center := common.Vector2{X: 50, Y: -20}
+	const radius = 100.0
+
+	tests := []struct {
+		name       string
+		startAngle float64
+		endAngle   float64
+		wantStart  common.Vector2
+		wantEnd    common.Vector2
+	}{
+		{"right to bottom", 0, math.Pi / 2, common.Vector2{X: 150, Y: -20}, common.Vector2{X: 50, Y: 80}},
+		{"top to right", -math.Pi / 2, 0, common.Vector2{X: 50, Y: -120}, common.Vector2{X: 150, Y: -20}},
+		{"bottom back to right", math.Pi / 2, 0, common.Vector2{X: 50, Y: 80}, common.Vector2{X: 150, Y: -20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sweep := tt.endAngle - tt.startAngle
+			n := arcSegments(radius, sweep)
+
+			start := arcPoint(center, radius, tt.startAngle, sweep, 0, n)
+			end := arcPoint(center, radius, tt.startAngle, sweep, n, n)
+			if start.Sub(tt.wantStart).Magnitude() > 1e-9 {
+				t.Errorf("start = %v, want %v", start, tt.wantStart)
+			}
+			if end.Sub(tt.wantEnd).Magnitude() > 1e-9 {
+				t.Errorf("end = %v, want %v", end, tt.wantEnd)
+			}
+
+			// Every point in between lies on the circle
+			for i := 1; i < n; i++ {
+				p := arcPoint(center, radius, tt.startAngle, sweep, i, n)
+				if d := p.Sub(center).Magnitude(); math.Abs(d-radius) > 1e-9 {
+					t.Errorf("point %d is %v from the center, want %v", i, d, radius)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"image"
+	"image/color"
+)
+
+// BlendMode selects how a primitive is composited onto the target image
+type BlendMode int
+
+const (
+	// BlendNormal is regular source-over alpha blending
+	BlendNormal BlendMode = iota
+
+	// BlendAdditive adds the primitive's color to the target, for glows
+	BlendAdditive
+)
+
+// Blend returns the ebiten blend used for this mode
+func (m BlendMode) Blend() ebiten.Blend {
+	switch m {
+	case BlendAdditive:
+		return ebiten.BlendLighter
+	default:
+		return ebiten.BlendSourceOver
+	}
+}
+
+var (
+	// whiteImage is the texture used to fill vector paths with vertex colors
+	whiteImage = ebiten.NewImage(3, 3)
+
+	// whiteSubImage avoids sampling the image edges
+	whiteSubImage = whiteImage.SubImage(image.Rect(1, 1, 2, 2)).(*ebiten.Image)
+)
+
+func init() {
+	whiteImage.Fill(color.White)
+}
+
+// trianglesOptions returns the draw options for a path drawn with the given blend mode
+func trianglesOptions(mode BlendMode, antiAlias bool) *ebiten.DrawTrianglesOptions {
+	return &ebiten.DrawTrianglesOptions{
+		Blend:     mode.Blend(),
+		AntiAlias: antiAlias,
+	}
+}
+
+// fillPath fills a vector path in screen coordinates with the given blend mode
+func (r *Renderer) fillPath(screen *ebiten.Image, path *vector.Path, fill color.RGBA, mode BlendMode, antiAlias bool) {
+	vertices, indices := path.AppendVerticesAndIndicesForFilling(nil, nil)
+	colorVertices(vertices, fill)
+	screen.DrawTriangles(vertices, indices, whiteSubImage, trianglesOptions(mode, antiAlias))
+}
+
+// strokePath strokes a vector path in screen coordinates with the given blend mode
+func (r *Renderer) strokePath(screen *ebiten.Image, path *vector.Path, lineWidth float64, stroke color.RGBA, mode BlendMode, antiAlias bool) {
+	vertices, indices := path.AppendVerticesAndIndicesForStroke(nil, nil, &vector.StrokeOptions{
+		Width: float32(lineWidth),
+	})
+	colorVertices(vertices, stroke)
+	screen.DrawTriangles(vertices, indices, whiteSubImage, trianglesOptions(mode, antiAlias))
+}
+
+// colorVertices sets every vertex to sample the white texture tinted with col
+func colorVertices(vertices []ebiten.Vertex, col color.RGBA) {
+	for i := range vertices {
+		setVertexColor(&vertices[i], col)
+	}
+}
+
+// setVertexColor makes a vertex sample the white texture tinted with col
+func setVertexColor(vertex *ebiten.Vertex, col color.RGBA) {
+	vertex.SrcX = 1
+	vertex.SrcY = 1
+	vertex.ColorR = float32(col.R) / 255
+	vertex.ColorG = float32(col.G) / 255
+	vertex.ColorB = float32(col.B) / 255
+	vertex.ColorA = float32(col.A) / 255
+}
@@ -0,0 +1,32 @@
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"testing"
+)
+
+func TestTrianglesOptionsBlend(t *testing.T) {
+	tests := []struct {
+		name string
+		mode BlendMode
+		want ebiten.Blend
+	}{
+		{"normal", BlendNormal, ebiten.BlendSourceOver},
+		{"additive", BlendAdditive, ebiten.BlendLighter},
+		{"unknown falls back to normal", BlendMode(99), ebiten.BlendSourceOver},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, antiAlias := range []bool{false, true} {
+				op := trianglesOptions(tt.mode, antiAlias)
+				if op.Blend != tt.want {
+					t.Errorf("Blend = %+v, want %+v", op.Blend, tt.want)
+				}
+				if op.AntiAlias != antiAlias {
+					t.Errorf("AntiAlias = %v, want %v", op.AntiAlias, antiAlias)
+				}
+			}
+		})
+	}
+}
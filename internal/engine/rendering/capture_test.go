@@ -0,0 +1,42 @@
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"novampires-go/internal/engine/camera"
+	"testing"
+)
+
+func TestCaptureWorldMatchesFrame(t *testing.T) {
+	r := NewRenderer(DefaultRenderConfig(), camera.New())
+	if r.CaptureWorld() != nil {
+		t.Fatal("captured outside of a frame")
+	}
+
+	sizes := []struct{ width, height int }{
+		{320, 180},
+		{320, 180},
+		{640, 360},
+	}
+
+	var previous *ebiten.Image
+	for i, size := range sizes {
+		screen := ebiten.NewImage(size.width, size.height)
+		r.BeginFrame(screen)
+		captured := r.CaptureWorld()
+		if captured == nil {
+			t.Fatalf("frame %d: nothing captured", i)
+		}
+		if captured == screen {
+			t.Errorf("frame %d: captured the screen itself, want a copy", i)
+		}
+		if got := captured.Bounds(); got.Dx() != size.width || got.Dy() != size.height {
+			t.Errorf("frame %d: captured %dx%d, want %dx%d", i, got.Dx(), got.Dy(), size.width, size.height)
+		}
+
+		// The buffer is reused while the frame size stays the same
+		if i == 1 && captured != previous {
+			t.Error("capture buffer reallocated for an unchanged frame size")
+		}
+		previous = captured
+	}
+}
@@ -0,0 +1,27 @@
+package rendering
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCooldownArcEnd(t *testing.T) {
+	tests := []struct {
+		progress float64
+		want     float64
+	}{
+		{0, -math.Pi / 2},    // Ready, no arc
+		{0.25, 0},            // Quarter, 3 o'clock
+		{0.5, math.Pi / 2},   // Half, 6 o'clock
+		{0.75, math.Pi},      // Three quarters, 9 o'clock
+		{1, 3 * math.Pi / 2}, // Just used, full circle
+		{-0.5, -math.Pi / 2}, // Clamped to ready
+		{2, 3 * math.Pi / 2}, // Clamped to full
+	}
+
+	for _, tt := range tests {
+		if got := CooldownArcEnd(tt.progress); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("CooldownArcEnd(%v) = %v, want %v", tt.progress, got, tt.want)
+		}
+	}
+}
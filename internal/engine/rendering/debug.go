@@ -0,0 +1,67 @@
+// internal/engine/rendering/debug.go
+package rendering
+
+import (
+	"fmt"
+	imgui "github.com/gabstv/cimgui-go"
+	"novampires-go/internal/common"
+	"unsafe"
+)
+
+type DebugWindow struct {
+	renderer *Renderer
+	open     bool
+	openPtr  unsafe.Pointer
+}
+
+func NewDebugWindow(renderer *Renderer) *DebugWindow {
+	w := &DebugWindow{
+		renderer: renderer,
+		open:     true,
+	}
+
+	w.openPtr = unsafe.Pointer(&w.open)
+
+	return w
+}
+
+func (w *DebugWindow) Draw() {
+	if !w.open {
+		return
+	}
+
+	if imgui.BeginV("Render Debug", (*bool)(w.openPtr), imgui.WindowFlagsNone) {
+		showGizmo := w.renderer.IsGizmoVisible()
+		if imgui.Checkbox("Show Origin Gizmo", &showGizmo) {
+			w.renderer.SetShowGizmo(showGizmo)
+		}
+
+		showSpatialGrid := w.renderer.IsSpatialGridVisible()
+		if imgui.Checkbox("Show Spatial Grid", &showSpatialGrid) {
+			w.renderer.SetShowSpatialGrid(showSpatialGrid)
+		}
+
+		imgui.Text(fmt.Sprintf("Palette: %d / %d", w.renderer.GetPaletteIndex()+1, w.renderer.PaletteCount()))
+	}
+	imgui.End()
+}
+
+func (w *DebugWindow) Name() string {
+	return common.WindowRenderDebug
+}
+
+func (w *DebugWindow) Toggle() {
+	w.open = !w.open
+}
+
+func (w *DebugWindow) IsOpen() bool {
+	return w.open
+}
+
+func (w *DebugWindow) Close() {
+	w.open = false
+}
+
+func (r *Renderer) CreateDebugWindow() *DebugWindow {
+	return NewDebugWindow(r)
+}
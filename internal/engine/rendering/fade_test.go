@@ -0,0 +1,80 @@
+package rendering
+
+import (
+	"math"
+	"novampires-go/internal/engine/camera"
+	"testing"
+	"time"
+)
+
+func TestFadeAlpha(t *testing.T) {
+	duration := time.Second
+
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		in      bool
+		want    float64
+	}{
+		{"out at start", 0, false, 0},
+		{"out a quarter in", 250 * time.Millisecond, false, 0.25},
+		{"out halfway", 500 * time.Millisecond, false, 0.5},
+		{"out done", duration, false, 1},
+		{"out past the end", 2 * duration, false, 1},
+		{"in at start", 0, true, 1},
+		{"in a quarter in", 250 * time.Millisecond, true, 0.75},
+		{"in done", duration, true, 0},
+		{"negative elapsed", -time.Second, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FadeAlpha(tt.elapsed, duration, tt.in); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("FadeAlpha(%v, %v, %v) = %v, want %v", tt.elapsed, duration, tt.in, got, tt.want)
+			}
+		})
+	}
+
+	if got := FadeAlpha(0, 0, false); got != 1 {
+		t.Errorf("instant fade-out alpha = %v, want 1", got)
+	}
+}
+
+func TestFadeFollowsUpdates(t *testing.T) {
+	r := NewRenderer(DefaultRenderConfig(), camera.New())
+	if !r.FadeDone() || r.currentFadeAlpha() != 0 {
+		t.Fatal("fade running before one was started")
+	}
+
+	r.BeginFade(400*time.Millisecond, false)
+	for i, want := range []float64{0.25, 0.5, 0.75, 1} {
+		if r.FadeDone() {
+			t.Fatalf("step %d: fade-out done early", i)
+		}
+		r.Update(100 * time.Millisecond)
+		if got := r.currentFadeAlpha(); math.Abs(got-want) > 1e-9 {
+			t.Errorf("step %d: alpha = %v, want %v", i, got, want)
+		}
+	}
+	if !r.FadeDone() {
+		t.Error("fade-out not done after its duration")
+	}
+
+	// A finished fade-out holds black, however much time passes
+	r.Update(time.Hour)
+	if got := r.currentFadeAlpha(); got != 1 {
+		t.Errorf("alpha = %v after the fade-out, want it to stay 1", got)
+	}
+
+	// Frozen simulation holds the fade
+	r.BeginFade(400*time.Millisecond, true)
+	r.Update(0)
+	if got := r.currentFadeAlpha(); got != 1 {
+		t.Errorf("alpha = %v with no time passed, want 1", got)
+	}
+
+	r.Update(400 * time.Millisecond)
+	if !r.FadeDone() || r.currentFadeAlpha() != 0 {
+		t.Errorf("fade-in left alpha %v, want the overlay gone", r.currentFadeAlpha())
+	}
+}
@@ -0,0 +1,57 @@
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"novampires-go/internal/common"
+)
+
+// Font draws single lines of text at a native size that the renderer then
+// scales
+type Font interface {
+	// Size returns the size of text at scale 1
+	Size(text string) common.Vector2
+
+	// Draw draws text with its top-left corner at the origin of dst
+	Draw(dst *ebiten.Image, text string)
+}
+
+// DebugFont is ebiten's built-in debug font, used when no other font is set
+type DebugFont struct{}
+
+// Size returns the size of text in the fixed-width debug font
+func (DebugFont) Size(text string) common.Vector2 {
+	return common.Vector2{
+		X: float64(len(text) * debugGlyphWidth),
+		Y: debugGlyphHeight,
+	}
+}
+
+// Draw draws text in the debug font
+func (DebugFont) Draw(dst *ebiten.Image, text string) {
+	ebitenutil.DebugPrintAt(dst, text, 0, 0)
+}
+
+// WithFont runs draw with every text drawn in font, restoring the previous
+// font afterwards. A nil font keeps the current one.
+func (r *Renderer) WithFont(font Font, draw func()) {
+	if font == nil {
+		draw()
+		return
+	}
+
+	previous := r.fontOverride
+	r.fontOverride = font
+	defer func() { r.fontOverride = previous }()
+
+	draw()
+}
+
+// font returns the font text is drawn in: the WithFont override if there is
+// one, otherwise the debug font
+func (r *Renderer) font() Font {
+	if r.fontOverride != nil {
+		return r.fontOverride
+	}
+	return DebugFont{}
+}
@@ -0,0 +1,91 @@
+// internal/engine/rendering/gizmo.go
+package rendering
+
+import (
+	"fmt"
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"novampires-go/internal/common"
+)
+
+// Layout of the origin gizmo in world units
+const (
+	gizmoAxisLength  = 500.0
+	gizmoTickSpacing = 100.0
+	gizmoTickSize    = 8.0
+)
+
+// Size of the camera center crosshair in screen pixels
+const gizmoCrosshairSize = 10.0
+
+var (
+	gizmoAxisX     = color.RGBA{230, 70, 70, 220}
+	gizmoAxisY     = color.RGBA{70, 200, 90, 220}
+	gizmoCrosshair = color.RGBA{255, 255, 255, 200}
+)
+
+// SetShowGizmo sets whether the world origin gizmo is drawn
+func (r *Renderer) SetShowGizmo(show bool) {
+	r.showGizmo = show
+}
+
+// IsGizmoVisible returns whether the world origin gizmo is drawn
+func (r *Renderer) IsGizmoVisible() bool {
+	return r.showGizmo
+}
+
+// SetShowSpatialGrid sets whether spatial grid debug overlays should be drawn
+func (r *Renderer) SetShowSpatialGrid(show bool) {
+	r.showSpatialGrid = show
+}
+
+// IsSpatialGridVisible returns whether spatial grid debug overlays should be drawn
+func (r *Renderer) IsSpatialGridVisible() bool {
+	return r.showSpatialGrid
+}
+
+// GizmoOrigin returns the screen position the origin marker is drawn at
+func (r *Renderer) GizmoOrigin() common.Vector2 {
+	return r.worldToScreen(common.Vector2{})
+}
+
+// DrawGizmo draws the world origin with labeled, tick-marked axes and a
+// crosshair at the camera center, if enabled
+func (r *Renderer) DrawGizmo(screen *ebiten.Image) {
+	if !r.showGizmo {
+		return
+	}
+
+	// Keep line widths constant on screen regardless of zoom
+	zoom := r.camera.GetZoom()
+	lineWidth := r.config.LineThickness / zoom
+
+	// Axes through the origin
+	r.DrawLine(screen, common.Vector2{X: -gizmoAxisLength}, common.Vector2{X: gizmoAxisLength}, lineWidth, gizmoAxisX)
+	r.DrawLine(screen, common.Vector2{Y: -gizmoAxisLength}, common.Vector2{Y: gizmoAxisLength}, lineWidth, gizmoAxisY)
+
+	// Tick marks
+	for d := gizmoTickSpacing; d <= gizmoAxisLength; d += gizmoTickSpacing {
+		for _, v := range []float64{-d, d} {
+			r.DrawLine(screen, common.Vector2{X: v, Y: -gizmoTickSize}, common.Vector2{X: v, Y: gizmoTickSize}, lineWidth, gizmoAxisX)
+			r.DrawLine(screen, common.Vector2{X: -gizmoTickSize, Y: v}, common.Vector2{X: gizmoTickSize, Y: v}, lineWidth, gizmoAxisY)
+		}
+	}
+
+	// Labels
+	labelScale := 1 / zoom
+	labelGap := 3 * gizmoTickSize
+	r.DrawWorldText(screen, "X", common.Vector2{X: gizmoAxisLength + labelGap}, labelScale, gizmoAxisX, true)
+	r.DrawWorldText(screen, "Y", common.Vector2{Y: gizmoAxisLength + labelGap}, labelScale, gizmoAxisY, true)
+	r.DrawWorldText(screen, "0,0", common.Vector2{X: labelGap, Y: labelGap}, labelScale, gizmoCrosshair, true)
+	for d := gizmoTickSpacing; d <= gizmoAxisLength; d += gizmoTickSpacing {
+		r.DrawWorldText(screen, fmt.Sprintf("%.0f", d), common.Vector2{X: d, Y: labelGap}, labelScale, gizmoAxisX, true)
+		r.DrawWorldText(screen, fmt.Sprintf("%.0f", d), common.Vector2{X: labelGap * 1.5, Y: d}, labelScale, gizmoAxisY, true)
+	}
+
+	// Camera center crosshair
+	center := r.camera.GetCenter()
+	arm := gizmoCrosshairSize / zoom
+	r.DrawLine(screen, center.Add(common.Vector2{X: -arm}), center.Add(common.Vector2{X: arm}), lineWidth, gizmoCrosshair)
+	r.DrawLine(screen, center.Add(common.Vector2{Y: -arm}), center.Add(common.Vector2{Y: arm}), lineWidth, gizmoCrosshair)
+}
@@ -0,0 +1,47 @@
+package rendering
+
+import (
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/camera"
+	"testing"
+)
+
+func TestGizmoOriginFollowsCamera(t *testing.T) {
+	tests := []struct {
+		name     string
+		center   common.Vector2
+		zoom     float64
+		rotation float64
+	}{
+		{"default camera", common.Vector2{}, 1, 0},
+		{"panned", common.Vector2{X: 400, Y: -250}, 1, 0},
+		{"zoomed in", common.Vector2{X: -80, Y: 30}, 2.5, 0},
+		{"zoomed out and rotated", common.Vector2{X: 120, Y: 900}, 0.5, 0.7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cam := camera.New()
+			cam.SetCenter(tt.center)
+			cam.SetZoom(tt.zoom)
+			cam.SetRotation(tt.rotation)
+			r := NewRenderer(DefaultRenderConfig(), cam)
+
+			if got, want := r.GizmoOrigin(), cam.WorldToScreen(common.Vector2{}); got != want {
+				t.Errorf("origin marker at %v, want WorldToScreen %v", got, want)
+			}
+		})
+	}
+}
+
+func TestGizmoOriginMovesAgainstPan(t *testing.T) {
+	cam := camera.New()
+	cam.SetZoom(2)
+	r := NewRenderer(DefaultRenderConfig(), cam)
+	before := r.GizmoOrigin()
+
+	cam.SetCenter(cam.GetCenter().Add(common.Vector2{X: 30, Y: -10}))
+	if got, want := r.GizmoOrigin().Sub(before), (common.Vector2{X: -60, Y: 20}); got.Sub(want).Magnitude() > 1e-9 {
+		t.Errorf("origin marker moved by %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+package rendering
+
+import (
+	"image/color"
+	"novampires-go/internal/common"
+	"testing"
+)
+
+func TestGridFadeAlpha(t *testing.T) {
+	viewport := common.Rectangle{Size: common.Vector2{X: 200, Y: 100}}
+
+	tests := []struct {
+		name string
+		pos  common.Vector2
+		want float64
+	}{
+		{"center", common.Vector2{X: 100, Y: 50}, 1},
+		{"inside fade start", common.Vector2{X: 130, Y: 50}, 1},
+		{"halfway through fade", common.Vector2{X: 175, Y: 50}, 0.5},
+		{"edge", common.Vector2{X: 200, Y: 50}, 0},
+		{"corner", common.Vector2{X: 200, Y: 100}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GridFadeAlpha(tt.pos, viewport, 0.5)
+			if diff := got - tt.want; diff > 1e-9 || diff < -1e-9 {
+				t.Errorf("GridFadeAlpha(%v) = %v, want %v", tt.pos, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFadeColorStaysPremultiplied(t *testing.T) {
+	col := color.RGBA{50, 50, 60, 80}
+
+	for _, fade := range []float64{1, 0.75, 0.5, 0.1, 0} {
+		got := FadeColor(col, fade)
+		if got.R > got.A || got.G > got.A || got.B > got.A {
+			t.Errorf("FadeColor(%v) = %v, color exceeds alpha", fade, got)
+		}
+		if want := uint8(float64(col.A) * fade); got.A != want {
+			t.Errorf("FadeColor(%v).A = %d, want %d", fade, got.A, want)
+		}
+	}
+}
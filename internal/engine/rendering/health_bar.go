@@ -0,0 +1,115 @@
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"image/color"
+	"math"
+	"novampires-go/internal/common"
+)
+
+// HealthBarFrame adds a border and rounded ends to a health bar. The zero
+// value draws the plain bar.
+type HealthBarFrame struct {
+	Border      float64 // Border thickness in world units, 0 for none
+	BorderColor color.RGBA
+	Rounded     bool // Round the ends into a pill shape
+}
+
+// DefaultHealthBarFrame returns a thin dark border with square corners
+func DefaultHealthBarFrame() HealthBarFrame {
+	return HealthBarFrame{
+		Border:      1.0,
+		BorderColor: color.RGBA{10, 10, 15, 220},
+	}
+}
+
+// IsPlain returns whether the frame adds nothing to the plain bar
+func (f HealthBarFrame) IsPlain() bool {
+	return f.Border <= 0 && !f.Rounded
+}
+
+// HealthBarInset returns the area left for the fill inside a border of the
+// given thickness. The size never goes negative.
+func HealthBarInset(rect common.Rectangle, border float64) common.Rectangle {
+	border = max(border, 0)
+	return common.Rectangle{
+		Pos: common.Vector2{X: rect.Pos.X + border, Y: rect.Pos.Y + border},
+		Size: common.Vector2{
+			X: max(rect.Size.X-border*2, 0),
+			Y: max(rect.Size.Y-border*2, 0),
+		},
+	}
+}
+
+// DrawFramedHealthBar draws a health bar in world coordinates with a border
+// and optionally rounded ends. A plain frame draws the same as DrawHealthBar.
+func (r *Renderer) DrawFramedHealthBar(screen *ebiten.Image, position common.Vector2, width, height float64, percent float64, frame HealthBarFrame) {
+	if frame.IsPlain() {
+		r.DrawHealthBar(screen, position, width, height, percent)
+		return
+	}
+
+	rect := common.Rectangle{
+		Pos:  position,
+		Size: common.Vector2{X: width, Y: height},
+	}
+	if !r.camera.IsRectVisible(rect) {
+		return
+	}
+
+	inner := HealthBarInset(rect, frame.Border)
+	fill := inner
+	fill.Size.X *= min(max(percent, 0), 1)
+
+	if frame.Rounded {
+		// Layered pills, the border shows around the smaller inner ones
+		if frame.Border > 0 {
+			r.drawPill(screen, rect, frame.BorderColor)
+		}
+		r.drawPill(screen, inner, r.config.ColorPalette.HealthBarBG)
+		if fill.Size.X > 0 {
+			r.drawPill(screen, fill, r.config.ColorPalette.HealthBarFill)
+		}
+		return
+	}
+
+	r.DrawRect(screen, inner, r.config.ColorPalette.HealthBarBG)
+	if fill.Size.X > 0 {
+		r.DrawRect(screen, fill, r.config.ColorPalette.HealthBarFill)
+	}
+
+	// Centered on the gap between the rect and the fill area
+	if frame.Border > 0 {
+		outline := HealthBarInset(rect, frame.Border/2)
+		r.DrawRectOutline(screen, outline, frame.Border, frame.BorderColor)
+	}
+}
+
+// drawPill fills a world space rectangle with fully rounded ends. A rect
+// narrower than it is tall draws as a circle. The outline is a single path
+// filled once, so translucent colors don't darken where parts overlap.
+func (r *Renderer) drawPill(screen *ebiten.Image, rect common.Rectangle, fill color.RGBA) {
+	if rect.Size.X <= 0 || rect.Size.Y <= 0 {
+		return
+	}
+
+	screenRect := r.worldRectToScreen(rect)
+	x, y := float32(screenRect.Pos.X), float32(screenRect.Pos.Y)
+	w, h := float32(screenRect.Size.X), float32(screenRect.Size.Y)
+
+	var path vector.Path
+	if w <= h {
+		path.Arc(x+w/2, y+h/2, w/2, 0, 2*math.Pi, vector.Clockwise)
+	} else {
+		radius := h / 2
+		path.MoveTo(x+radius, y)
+		path.LineTo(x+w-radius, y)
+		path.Arc(x+w-radius, y+radius, radius, -math.Pi/2, math.Pi/2, vector.Clockwise)
+		path.LineTo(x+radius, y+h)
+		path.Arc(x+radius, y+radius, radius, math.Pi/2, 3*math.Pi/2, vector.Clockwise)
+	}
+	path.Close()
+
+	r.fillPath(screen, &path, fill, BlendNormal, r.antiAlias())
+}
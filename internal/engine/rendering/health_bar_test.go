@@ -0,0 +1,33 @@
+package rendering
+
+import (
+	"novampires-go/internal/common"
+	"testing"
+)
+
+func TestHealthBarInset(t *testing.T) {
+	rect := common.Rectangle{
+		Pos:  common.Vector2{X: 10, Y: 20},
+		Size: common.Vector2{X: 40, Y: 6},
+	}
+
+	tests := []struct {
+		name   string
+		border float64
+		want   common.Rectangle
+	}{
+		{"no border", 0, rect},
+		{"negative border", -2, rect},
+		{"one unit", 1, common.Rectangle{Pos: common.Vector2{X: 11, Y: 21}, Size: common.Vector2{X: 38, Y: 4}}},
+		{"border taller than bar", 4, common.Rectangle{Pos: common.Vector2{X: 14, Y: 24}, Size: common.Vector2{X: 32, Y: 0}}},
+		{"border wider than bar", 30, common.Rectangle{Pos: common.Vector2{X: 40, Y: 50}, Size: common.Vector2{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HealthBarInset(rect, tt.border); got != tt.want {
+				t.Errorf("HealthBarInset(%v) = %v, want %v", tt.border, got, tt.want)
+			}
+		})
+	}
+}
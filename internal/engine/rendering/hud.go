@@ -0,0 +1,112 @@
+// internal/engine/rendering/hud.go
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"novampires-go/internal/common"
+)
+
+// HUDSize scales a HUD dimension authored at UI scale 1
+func HUDSize(size, uiScale float64) float64 {
+	return size * uiScale
+}
+
+// HUDRect scales a HUD rectangle authored at UI scale 1, including its
+// distance from the screen origin so the layout grows evenly
+func HUDRect(rect common.Rectangle, uiScale float64) common.Rectangle {
+	return common.Rectangle{
+		Pos:  rect.Pos.Scale(uiScale),
+		Size: rect.Size.Scale(uiScale),
+	}
+}
+
+// SetUIScale sets the scale of screen space HUD elements. It is independent
+// of the camera zoom. Non-positive scales are ignored.
+func (r *Renderer) SetUIScale(scale float64) {
+	if scale > 0 {
+		r.config.UIScale = scale
+	}
+}
+
+// GetUIScale returns the scale of screen space HUD elements
+func (r *Renderer) GetUIScale() float64 {
+	return r.config.UIScale
+}
+
+// TextRect returns the screen area covered by text drawn in font with its
+// top-left corner at pos and its glyphs scaled by scale
+func TextRect(font Font, text string, pos common.Vector2, scale float64) common.Rectangle {
+	return common.Rectangle{Pos: pos, Size: font.Size(text).Scale(scale)}
+}
+
+// screenRect returns the bounds of a screen image as a rectangle
+func screenRect(screen *ebiten.Image) common.Rectangle {
+	bounds := screen.Bounds()
+	return common.Rectangle{
+		Pos:  common.Vector2{X: float64(bounds.Min.X), Y: float64(bounds.Min.Y)},
+		Size: common.Vector2{X: float64(bounds.Dx()), Y: float64(bounds.Dy())},
+	}
+}
+
+// DrawWorldLabel draws debug text with its top-left corner anchored to a
+// world position, e.g. an entity ID next to the entity. The glyphs keep
+// their UI scale size regardless of zoom, and labels off-screen are skipped.
+func (r *Renderer) DrawWorldLabel(screen *ebiten.Image, text string, worldPos common.Vector2, col color.RGBA) {
+	bounds := r.worldLabelRect(text, worldPos)
+	if text == "" || !bounds.Intersects(screenRect(screen)) {
+		return
+	}
+
+	scale := r.config.UIScale
+	glyphs := r.rasterizeText(text)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(bounds.Pos.X, bounds.Pos.Y)
+	op.ColorScale.Scale(float32(col.R)/255, float32(col.G)/255, float32(col.B)/255, 1)
+	op.ColorScale.ScaleAlpha(float32(col.A) / 255)
+	screen.DrawImage(glyphs, op)
+}
+
+// worldLabelRect returns the screen area a world label covers
+func (r *Renderer) worldLabelRect(text string, worldPos common.Vector2) common.Rectangle {
+	return TextRect(r.font(), text, r.worldToScreen(worldPos), r.config.UIScale)
+}
+
+// DrawHUDText draws text at a screen position authored at UI scale 1. Both
+// the position and the glyphs are scaled by the UI scale.
+func (r *Renderer) DrawHUDText(screen *ebiten.Image, text string, pos common.Vector2, col color.RGBA) {
+	glyphs := r.rasterizeText(text)
+	if glyphs == nil {
+		return
+	}
+
+	scale := r.config.UIScale
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(pos.X*scale, pos.Y*scale)
+	op.ColorScale.Scale(float32(col.R)/255, float32(col.G)/255, float32(col.B)/255, 1)
+	op.ColorScale.ScaleAlpha(float32(col.A) / 255)
+	screen.DrawImage(glyphs, op)
+}
+
+// DrawHUDTextScaled draws text centered on a screen position authored at UI
+// scale 1, with the glyphs scaled by scale on top of the UI scale
+func (r *Renderer) DrawHUDTextScaled(screen *ebiten.Image, text string, pos common.Vector2, scale float64, col color.RGBA) {
+	glyphs := r.rasterizeText(text)
+	if glyphs == nil {
+		return
+	}
+
+	uiScale := r.config.UIScale
+	width := float64(glyphs.Bounds().Dx())
+	height := float64(glyphs.Bounds().Dy())
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(-width/2, -height/2)
+	op.GeoM.Scale(scale*uiScale, scale*uiScale)
+	op.GeoM.Translate(pos.X*uiScale, pos.Y*uiScale)
+	op.ColorScale.Scale(float32(col.R)/255, float32(col.G)/255, float32(col.B)/255, 1)
+	op.ColorScale.ScaleAlpha(float32(col.A) / 255)
+	screen.DrawImage(glyphs, op)
+}
@@ -0,0 +1,95 @@
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/camera"
+	"testing"
+)
+
+func TestWorldLabelAnchorsToWorldPos(t *testing.T) {
+	cam := camera.New()
+	cam.SetCenter(common.Vector2{X: 300, Y: -120})
+	cam.SetZoom(2)
+
+	config := DefaultRenderConfig()
+	config.UIScale = 1.5
+	r := NewRenderer(config, cam)
+
+	for _, worldPos := range []common.Vector2{{}, {X: 310, Y: -100}, {X: -50, Y: 900}} {
+		rect := r.worldLabelRect("id 42", worldPos)
+		if want := cam.WorldToScreen(worldPos); rect.Pos != want {
+			t.Errorf("label for %v at %v, want WorldToScreen %v", worldPos, rect.Pos, want)
+		}
+
+		// Glyphs keep their UI size whatever the zoom
+		if want := (DebugFont{}).Size("id 42").Scale(1.5); rect.Size != want {
+			t.Errorf("label size %v, want %v", rect.Size, want)
+		}
+	}
+}
+
+func TestTextRectCulling(t *testing.T) {
+	screen := screenRect(ebiten.NewImage(800, 600))
+	width := (DebugFont{}).Size("label").X
+
+	tests := []struct {
+		name    string
+		pos     common.Vector2
+		visible bool
+	}{
+		{"on screen", common.Vector2{X: 400, Y: 300}, true},
+		{"hanging off the left", common.Vector2{X: -width / 2, Y: 300}, true},
+		{"just off the left", common.Vector2{X: -width - 1, Y: 300}, false},
+		{"hanging off the bottom", common.Vector2{X: 400, Y: 595}, true},
+		{"past the right", common.Vector2{X: 801, Y: 300}, false},
+		{"above the top", common.Vector2{X: 400, Y: -40}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rect := TextRect(DebugFont{}, "label", tt.pos, 1)
+			if got := rect.Intersects(screen); got != tt.visible {
+				t.Errorf("visible = %v, want %v", got, tt.visible)
+			}
+		})
+	}
+}
+
+func TestDoublingUIScaleDoublesHUD(t *testing.T) {
+	healthBar := common.Rectangle{Pos: common.Vector2{X: 8, Y: 8}, Size: common.Vector2{X: 200, Y: 14}}
+
+	for _, scale := range []float64{1, 1.25, 2} {
+		if got, want := HUDSize(24, 2*scale), 2*HUDSize(24, scale); got != want {
+			t.Errorf("scale %v: doubled HUDSize = %v, want %v", scale, got, want)
+		}
+
+		got, base := HUDRect(healthBar, 2*scale), HUDRect(healthBar, scale)
+		if want := (common.Rectangle{Pos: base.Pos.Scale(2), Size: base.Size.Scale(2)}); got != want {
+			t.Errorf("scale %v: doubled HUDRect = %+v, want %+v", scale, got, want)
+		}
+
+		text := TextRect(DebugFont{}, "HP 100", common.Vector2{}, 2*scale).Size
+		if want := TextRect(DebugFont{}, "HP 100", common.Vector2{}, scale).Size.Scale(2); text != want {
+			t.Errorf("scale %v: doubled text size = %v, want %v", scale, text, want)
+		}
+
+		small := NewMinimap(DefaultMinimapConfig(), DefaultColorPalette())
+		small.SetUIScale(scale)
+		large := NewMinimap(DefaultMinimapConfig(), DefaultColorPalette())
+		large.SetUIScale(2 * scale)
+		if got, want := large.Bounds(1920).Size, small.Bounds(1920).Size.Scale(2); got != want {
+			t.Errorf("scale %v: doubled minimap size = %v, want %v", scale, got, want)
+		}
+	}
+}
+
+func TestSetUIScaleIgnoresNonPositive(t *testing.T) {
+	r := NewRenderer(DefaultRenderConfig(), camera.New())
+	r.SetUIScale(2)
+	r.SetUIScale(0)
+	r.SetUIScale(-1)
+	if got := r.GetUIScale(); got != 2 {
+		t.Errorf("UI scale = %v, want 2", got)
+	}
+}
@@ -0,0 +1,61 @@
+// internal/engine/rendering/image.go
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"novampires-go/internal/common"
+)
+
+// WorldImageGeoM returns the world space transform for an image of the
+// given size: the normalized pivot is moved to the origin, local is applied
+// (e.g. a flip about the pivot), then the image is scaled, rotated about the
+// pivot and placed with the pivot at pos. Concatenate the camera transform to
+// get screen space.
+func WorldImageGeoM(width, height float64, pos common.Vector2, rotation, scale float64, pivot common.Vector2, local ebiten.GeoM) ebiten.GeoM {
+	m := ebiten.GeoM{}
+	m.Translate(OriginOffset(width, height, pivot))
+	m.Concat(local)
+	m.Scale(scale, scale)
+	if rotation != 0 {
+		m.Rotate(rotation)
+	}
+	m.Translate(pos.X, pos.Y)
+	return m
+}
+
+// DrawImage draws an image in world space with its normalized pivot at pos,
+// rotated about the pivot and scaled, through the camera. opts may be nil;
+// its GeoM is applied after the pivot is moved to the origin (so a negative
+// X scale flips about the pivot) and its other options are used as is.
+func (r *Renderer) DrawImage(screen, img *ebiten.Image, pos common.Vector2, rotation, scale float64, pivot common.Vector2, opts *ebiten.DrawImageOptions) {
+	if img == nil {
+		return
+	}
+
+	op := &ebiten.DrawImageOptions{}
+	if opts != nil {
+		*op = *opts
+	}
+
+	bounds := img.Bounds()
+	op.GeoM = WorldImageGeoM(float64(bounds.Dx()), float64(bounds.Dy()), pos, rotation, scale, pivot, op.GeoM)
+	op.GeoM.Concat(r.camera.GetTransform())
+
+	screen.DrawImage(img, op)
+}
+
+// spriteGeoM returns a local transform that mirrors horizontally when flipX
+// is set and scales X and Y by stretch, both about the pivot. A zero
+// stretch is treated as (1, 1).
+func spriteGeoM(flipX bool, stretch common.Vector2) ebiten.GeoM {
+	if stretch.X == 0 && stretch.Y == 0 {
+		stretch = common.Vector2{X: 1, Y: 1}
+	}
+
+	m := ebiten.GeoM{}
+	if flipX {
+		stretch.X = -stretch.X
+	}
+	m.Scale(stretch.X, stretch.Y)
+	return m
+}
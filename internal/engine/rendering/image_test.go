@@ -0,0 +1,41 @@
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+)
+
+func TestWorldImageGeoM(t *testing.T) {
+	// A 40x20 image pivoting near its bottom left corner, doubled and
+	// turned a quarter clockwise
+	pos := common.Vector2{X: 100, Y: 50}
+	pivot := common.Vector2{X: 0.25, Y: 1}
+
+	var flip ebiten.GeoM
+	flip.Scale(-1, 1)
+
+	tests := []struct {
+		name  string
+		local ebiten.GeoM
+		point common.Vector2 // Image pixel
+		want  common.Vector2 // World position
+	}{
+		{"pivot lands on pos", ebiten.GeoM{}, common.Vector2{X: 10, Y: 20}, pos},
+		{"top left corner", ebiten.GeoM{}, common.Vector2{}, common.Vector2{X: 140, Y: 30}},
+		{"bottom right corner", ebiten.GeoM{}, common.Vector2{X: 40, Y: 20}, common.Vector2{X: 100, Y: 110}},
+		{"flipped pivot stays on pos", flip, common.Vector2{X: 10, Y: 20}, pos},
+		{"flipped top left corner", flip, common.Vector2{}, common.Vector2{X: 140, Y: 70}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := WorldImageGeoM(40, 20, pos, math.Pi/2, 2, pivot, tt.local)
+			x, y := m.Apply(tt.point.X, tt.point.Y)
+			if math.Abs(x-tt.want.X) > 1e-9 || math.Abs(y-tt.want.Y) > 1e-9 {
+				t.Errorf("pixel %v maps to (%v, %v), want %v", tt.point, x, y, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// internal/engine/rendering/indicator.go
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"math"
+	"novampires-go/internal/common"
+)
+
+// IndicatorConfig controls the arrows pointing at off-screen targets
+type IndicatorConfig struct {
+	Enabled  bool
+	Margin   float64 // Gap between the arrows and the screen edge in pixels
+	Size     float64 // Arrow length in pixels at full scale
+	Range    float64 // World distance past the screen edge at which arrows reach MinScale
+	MinScale float64 // Arrow scale for targets at or beyond Range
+}
+
+// DefaultIndicatorConfig returns default off-screen indicator configuration
+func DefaultIndicatorConfig() IndicatorConfig {
+	return IndicatorConfig{
+		Enabled:  true,
+		Margin:   16.0,
+		Size:     14.0,
+		Range:    800.0,
+		MinScale: 0.4,
+	}
+}
+
+// IndicatorScale returns the arrow scale for a target the given world
+// distance beyond the screen edge, shrinking linearly to MinScale at Range
+func IndicatorScale(distance float64, config IndicatorConfig) float64 {
+	if config.Range <= 0 {
+		return 1
+	}
+	t := min(max(distance/config.Range, 0), 1)
+	return 1 + (config.MinScale-1)*t
+}
+
+// DrawOffscreenIndicators draws an arrow at the screen edge for every target
+// outside the view, pointing toward it. Arrows go into the UI buffer.
+func (r *Renderer) DrawOffscreenIndicators(targets []common.TargetInfo) {
+	config := r.config.Indicators
+	if !config.Enabled || r.uiBuffer == nil {
+		return
+	}
+
+	size := r.uiBuffer.Bounds().Size()
+	screen := common.Rectangle{
+		Size: common.Vector2{X: float64(size.X), Y: float64(size.Y)},
+	}
+	margin := HUDSize(config.Margin, r.config.UIScale)
+	inset := common.Rectangle{
+		Pos:  common.Vector2{X: margin, Y: margin},
+		Size: common.Vector2{X: screen.Size.X - 2*margin, Y: screen.Size.Y - 2*margin},
+	}
+	if inset.Size.X <= 0 || inset.Size.Y <= 0 {
+		return
+	}
+	center := screen.Center()
+	zoom := r.camera.GetZoom()
+
+	for _, target := range targets {
+		pos := r.worldToScreen(target.Pos)
+		if screen.Contains(pos) {
+			continue
+		}
+
+		edge := common.EdgeIntersection(center, pos, inset)
+		distance := pos.Sub(edge).Magnitude() / zoom
+		length := HUDSize(config.Size, r.config.UIScale) * IndicatorScale(distance, config)
+		angle := math.Atan2(pos.Y-edge.Y, pos.X-edge.X)
+		r.drawArrow(edge, angle, length)
+	}
+}
+
+// drawArrow fills a triangle in the UI buffer with its tip at tip, pointing
+// along angle
+func (r *Renderer) drawArrow(tip common.Vector2, angle, length float64) {
+	const spread = 0.45 // Half-angle of the arrow head in radians
+
+	back := func(a float64) common.Vector2 {
+		return common.Vector2{
+			X: tip.X - math.Cos(a)*length,
+			Y: tip.Y - math.Sin(a)*length,
+		}
+	}
+	left, right := back(angle-spread), back(angle+spread)
+
+	var path vector.Path
+	path.MoveTo(float32(tip.X), float32(tip.Y))
+	path.LineTo(float32(left.X), float32(left.Y))
+	path.LineTo(float32(right.X), float32(right.Y))
+	path.Close()
+	r.fillPath(r.uiBuffer, &path, r.config.ColorPalette.EnemyStandard, BlendNormal, r.antiAlias())
+}
@@ -0,0 +1,67 @@
+// internal/engine/rendering/letterbox.go
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"math"
+	"novampires-go/internal/common"
+)
+
+// Letterbox fits a fixed-size render target into a window of any size while
+// preserving its aspect ratio. The unused space becomes bars on the top and
+// bottom (letterbox) or the sides (pillarbox).
+type Letterbox struct {
+	// Uniform scale from render target pixels to window pixels
+	Scale float64
+
+	// Area of the window the render target covers
+	Area common.Rectangle
+}
+
+// NewLetterbox computes the largest centered area with the inner aspect ratio
+// that fits inside the outer size
+func NewLetterbox(outerWidth, outerHeight, innerWidth, innerHeight int) Letterbox {
+	if innerWidth <= 0 || innerHeight <= 0 || outerWidth <= 0 || outerHeight <= 0 {
+		return Letterbox{Scale: 1}
+	}
+
+	scale := math.Min(
+		float64(outerWidth)/float64(innerWidth),
+		float64(outerHeight)/float64(innerHeight),
+	)
+
+	size := common.Vector2{
+		X: float64(innerWidth) * scale,
+		Y: float64(innerHeight) * scale,
+	}
+
+	return Letterbox{
+		Scale: scale,
+		Area: common.Rectangle{
+			Pos: common.Vector2{
+				X: (float64(outerWidth) - size.X) / 2,
+				Y: (float64(outerHeight) - size.Y) / 2,
+			},
+			Size: size,
+		},
+	}
+}
+
+// ToInner converts a window position into render target coordinates
+func (l Letterbox) ToInner(pos common.Vector2) common.Vector2 {
+	if l.Scale == 0 {
+		return pos
+	}
+	return pos.Sub(l.Area.Pos).Scale(1 / l.Scale)
+}
+
+// ToOuter converts a render target position into window coordinates
+func (l Letterbox) ToOuter(pos common.Vector2) common.Vector2 {
+	return pos.Scale(l.Scale).Add(l.Area.Pos)
+}
+
+// Apply sets up draw options to draw the render target into its area
+func (l Letterbox) Apply(op *ebiten.DrawImageOptions) {
+	op.GeoM.Scale(l.Scale, l.Scale)
+	op.GeoM.Translate(l.Area.Pos.X, l.Area.Pos.Y)
+}
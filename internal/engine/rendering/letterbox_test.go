@@ -0,0 +1,59 @@
+package rendering
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+)
+
+func TestNewLetterbox(t *testing.T) {
+	tests := []struct {
+		name         string
+		outerW       int
+		outerH       int
+		wantScale    float64
+		wantPos      common.Vector2
+		wantAreaSize common.Vector2
+	}{
+		{"same aspect", 3200, 1800, 2, common.Vector2{}, common.Vector2{X: 3200, Y: 1800}},
+		{"wider window pillarboxes", 2000, 900, 1, common.Vector2{X: 200}, common.Vector2{X: 1600, Y: 900}},
+		{"taller window letterboxes", 1600, 1300, 1, common.Vector2{Y: 200}, common.Vector2{X: 1600, Y: 900}},
+		{"smaller window", 800, 800, 0.5, common.Vector2{Y: 175}, common.Vector2{X: 800, Y: 450}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLetterbox(tt.outerW, tt.outerH, 1600, 900)
+			if math.Abs(l.Scale-tt.wantScale) > 1e-9 {
+				t.Errorf("Scale = %v, want %v", l.Scale, tt.wantScale)
+			}
+			if l.Area.Pos != tt.wantPos {
+				t.Errorf("Area.Pos = %v, want %v", l.Area.Pos, tt.wantPos)
+			}
+			if l.Area.Size != tt.wantAreaSize {
+				t.Errorf("Area.Size = %v, want %v", l.Area.Size, tt.wantAreaSize)
+			}
+		})
+	}
+}
+
+func TestLetterboxRoundTrip(t *testing.T) {
+	l := NewLetterbox(2000, 900, 1600, 900)
+	inner := common.Vector2{X: 400, Y: 300}
+
+	outer := l.ToOuter(inner)
+	if want := (common.Vector2{X: 600, Y: 300}); outer != want {
+		t.Fatalf("ToOuter = %v, want %v", outer, want)
+	}
+	if back := l.ToInner(outer); back != inner {
+		t.Errorf("ToInner(ToOuter) = %v, want %v", back, inner)
+	}
+}
+
+func TestLetterboxInvalidSize(t *testing.T) {
+	l := NewLetterbox(0, 900, 1600, 900)
+	pos := common.Vector2{X: 12, Y: 34}
+	if got := l.ToInner(pos); got != pos {
+		t.Errorf("ToInner = %v, want unchanged %v", got, pos)
+	}
+}
@@ -0,0 +1,137 @@
+// internal/engine/rendering/minimap.go
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"image"
+	"novampires-go/internal/common"
+)
+
+// minimapBackgroundOpacity fades the UI background behind the minimap
+const minimapBackgroundOpacity = 0.7
+
+// MinimapConfig contains minimap layout parameters
+type MinimapConfig struct {
+	Size    float64 // Width and height of the minimap in screen pixels
+	Range   float64 // World distance from the player to the minimap edge
+	Margin  float64 // Gap between the minimap and the top-right screen corner
+	DotSize float64 // Radius of entity dots in screen pixels
+}
+
+// DefaultMinimapConfig returns default minimap configuration
+func DefaultMinimapConfig() MinimapConfig {
+	return MinimapConfig{
+		Size:    180.0,
+		Range:   1200.0,
+		Margin:  12.0,
+		DotSize: 3.0,
+	}
+}
+
+// Minimap draws a player-centered overview in a screen corner
+type Minimap struct {
+	config  MinimapConfig
+	palette ColorPalette
+
+	// HUD scale applied to the configured pixel sizes
+	uiScale float64
+}
+
+// NewMinimap creates a minimap drawn with the given palette
+func NewMinimap(config MinimapConfig, palette ColorPalette) *Minimap {
+	return &Minimap{
+		config:  config,
+		palette: palette,
+		uiScale: 1.0,
+	}
+}
+
+// SetRange sets how far from the player the minimap reaches, which acts as its zoom
+func (m *Minimap) SetRange(worldRange float64) {
+	if worldRange > 0 {
+		m.config.Range = worldRange
+	}
+}
+
+// SetUIScale sets the HUD scale the minimap's pixel sizes are multiplied by
+func (m *Minimap) SetUIScale(scale float64) {
+	if scale > 0 {
+		m.uiScale = scale
+	}
+}
+
+// size returns the scaled width and height of the minimap
+func (m *Minimap) size() float64 {
+	return HUDSize(m.config.Size, m.uiScale)
+}
+
+// Bounds returns the screen rectangle the minimap occupies
+func (m *Minimap) Bounds(screenWidth int) common.Rectangle {
+	size := m.size()
+	margin := HUDSize(m.config.Margin, m.uiScale)
+	return common.Rectangle{
+		Pos: common.Vector2{
+			X: float64(screenWidth) - margin - size,
+			Y: margin,
+		},
+		Size: common.Vector2{X: size, Y: size},
+	}
+}
+
+// WorldToMinimap maps a world position to a position relative to the
+// minimap's top-left corner, with the player at the center
+func (m *Minimap) WorldToMinimap(worldPos, playerPos common.Vector2) common.Vector2 {
+	scale := m.size() / (2 * m.config.Range)
+	half := m.size() / 2
+	offset := worldPos.Sub(playerPos).Scale(scale)
+	return common.Vector2{X: half + offset.X, Y: half + offset.Y}
+}
+
+// SetPalette changes the colors the minimap is drawn with
+func (m *Minimap) SetPalette(palette ColorPalette) {
+	m.palette = palette
+}
+
+// Draw draws the minimap with the player, targets and optional arena bounds
+func (m *Minimap) Draw(screen *ebiten.Image, playerPos common.Vector2, targets []common.TargetInfo, arena *common.Rectangle) {
+	bounds := m.Bounds(screen.Bounds().Dx())
+
+	// Drawing into a sub-image clips everything to the minimap area
+	area := image.Rect(
+		int(bounds.Pos.X),
+		int(bounds.Pos.Y),
+		int(bounds.Pos.X+bounds.Size.X),
+		int(bounds.Pos.Y+bounds.Size.Y),
+	)
+	dst := screen.SubImage(area).(*ebiten.Image)
+
+	background := FadeColor(m.palette.UIBackground, minimapBackgroundOpacity)
+	vector.DrawFilledRect(dst, float32(bounds.Pos.X), float32(bounds.Pos.Y), float32(bounds.Size.X), float32(bounds.Size.Y), background, false)
+
+	toScreen := func(worldPos common.Vector2) common.Vector2 {
+		return m.WorldToMinimap(worldPos, playerPos).Add(bounds.Pos)
+	}
+
+	// Arena bounds
+	if arena != nil {
+		topLeft := toScreen(arena.Pos)
+		bottomRight := toScreen(arena.Pos.Add(arena.Size))
+		size := bottomRight.Sub(topLeft)
+		vector.StrokeRect(dst, float32(topLeft.X), float32(topLeft.Y), float32(size.X), float32(size.Y), 1, m.palette.UIForeground, false)
+	}
+
+	// Targets
+	dot := float32(HUDSize(m.config.DotSize, m.uiScale))
+	for _, target := range targets {
+		pos := toScreen(target.Pos)
+		vector.DrawFilledCircle(dst, float32(pos.X), float32(pos.Y), dot, m.palette.EnemyStandard, true)
+	}
+
+	// Player, always at the center
+	center := toScreen(playerPos)
+	vector.DrawFilledCircle(dst, float32(center.X), float32(center.Y), dot+1, m.palette.PlayerBody, true)
+
+	// Border
+	vector.StrokeRect(screen, float32(bounds.Pos.X), float32(bounds.Pos.Y), float32(bounds.Size.X), float32(bounds.Size.Y), 1, m.palette.UIAccent, false)
+}
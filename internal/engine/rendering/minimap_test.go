@@ -0,0 +1,46 @@
+package rendering
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"testing"
+)
+
+func TestWorldToMinimap(t *testing.T) {
+	config := MinimapConfig{Size: 200, Range: 1000, Margin: 10, DotSize: 3}
+	player := common.Vector2{X: 500, Y: -300}
+
+	tests := []struct {
+		name    string
+		uiScale float64
+		world   common.Vector2
+		want    common.Vector2
+	}{
+		{"player at the center", 1, player, common.Vector2{X: 100, Y: 100}},
+		{"range reaches the right edge", 1, player.Add(common.Vector2{X: 1000}), common.Vector2{X: 200, Y: 100}},
+		{"range reaches the top edge", 1, player.Add(common.Vector2{Y: -1000}), common.Vector2{X: 100, Y: 0}},
+		{"halfway down left", 1, player.Add(common.Vector2{X: -500, Y: 500}), common.Vector2{X: 50, Y: 150}},
+		{"beyond range falls outside", 1, player.Add(common.Vector2{X: 2000}), common.Vector2{X: 300, Y: 100}},
+		{"ui scale grows the map", 2, player.Add(common.Vector2{X: 500}), common.Vector2{X: 300, Y: 200}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMinimap(config, DefaultColorPalette())
+			m.SetUIScale(tt.uiScale)
+
+			got := m.WorldToMinimap(tt.world, player)
+			if math.Abs(got.X-tt.want.X) > 1e-9 || math.Abs(got.Y-tt.want.Y) > 1e-9 {
+				t.Errorf("WorldToMinimap(%v) = %v, want %v", tt.world, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinimapBoundsInTopRightCorner(t *testing.T) {
+	m := NewMinimap(MinimapConfig{Size: 200, Range: 1000, Margin: 10}, DefaultColorPalette())
+	want := common.Rectangle{Pos: common.Vector2{X: 590, Y: 10}, Size: common.Vector2{X: 200, Y: 200}}
+	if got := m.Bounds(800); got != want {
+		t.Errorf("Bounds(800) = %+v, want %+v", got, want)
+	}
+}
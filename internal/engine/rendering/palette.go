@@ -0,0 +1,92 @@
+// internal/engine/rendering/palette.go
+package rendering
+
+import (
+	"image/color"
+)
+
+// HighContrastColorPalette returns a palette with strong contrast between
+// the player, enemies and the background for accessibility
+func HighContrastColorPalette() ColorPalette {
+	return ColorPalette{
+		// UI colors
+		UIBackground: color.RGBA{0, 0, 0, 255},
+		UIForeground: color.RGBA{255, 255, 255, 255},
+		UIAccent:     color.RGBA{255, 214, 0, 255},
+		UIHighlight:  color.RGBA{255, 255, 255, 255},
+
+		// Player colors
+		PlayerBody:    color.RGBA{0, 255, 255, 255}, // Cyan
+		PlayerOutline: color.RGBA{255, 255, 255, 255},
+		PlayerAimLine: color.RGBA{255, 255, 255, 220},
+		PlayerReticle: color.RGBA{255, 214, 0, 255},
+
+		// Projectile colors
+		PlayerBullet: color.RGBA{255, 255, 255, 255},
+		EnemyBullet:  color.RGBA{255, 128, 0, 255}, // Orange
+
+		// Health bar colors
+		HealthBarBG:   color.RGBA{60, 60, 60, 255},
+		HealthBarFill: color.RGBA{0, 255, 0, 255},
+		ShieldBarFill: color.RGBA{0, 160, 255, 255},
+
+		// Enemy colors
+		EnemyStandard: color.RGBA{255, 0, 128, 255}, // Magenta
+		EnemyElite:    color.RGBA{255, 128, 0, 255}, // Orange
+		EnemyBoss:     color.RGBA{255, 0, 0, 255},   // Red
+
+		// Effect colors
+		HitFlash:      color.RGBA{255, 255, 255, 255},
+		ExplosionBase: color.RGBA{255, 214, 0, 255},
+		DamageNumber:  color.RGBA{255, 255, 255, 255},
+	}
+}
+
+// SetPalettes replaces the palettes available for switching and activates
+// the first one. An empty list is ignored.
+func (r *Renderer) SetPalettes(palettes []ColorPalette) {
+	if len(palettes) == 0 {
+		return
+	}
+	r.palettes = append(r.palettes[:0], palettes...)
+	r.SetPalette(0)
+}
+
+// AddPalette makes another palette available for switching and returns its index
+func (r *Renderer) AddPalette(palette ColorPalette) int {
+	r.palettes = append(r.palettes, palette)
+	return len(r.palettes) - 1
+}
+
+// SetPalette activates the palette at index. Out of range indices are ignored.
+func (r *Renderer) SetPalette(index int) {
+	if index < 0 || index >= len(r.palettes) {
+		return
+	}
+	r.paletteIndex = index
+	r.config.ColorPalette = r.palettes[index]
+}
+
+// CyclePalette activates the next palette, wrapping around to the first,
+// and returns its index
+func (r *Renderer) CyclePalette() int {
+	if len(r.palettes) > 0 {
+		r.SetPalette((r.paletteIndex + 1) % len(r.palettes))
+	}
+	return r.paletteIndex
+}
+
+// GetPaletteIndex returns the index of the active palette
+func (r *Renderer) GetPaletteIndex() int {
+	return r.paletteIndex
+}
+
+// PaletteCount returns the number of palettes available for switching
+func (r *Renderer) PaletteCount() int {
+	return len(r.palettes)
+}
+
+// GetPalette returns the active palette
+func (r *Renderer) GetPalette() ColorPalette {
+	return r.config.ColorPalette
+}
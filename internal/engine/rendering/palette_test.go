@@ -0,0 +1,38 @@
+package rendering
+
+import (
+	"novampires-go/internal/engine/camera"
+	"testing"
+)
+
+func TestCyclePaletteWraps(t *testing.T) {
+	r := NewRenderer(DefaultRenderConfig(), camera.New())
+	r.SetPalettes([]ColorPalette{DefaultColorPalette(), HighContrastColorPalette()})
+
+	if got := r.CyclePalette(); got != 1 {
+		t.Fatalf("CyclePalette() = %d, want 1", got)
+	}
+	if got := r.CyclePalette(); got != 0 {
+		t.Errorf("CyclePalette() = %d past the last palette, want 0", got)
+	}
+	if r.config.ColorPalette != DefaultColorPalette() {
+		t.Error("wrapping did not reactivate the first palette")
+	}
+}
+
+func TestSetPalettesIgnoresEmpty(t *testing.T) {
+	r := NewRenderer(DefaultRenderConfig(), camera.New())
+	r.SetPalettes([]ColorPalette{DefaultColorPalette(), HighContrastColorPalette()})
+	r.SetPalette(1)
+
+	r.SetPalettes(nil)
+	if got := r.PaletteCount(); got != 2 {
+		t.Errorf("PaletteCount() = %d after an empty SetPalettes, want 2", got)
+	}
+	if got := r.GetPaletteIndex(); got != 1 {
+		t.Errorf("GetPaletteIndex() = %d after an empty SetPalettes, want 1", got)
+	}
+	if r.config.ColorPalette != HighContrastColorPalette() {
+		t.Error("empty SetPalettes changed the active palette")
+	}
+}
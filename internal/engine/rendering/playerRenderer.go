@@ -13,6 +13,8 @@ func (r *Renderer) DrawLayeredPlayerSprite(
 	eyePosition common.Vector2, // Relative position from character center
 	rotation float64,
 	scale float64,
+	stretch common.Vector2, // Non-uniform X/Y scale on top of scale, (1, 1) for none
+	origin common.Vector2,
 	flipX bool,
 ) {
 	// Skip if no base sprite provided
@@ -31,58 +33,11 @@ func (r *Renderer) DrawLayeredPlayerSprite(
 		return
 	}
 
-	// Get screen position
-	screenPos := r.worldToScreen(position)
-
 	// STEP 1: Draw the base character sprite
-	baseOp := &ebiten.DrawImageOptions{}
-
-	// Get image dimensions
-	imgWidth, imgHeight := float64(baseSprite.Bounds().Dx()), float64(baseSprite.Bounds().Dy())
-
-	// Center the image
-	baseOp.GeoM.Translate(-imgWidth/2, -imgHeight/2)
-
-	// Apply flip if needed
-	scaleX := 1.0
-	if flipX {
-		scaleX = -1.0
-	}
-	baseOp.GeoM.Scale(scaleX, 1.0)
-
-	// Apply scale
-	baseOp.GeoM.Scale(scale, scale)
-
-	// Apply camera zoom
-	baseOp.GeoM.Scale(r.camera.GetZoom(), r.camera.GetZoom())
-
-	// Translate to screen position
-	baseOp.GeoM.Translate(screenPos.X, screenPos.Y)
-
-	// Draw the base character sprite
-	screen.DrawImage(baseSprite, baseOp)
+	r.DrawImage(screen, baseSprite, position, 0, scale, origin, &ebiten.DrawImageOptions{GeoM: spriteGeoM(flipX, stretch)})
 
 	// STEP 2: Draw the eye sprite as a separate layer
 	if eyeSprite != nil {
-		eyeOp := &ebiten.DrawImageOptions{}
-
-		// Get eye image dimensions
-		eyeWidth, eyeHeight := float64(eyeSprite.Bounds().Dx()), float64(eyeSprite.Bounds().Dy())
-
-		// Center the eye image
-		eyeOp.GeoM.Translate(-eyeWidth/2, -eyeHeight/2)
-
-		// Apply flip if needed
-		if flipX {
-			eyeOp.GeoM.Scale(-1.0, 1.0)
-		}
-
-		// Apply scale
-		eyeOp.GeoM.Scale(scale, scale)
-
-		// Apply camera zoom
-		eyeOp.GeoM.Scale(r.camera.GetZoom(), r.camera.GetZoom())
-
 		// Calculate eye position - adjusted for relative positioning
 		eyeOffsetX, eyeOffsetY := eyePosition.X, eyePosition.Y
 
@@ -91,18 +46,10 @@ func (r *Renderer) DrawLayeredPlayerSprite(
 			eyeOffsetX = -eyeOffsetX
 		}
 
-		// Scale the offset by the character scale
-		eyeOffsetX *= scale
-		eyeOffsetY *= scale
-
-		// Scale the offset by camera zoom
-		eyeOffsetX *= r.camera.GetZoom()
-		eyeOffsetY *= r.camera.GetZoom()
-
-		// Translate to screen position with offset
-		eyeOp.GeoM.Translate(screenPos.X+eyeOffsetX, screenPos.Y+eyeOffsetY)
+		// Scale the offset by the character scale, deforming it with the body
+		eyePos := position.Add(common.Vector2{X: eyeOffsetX * scale * stretch.X, Y: eyeOffsetY * scale * stretch.Y})
 
-		// Draw the eye sprite
-		screen.DrawImage(eyeSprite, eyeOp)
+		// Use the same origin as the base layer so both stay aligned
+		r.DrawImage(screen, eyeSprite, eyePos, 0, scale, origin, &ebiten.DrawImageOptions{GeoM: spriteGeoM(flipX, stretch)})
 	}
 }
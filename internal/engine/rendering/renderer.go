@@ -3,12 +3,13 @@ package rendering
 
 import (
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 	"github.com/hajimehoshi/ebiten/v2/vector"
+	"image"
 	"image/color"
 	"math"
 	"novampires-go/internal/common"
 	"novampires-go/internal/engine/camera"
+	"time"
 )
 
 // ColorPalette defines a consistent set of colors for rendering
@@ -23,6 +24,7 @@ type ColorPalette struct {
 	PlayerBody    color.RGBA
 	PlayerOutline color.RGBA
 	PlayerAimLine color.RGBA
+	PlayerReticle color.RGBA
 
 	// Projectile colors
 	PlayerBullet color.RGBA
@@ -57,6 +59,7 @@ func DefaultColorPalette() ColorPalette {
 		PlayerBody:    color.RGBA{50, 205, 50, 255}, // Green
 		PlayerOutline: color.RGBA{220, 220, 220, 255},
 		PlayerAimLine: color.RGBA{200, 200, 200, 180},
+		PlayerReticle: color.RGBA{255, 220, 120, 230},
 
 		// Projectile colors
 		PlayerBullet: color.RGBA{68, 221, 255, 255}, // Cyan
@@ -88,6 +91,17 @@ type RenderConfig struct {
 	EnableBloom   bool
 	MotionBlur    bool
 	AntiAliasing  bool
+
+	// Fade the reference grid out toward the viewport edges, starting at
+	// GridFadeStart (0 = center, 1 = edge)
+	GridFade      bool
+	GridFadeStart float64
+
+	// Scale of screen space HUD elements, e.g. 2 for high DPI displays
+	UIScale float64
+
+	// Arrows at the screen edge pointing at off-screen targets
+	Indicators IndicatorConfig
 }
 
 // DefaultRenderConfig returns sensible rendering defaults
@@ -100,7 +114,32 @@ func DefaultRenderConfig() RenderConfig {
 		EnableBloom:   true,
 		MotionBlur:    false,
 		AntiAliasing:  true,
+		GridFade:      true,
+		GridFadeStart: 0.4,
+		UIScale:       1.0,
+		Indicators:    DefaultIndicatorConfig(),
+	}
+}
+
+// SpriteDrawScale returns the scale to draw an image at so that its width
+// covers worldSize world units, before camera zoom. One world unit is one
+// screen pixel at zoom 1, so the result is independent of the asset's
+// resolution: a 96px sprite with a 48 unit world size draws at 0.5. A
+// non-positive worldSize keeps the native size. extraScale multiplies the result.
+func SpriteDrawScale(nativeWidth, worldSize, extraScale float64) float64 {
+	if worldSize <= 0 || nativeWidth <= 0 {
+		return extraScale
 	}
+	return worldSize / nativeWidth * extraScale
+}
+
+// CenterOrigin is the default sprite origin, placing the image center at the draw position
+var CenterOrigin = common.Vector2{X: 0.5, Y: 0.5}
+
+// OriginOffset returns the translation that moves a normalized origin
+// within an image of the given size to (0, 0)
+func OriginOffset(width, height float64, origin common.Vector2) (float64, float64) {
+	return -width * origin.X, -height * origin.Y
 }
 
 // Renderer provides methods for drawing game elements
@@ -110,13 +149,53 @@ type Renderer struct {
 
 	// UI buffer for screen-space rendering
 	uiBuffer *ebiten.Image
+
+	// Scratch image that world text is rasterized into before scaling
+	textBuffer *ebiten.Image
+
+	// Target of the current frame and the copy handed out by CaptureWorld
+	frame         *ebiten.Image
+	captureBuffer *ebiten.Image
+
+	// Screen fade state, advanced by Update
+	fadeActive   bool
+	fadeIn       bool
+	fadeElapsed  time.Duration
+	fadeDuration time.Duration
+
+	// Low health warning state
+	lowHealthEnabled   bool
+	lowHealthThreshold float64
+	healthFraction     float64
+
+	// Reference time for pulsing effects
+	createdAt time.Time
+
+	// Anti-aliasing forced by WithAntiAlias, AntiAliasDefault outside of it
+	antiAliasOverride AntiAlias
+
+	// Font set by WithFont, nil for the debug font
+	fontOverride Font
+
+	// Debug overlay marking the world origin and camera center
+	showGizmo bool
+
+	// Debug overlay of spatial grid cells and queries
+	showSpatialGrid bool
+
+	// Palettes available for runtime switching, starting with the configured one
+	palettes     []ColorPalette
+	paletteIndex int
 }
 
 // NewRenderer creates a new renderer with specified configuration
 func NewRenderer(config RenderConfig, camera *camera.Camera) *Renderer {
 	return &Renderer{
-		config: config,
-		camera: camera,
+		config:         config,
+		camera:         camera,
+		healthFraction: 1.0,
+		createdAt:      time.Now(),
+		palettes:       []ColorPalette{config.ColorPalette},
 	}
 }
 
@@ -133,15 +212,111 @@ func (r *Renderer) BeginFrame(screen *ebiten.Image) {
 	// Clear the screen and UI buffer
 	screen.Fill(r.config.ColorPalette.UIBackground)
 	r.uiBuffer.Clear()
+	r.frame = screen
+}
+
+// CaptureWorld copies everything drawn to the world so far this frame into
+// an offscreen image that effect passes can sample. UI drawn through the UI
+// buffer is not included. The image is owned by the renderer and reused: it
+// stays valid until the next CaptureWorld call, so callers that need it longer
+// must copy it. Returns nil outside of a frame.
+func (r *Renderer) CaptureWorld() *ebiten.Image {
+	if r.frame == nil {
+		return nil
+	}
+
+	width, height := r.frame.Bounds().Dx(), r.frame.Bounds().Dy()
+	if r.captureBuffer == nil ||
+		r.captureBuffer.Bounds().Dx() != width ||
+		r.captureBuffer.Bounds().Dy() != height {
+		r.captureBuffer = ebiten.NewImage(width, height)
+	}
+
+	r.captureBuffer.Clear()
+	r.captureBuffer.DrawImage(r.frame, nil)
+	return r.captureBuffer
 }
 
 func (r *Renderer) EndFrame(screen *ebiten.Image) {
+	r.frame = nil
+
+	// Draw the low health warning below the UI
+	r.drawLowHealthVignette(screen)
+
 	// Draw UI on top of everything
 	screen.DrawImage(r.uiBuffer, nil)
+
+	// Draw the fade overlay above the UI
+	alpha := r.currentFadeAlpha()
+	if alpha > 0 {
+		vector.DrawFilledRect(
+			screen,
+			0,
+			0,
+			float32(screen.Bounds().Dx()),
+			float32(screen.Bounds().Dy()),
+			color.RGBA{0, 0, 0, uint8(alpha * 255)},
+			false,
+		)
+	}
+}
+
+// BeginFade starts a full-screen fade. A fade-in goes from black to the scene,
+// a fade-out goes from the scene to black and stays black until the next fade.
+func (r *Renderer) BeginFade(duration time.Duration, in bool) {
+	r.fadeActive = true
+	r.fadeIn = in
+	r.fadeElapsed = 0
+	r.fadeDuration = duration
+}
+
+// Update advances time based effects by the (scaled) simulation delta
+func (r *Renderer) Update(dt time.Duration) {
+	if !r.fadeActive {
+		return
+	}
+
+	r.fadeElapsed = min(r.fadeElapsed+dt, r.fadeDuration)
+
+	// A finished fade-in no longer needs an overlay
+	if r.fadeIn && r.fadeElapsed >= r.fadeDuration {
+		r.fadeActive = false
+	}
+}
+
+// FadeDone returns whether the current fade has finished (or no fade is running)
+func (r *Renderer) FadeDone() bool {
+	return !r.fadeActive || r.fadeElapsed >= r.fadeDuration
+}
+
+// currentFadeAlpha returns the overlay alpha for the current frame
+func (r *Renderer) currentFadeAlpha() float64 {
+	if !r.fadeActive {
+		return 0
+	}
+	return FadeAlpha(r.fadeElapsed, r.fadeDuration, r.fadeIn)
+}
+
+// FadeAlpha returns the black overlay alpha (0-1) after elapsed time of a fade
+func FadeAlpha(elapsed, duration time.Duration, in bool) float64 {
+	progress := 1.0
+	if duration > 0 {
+		progress = math.Max(0, math.Min(1, float64(elapsed)/float64(duration)))
+	}
+
+	if in {
+		return 1 - progress
+	}
+	return progress
 }
 
 // DrawCircle draws a filled circle in world coordinates
 func (r *Renderer) DrawCircle(screen *ebiten.Image, position common.Vector2, radius float64, fill color.RGBA) {
+	r.DrawCircleBlend(screen, position, radius, fill, BlendNormal)
+}
+
+// DrawCircleBlend draws a filled circle in world coordinates with the given blend mode
+func (r *Renderer) DrawCircleBlend(screen *ebiten.Image, position common.Vector2, radius float64, fill color.RGBA, mode BlendMode) {
 	// Get the viewport - if object is outside, skip drawing
 	viewport := r.camera.GetViewport()
 	circle := common.Rectangle{
@@ -156,62 +331,155 @@ func (r *Renderer) DrawCircle(screen *ebiten.Image, position common.Vector2, rad
 	// Apply camera transform to get screen coordinates
 	screenPos := r.worldToScreen(position)
 
+	screenRadius := radius * r.camera.GetZoom() // Scale radius by zoom
+
+	// Non-default blending needs a path drawn with explicit options
+	if mode != BlendNormal {
+		var path vector.Path
+		path.Arc(float32(screenPos.X), float32(screenPos.Y), float32(screenRadius), 0, 2*math.Pi, vector.Clockwise)
+		r.fillPath(screen, &path, fill, mode, r.antiAlias())
+		return
+	}
+
 	// Draw the circle at screen position
 	vector.DrawFilledCircle(
 		screen,
 		float32(screenPos.X),
 		float32(screenPos.Y),
-		float32(radius*r.camera.GetZoom()), // Scale radius by zoom
+		float32(screenRadius),
 		fill,
-		r.config.AntiAliasing,
+		r.antiAlias(),
 	)
 }
 
 // DrawCircleOutline draws a circle outline in world coordinates
 func (r *Renderer) DrawCircleOutline(screen *ebiten.Image, position common.Vector2, radius float64, lineWidth float64, stroke color.RGBA) {
-	// Check if circle is in viewport
-	viewport := r.camera.GetViewport()
-	circle := common.Rectangle{
-		Pos:  common.Vector2{X: position.X - radius, Y: position.Y - radius},
-		Size: common.Vector2{X: radius * 2, Y: radius * 2},
-	}
+	r.DrawArc(screen, position, radius, 0, 2*math.Pi, lineWidth, stroke)
+}
 
-	if !viewport.Intersects(circle) {
-		return // Circle is outside the viewport, skip drawing
+// arcSegments returns how many line segments approximate an arc, keeping
+// full circles at least 12 segments and adding more for larger radii
+func arcSegments(radius, sweep float64) int {
+	return int(math.Ceil(math.Max(12, radius/4) * math.Abs(sweep) / (2 * math.Pi)))
+}
+
+// arcPoint returns the point on the arc i segments of n along the sweep
+func arcPoint(center common.Vector2, radius, startAngle, sweep float64, i, n int) common.Vector2 {
+	angle := startAngle + sweep*float64(i)/float64(n)
+	return common.Vector2{
+		X: center.X + math.Cos(angle)*radius,
+		Y: center.Y + math.Sin(angle)*radius,
 	}
+}
 
-	// Get screen position
-	screenPos := r.worldToScreen(position)
+// DrawArc draws part of a circle outline in world coordinates, from
+// startAngle to endAngle in radians (clockwise on screen)
+func (r *Renderer) DrawArc(screen *ebiten.Image, center common.Vector2, radius, startAngle, endAngle, lineWidth float64, stroke color.RGBA) {
+	if !r.isCircleVisible(center, radius) {
+		return
+	}
 
-	// Scale radius and line width by zoom
-	screenRadius := radius * r.camera.GetZoom()
-	screenLineWidth := lineWidth * r.camera.GetZoom()
+	zoom := r.camera.GetZoom()
+	r.DrawScreenArc(screen, r.worldToScreen(center), radius*zoom, startAngle, endAngle, lineWidth*zoom, stroke)
+}
 
-	// Draw the circle outline
-	numSegments := int(math.Max(12, screenRadius/4)) // Adjust segments based on size
+// DrawScreenArc draws part of a circle outline in screen coordinates as a
+// series of line segments, from startAngle to endAngle in radians
+func (r *Renderer) DrawScreenArc(screen *ebiten.Image, center common.Vector2, radius, startAngle, endAngle, lineWidth float64, stroke color.RGBA) {
+	sweep := endAngle - startAngle
+	numSegments := arcSegments(radius, sweep)
 
 	for i := 0; i < numSegments; i++ {
-		angle1 := float64(i) / float64(numSegments) * 2 * math.Pi
-		angle2 := float64(i+1) / float64(numSegments) * 2 * math.Pi
-
-		x1 := screenPos.X + math.Cos(angle1)*screenRadius
-		y1 := screenPos.Y + math.Sin(angle1)*screenRadius
-		x2 := screenPos.X + math.Cos(angle2)*screenRadius
-		y2 := screenPos.Y + math.Sin(angle2)*screenRadius
+		p1 := arcPoint(center, radius, startAngle, sweep, i, numSegments)
+		p2 := arcPoint(center, radius, startAngle, sweep, i+1, numSegments)
 
 		vector.StrokeLine(
 			screen,
-			float32(x1),
-			float32(y1),
-			float32(x2),
-			float32(y2),
-			float32(screenLineWidth),
+			float32(p1.X),
+			float32(p1.Y),
+			float32(p2.X),
+			float32(p2.Y),
+			float32(lineWidth),
 			stroke,
-			r.config.AntiAliasing,
+			r.antiAlias(),
 		)
 	}
 }
 
+// DrawSector draws a filled pie slice in world coordinates, from startAngle
+// to endAngle in radians (clockwise on screen)
+func (r *Renderer) DrawSector(screen *ebiten.Image, center common.Vector2, radius, startAngle, endAngle float64, fill color.RGBA) {
+	if !r.isCircleVisible(center, radius) {
+		return
+	}
+
+	r.DrawScreenSector(screen, r.worldToScreen(center), radius*r.camera.GetZoom(), startAngle, endAngle, fill)
+}
+
+// DrawScreenSector draws a filled pie slice in screen coordinates
+func (r *Renderer) DrawScreenSector(screen *ebiten.Image, center common.Vector2, radius, startAngle, endAngle float64, fill color.RGBA) {
+	sweep := endAngle - startAngle
+	numSegments := arcSegments(radius, sweep)
+	if numSegments < 1 {
+		return
+	}
+
+	var path vector.Path
+	path.MoveTo(float32(center.X), float32(center.Y))
+	for i := 0; i <= numSegments; i++ {
+		p := arcPoint(center, radius, startAngle, sweep, i, numSegments)
+		path.LineTo(float32(p.X), float32(p.Y))
+	}
+	path.Close()
+
+	r.fillPath(screen, &path, fill, BlendNormal, r.antiAlias())
+}
+
+// isCircleVisible returns whether a world space circle overlaps the viewport
+func (r *Renderer) isCircleVisible(center common.Vector2, radius float64) bool {
+	return r.camera.GetViewport().Intersects(common.Rectangle{
+		Pos:  common.Vector2{X: center.X - radius, Y: center.Y - radius},
+		Size: common.Vector2{X: radius * 2, Y: radius * 2},
+	})
+}
+
+const (
+	// cooldownRingStart is the angle cooldown rings start from (12 o'clock)
+	cooldownRingStart = -math.Pi / 2
+
+	// cooldownTrackOpacity fades the UI background for the ring's track
+	cooldownTrackOpacity = 0.8
+)
+
+// CooldownArcEnd returns the end angle of a cooldown ring's arc for a
+// remaining-cooldown fraction, sweeping clockwise from the top
+func CooldownArcEnd(progress float64) float64 {
+	progress = math.Max(0, math.Min(1, progress))
+	return cooldownRingStart + progress*2*math.Pi
+}
+
+// DrawCooldownRing draws an ability cooldown ring in screen coordinates
+// authored at UI scale 1, so its position and radius grow with the UI scale
+// like HUD text. progress is the remaining cooldown from 1 (just used) to 0 (ready).
+func (r *Renderer) DrawCooldownRing(screen *ebiten.Image, center common.Vector2, radius float64, progress float64) {
+	center = center.Scale(r.config.UIScale)
+	radius = HUDSize(radius, r.config.UIScale)
+	lineWidth := HUDSize(r.config.LineThickness*2, r.config.UIScale)
+
+	// Track showing the full ring
+	track := FadeColor(r.config.ColorPalette.UIBackground, cooldownTrackOpacity)
+	r.DrawScreenArc(screen, center, radius, 0, 2*math.Pi, lineWidth, track)
+
+	if progress <= 0 {
+		// Ready, highlight the whole ring
+		r.DrawScreenArc(screen, center, radius, 0, 2*math.Pi, lineWidth, r.config.ColorPalette.UIHighlight)
+		return
+	}
+
+	// Remaining cooldown
+	r.DrawScreenArc(screen, center, radius, cooldownRingStart, CooldownArcEnd(progress), lineWidth, r.config.ColorPalette.UIAccent)
+}
+
 // DrawRect draws a filled rectangle in world coordinates
 func (r *Renderer) DrawRect(screen *ebiten.Image, rect common.Rectangle, fill color.RGBA) {
 	// Check if rect is in viewport
@@ -230,7 +498,7 @@ func (r *Renderer) DrawRect(screen *ebiten.Image, rect common.Rectangle, fill co
 		float32(screenRect.Size.X),
 		float32(screenRect.Size.Y),
 		fill,
-		r.config.AntiAliasing,
+		r.antiAlias(),
 	)
 }
 
@@ -254,12 +522,17 @@ func (r *Renderer) DrawRectOutline(screen *ebiten.Image, rect common.Rectangle,
 		float32(screenRect.Size.Y),
 		float32(screenLineWidth),
 		stroke,
-		r.config.AntiAliasing,
+		r.antiAlias(),
 	)
 }
 
 // DrawLine draws a line in world coordinates
 func (r *Renderer) DrawLine(screen *ebiten.Image, start, end common.Vector2, lineWidth float64, stroke color.RGBA) {
+	r.DrawLineBlend(screen, start, end, lineWidth, stroke, BlendNormal)
+}
+
+// DrawLineBlend draws a line in world coordinates with the given blend mode
+func (r *Renderer) DrawLineBlend(screen *ebiten.Image, start, end common.Vector2, lineWidth float64, stroke color.RGBA, mode BlendMode) {
 	// Check if line intersects the viewport
 	viewport := r.camera.GetViewport()
 
@@ -285,6 +558,15 @@ func (r *Renderer) DrawLine(screen *ebiten.Image, start, end common.Vector2, lin
 	// Scale line width by zoom
 	screenLineWidth := lineWidth * r.camera.GetZoom()
 
+	// Non-default blending needs a path drawn with explicit options
+	if mode != BlendNormal {
+		var path vector.Path
+		path.MoveTo(float32(screenStart.X), float32(screenStart.Y))
+		path.LineTo(float32(screenEnd.X), float32(screenEnd.Y))
+		r.strokePath(screen, &path, screenLineWidth, stroke, mode, r.antiAlias())
+		return
+	}
+
 	vector.StrokeLine(
 		screen,
 		float32(screenStart.X),
@@ -293,7 +575,7 @@ func (r *Renderer) DrawLine(screen *ebiten.Image, start, end common.Vector2, lin
 		float32(screenEnd.Y),
 		float32(screenLineWidth),
 		stroke,
-		r.config.AntiAliasing,
+		r.antiAlias(),
 	)
 }
 
@@ -323,7 +605,7 @@ func (r *Renderer) DrawHealthBar(screen *ebiten.Image, position common.Vector2,
 		float32(screenWidth),
 		float32(screenHeight),
 		r.config.ColorPalette.HealthBarBG,
-		r.config.AntiAliasing,
+		r.antiAlias(),
 	)
 
 	// Health fill
@@ -336,7 +618,7 @@ func (r *Renderer) DrawHealthBar(screen *ebiten.Image, position common.Vector2,
 			float32(fillWidth),
 			float32(screenHeight),
 			r.config.ColorPalette.HealthBarFill,
-			r.config.AntiAliasing,
+			r.antiAlias(),
 		)
 	}
 }
@@ -367,7 +649,7 @@ func (r *Renderer) DrawPlayerCharacter(screen, playerImg *ebiten.Image, position
 			float32(screenPos.Y),
 			float32(screenRadius),
 			r.config.ColorPalette.PlayerBody,
-			r.config.AntiAliasing,
+			r.antiAlias(),
 		)
 	} else {
 		// Create image drawing options
@@ -384,8 +666,8 @@ func (r *Renderer) DrawPlayerCharacter(screen, playerImg *ebiten.Image, position
 		//	op.GeoM.Rotate(rotation)
 		//}
 
-		// Apply base scale (adjust as needed for your sprite)
-		baseScale := (radius * 3) / imgWidth // Scale sprite to match radius
+		// Size the sprite to 1.5x the body diameter in world units
+		baseScale := SpriteDrawScale(imgWidth, radius*3, 1.0)
 		op.GeoM.Scale(baseScale, baseScale)
 
 		// Apply camera zoom
@@ -411,7 +693,7 @@ func (r *Renderer) DrawPlayerCharacter(screen, playerImg *ebiten.Image, position
 		float32(dirY),
 		float32(r.config.LineThickness*r.camera.GetZoom()),
 		r.config.ColorPalette.PlayerOutline,
-		r.config.AntiAliasing,
+		r.antiAlias(),
 	)
 }
 
@@ -422,6 +704,8 @@ func (r *Renderer) DrawPlayerSprite(
 	position common.Vector2,
 	rotation float64,
 	scale float64,
+	stretch common.Vector2,
+	origin common.Vector2,
 	flipX bool,
 ) {
 	// Skip if no sprite provided
@@ -445,39 +729,9 @@ func (r *Renderer) DrawPlayerSprite(
 	// Get screen position
 	screenPos := r.worldToScreen(position)
 
-	// Create drawing options
-	op := &ebiten.DrawImageOptions{}
-
-	// Get image dimensions
-	imgWidth, imgHeight := float64(sprite.Bounds().Dx()), float64(sprite.Bounds().Dy())
-
-	// Center the image (translate to origin for proper rotation)
-	op.GeoM.Translate(-imgWidth/2, -imgHeight/2)
-
-	// Apply flip if needed (before rotation)
-	scaleX := 1.0
-	if flipX {
-		scaleX = -1.0
-	}
-	op.GeoM.Scale(scaleX, 1.0)
-
-	// Apply rotation
-	//if rotation != 0 {
-	//	op.GeoM.Rotate(rotation)
-	//}
-
-	// Apply scale directly - using the scale parameter as a direct multiplier
-	// The scale parameter should represent how many times larger than original size
-	op.GeoM.Scale(scale, scale)
-
-	// Apply camera zoom
-	op.GeoM.Scale(r.camera.GetZoom(), r.camera.GetZoom())
-
-	// Translate to screen position
-	op.GeoM.Translate(screenPos.X, screenPos.Y)
-
-	// Draw the image
-	screen.DrawImage(sprite, op)
+	// The sprite itself stays upright, rotation only drives the indicator
+	op := &ebiten.DrawImageOptions{GeoM: spriteGeoM(flipX, stretch)}
+	r.DrawImage(screen, sprite, position, 0, scale, origin, op)
 
 	// Draw direction indicator (optional, you can remove if not needed)
 	indicatorLength := 20.0 * r.camera.GetZoom() * scale
@@ -492,7 +746,7 @@ func (r *Renderer) DrawPlayerSprite(
 		float32(dirY),
 		float32(r.config.LineThickness*r.camera.GetZoom()),
 		r.config.ColorPalette.PlayerOutline,
-		r.config.AntiAliasing,
+		r.antiAlias(),
 	)
 }
 
@@ -516,10 +770,58 @@ func (r *Renderer) DrawAimLine(screen *ebiten.Image, start common.Vector2, direc
 		float32(screenEnd.Y),
 		float32(r.config.LineThickness*0.5*r.camera.GetZoom()),
 		r.config.ColorPalette.PlayerAimLine,
-		r.config.AntiAliasing,
+		r.antiAlias(),
 	)
 }
 
+// ReticleStyle selects the shape of the aim reticle
+type ReticleStyle int
+
+const (
+	// ReticleCrosshair is a plus with a gap in the middle
+	ReticleCrosshair ReticleStyle = iota
+	// ReticleRing is a circle with short ticks pointing inward
+	ReticleRing
+	// ReticleLead is a small diamond marking where a moving target is led to
+	ReticleLead
+)
+
+// reticleSize is the reticle radius in screen pixels, it does not scale with zoom
+const reticleSize = 12.0
+
+// DrawReticle draws an aim reticle centered on a world position
+func (r *Renderer) DrawReticle(screen *ebiten.Image, worldPos common.Vector2, style ReticleStyle) {
+	center := r.worldToScreen(worldPos)
+	cx, cy := float32(center.X), float32(center.Y)
+	size := float32(reticleSize)
+	gap := size * 0.35
+	width := float32(r.config.LineThickness)
+	col := r.config.ColorPalette.PlayerReticle
+
+	switch style {
+	case ReticleLead:
+		half := size * 0.5
+		vector.StrokeLine(screen, cx, cy-half, cx+half, cy, width, col, r.antiAlias())
+		vector.StrokeLine(screen, cx+half, cy, cx, cy+half, width, col, r.antiAlias())
+		vector.StrokeLine(screen, cx, cy+half, cx-half, cy, width, col, r.antiAlias())
+		vector.StrokeLine(screen, cx-half, cy, cx, cy-half, width, col, r.antiAlias())
+	case ReticleRing:
+		vector.StrokeCircle(screen, cx, cy, size, width, col, r.antiAlias())
+
+		// Inward ticks at the four cardinal points
+		tick := size * 0.5
+		vector.StrokeLine(screen, cx-size, cy, cx-size+tick, cy, width, col, r.antiAlias())
+		vector.StrokeLine(screen, cx+size, cy, cx+size-tick, cy, width, col, r.antiAlias())
+		vector.StrokeLine(screen, cx, cy-size, cx, cy-size+tick, width, col, r.antiAlias())
+		vector.StrokeLine(screen, cx, cy+size, cx, cy+size-tick, width, col, r.antiAlias())
+	default:
+		vector.StrokeLine(screen, cx-size, cy, cx-gap, cy, width, col, r.antiAlias())
+		vector.StrokeLine(screen, cx+gap, cy, cx+size, cy, width, col, r.antiAlias())
+		vector.StrokeLine(screen, cx, cy-size, cx, cy-gap, width, col, r.antiAlias())
+		vector.StrokeLine(screen, cx, cy+gap, cx, cy+size, width, col, r.antiAlias())
+	}
+}
+
 // DrawGrid draws a reference grid that follows camera transformations
 func (r *Renderer) DrawGrid(screen *ebiten.Image) {
 	viewport := r.camera.GetViewport()
@@ -532,48 +834,166 @@ func (r *Renderer) DrawGrid(screen *ebiten.Image) {
 	endX := viewport.Pos.X + viewport.Size.X
 	endY := viewport.Pos.Y + viewport.Size.Y
 
-	zoom := r.camera.GetZoom()
+	lineWidth := 1 * r.camera.GetZoom() // Scale line width by zoom
 
-	// Draw vertical grid lines
+	// Every line goes into one path. Lines are split at each crossing so the
+	// fade has vertices to vary across when it is enabled.
+	var path vector.Path
 	for x := startX; x <= endX; x += gridSpacing {
-		// Convert world to screen
-		screenX1, screenY1 := r.worldToScreen(common.Vector2{X: x, Y: startY}).X, r.worldToScreen(common.Vector2{X: x, Y: startY}).Y
-		screenX2, screenY2 := r.worldToScreen(common.Vector2{X: x, Y: endY}).X, r.worldToScreen(common.Vector2{X: x, Y: endY}).Y
+		r.appendGridLine(&path, common.Vector2{X: x, Y: startY}, common.Vector2{X: 0, Y: gridSpacing}, endY-startY)
+	}
+	for y := startY; y <= endY; y += gridSpacing {
+		r.appendGridLine(&path, common.Vector2{X: startX, Y: y}, common.Vector2{X: gridSpacing, Y: 0}, endX-startX)
+	}
 
-		vector.StrokeLine(
-			screen,
-			float32(screenX1),
-			float32(screenY1),
-			float32(screenX2),
-			float32(screenY2),
-			float32(1*zoom), // Scale line width by zoom
-			gridColor,
-			true,
-		)
+	vertices, indices := path.AppendVerticesAndIndicesForStroke(nil, nil, &vector.StrokeOptions{
+		Width: float32(lineWidth),
+	})
+	for i := range vertices {
+		fade := 1.0
+		if r.config.GridFade {
+			pos := r.screenToWorld(common.Vector2{X: float64(vertices[i].DstX), Y: float64(vertices[i].DstY)})
+			fade = GridFadeAlpha(pos, viewport, r.config.GridFadeStart)
+		}
+		setVertexColor(&vertices[i], FadeColor(gridColor, fade))
 	}
 
-	// Draw horizontal grid lines
-	for y := startY; y <= endY; y += gridSpacing {
-		// Convert world to screen
-		screenX1, screenY1 := r.worldToScreen(common.Vector2{X: startX, Y: y}).X, r.worldToScreen(common.Vector2{X: startX, Y: y}).Y
-		screenX2, screenY2 := r.worldToScreen(common.Vector2{X: endX, Y: y}).X, r.worldToScreen(common.Vector2{X: endX, Y: y}).Y
+	options := trianglesOptions(BlendNormal, true)
+	options.ColorScaleMode = ebiten.ColorScaleModePremultipliedAlpha
+	screen.DrawTriangles(vertices, indices, whiteSubImage, options)
+}
 
-		vector.StrokeLine(
-			screen,
-			float32(screenX1),
-			float32(screenY1),
-			float32(screenX2),
-			float32(screenY2),
-			float32(1*zoom), // Scale line width by zoom
-			gridColor,
-			true,
-		)
+// appendGridLine adds a grid line of the given world length to path,
+// with a vertex at every step along it
+func (r *Renderer) appendGridLine(path *vector.Path, start, step common.Vector2, length float64) {
+	screenStart := r.worldToScreen(start)
+	path.MoveTo(float32(screenStart.X), float32(screenStart.Y))
+
+	stepLength := step.Magnitude()
+	for traveled := stepLength; traveled < length+stepLength; traveled += stepLength {
+		pos := r.worldToScreen(start.Add(step.Scale(math.Min(traveled, length) / stepLength)))
+		path.LineTo(float32(pos.X), float32(pos.Y))
+	}
+}
+
+// FadeColor scales a premultiplied color by fade (0 = invisible, 1 = as is).
+// All channels are scaled together, scaling alpha alone would brighten the
+// color as it fades.
+func FadeColor(col color.RGBA, fade float64) color.RGBA {
+	fade = math.Max(0, math.Min(1, fade))
+	return color.RGBA{
+		R: uint8(float64(col.R) * fade),
+		G: uint8(float64(col.G) * fade),
+		B: uint8(float64(col.B) * fade),
+		A: uint8(float64(col.A) * fade),
+	}
+}
+
+// GridFadeAlpha returns the opacity multiplier of the grid at a world
+// position: 1 inside fadeStart of the viewport center (0 = center, 1 = edge),
+// falling to 0 at the viewport edge
+func GridFadeAlpha(pos common.Vector2, viewport common.Rectangle, fadeStart float64) float64 {
+	halfW := viewport.Size.X / 2
+	halfH := viewport.Size.Y / 2
+	if halfW <= 0 || halfH <= 0 {
+		return 1
+	}
+
+	// Normalized elliptical distance from the center
+	dx := (pos.X - (viewport.Pos.X + halfW)) / halfW
+	dy := (pos.Y - (viewport.Pos.Y + halfH)) / halfH
+	distance := math.Sqrt(dx*dx + dy*dy)
+
+	if distance <= fadeStart {
+		return 1
+	}
+	if fadeStart >= 1 {
+		return 0
+	}
+	return math.Max(0, 1-(distance-fadeStart)/(1-fadeStart))
+}
+
+// Size of a glyph in the debug font
+const (
+	debugGlyphWidth  = 6
+	debugGlyphHeight = 16
+)
+
+// textOutlineOffsets are the directions an outline copy is drawn in, in text pixels
+var textOutlineOffsets = []common.Vector2{
+	{X: -1, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: -1}, {X: 0, Y: 1},
+}
+
+// DrawWorldText draws text centered on a world position. The text is scaled
+// on top of the camera zoom and optionally surrounded by a dark outline.
+func (r *Renderer) DrawWorldText(screen *ebiten.Image, text string, position common.Vector2, scale float64, fill color.RGBA, outline bool) {
+	screenPos := r.worldToScreen(position)
+	screenScale := scale * r.camera.GetZoom()
+
+	// Skip text entirely off-screen, allowing for the outline
+	size := TextRect(r.font(), text, common.Vector2{}, screenScale).Size
+	bounds := common.RectFromCenter(screenPos, size.Add(common.Vector2{X: screenScale * 2, Y: screenScale * 2}))
+	if !bounds.Intersects(screenRect(screen)) {
+		return
+	}
+
+	glyphs := r.rasterizeText(text)
+	if glyphs == nil {
+		return
+	}
+	width := glyphs.Bounds().Dx()
+	height := glyphs.Bounds().Dy()
+
+	draw := func(offset common.Vector2, col color.RGBA) {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(-float64(width)/2+offset.X, -float64(height)/2+offset.Y)
+		op.GeoM.Scale(screenScale, screenScale)
+		op.GeoM.Translate(screenPos.X, screenPos.Y)
+		op.ColorScale.Scale(float32(col.R)/255, float32(col.G)/255, float32(col.B)/255, 1)
+		op.ColorScale.ScaleAlpha(float32(col.A) / 255)
+		screen.DrawImage(glyphs, op)
+	}
+
+	if outline {
+		shadow := color.RGBA{0, 0, 0, fill.A}
+		for _, offset := range textOutlineOffsets {
+			draw(offset, shadow)
+		}
+	}
+
+	draw(common.Vector2{}, fill)
+}
+
+// rasterizeText draws a single line of text into the scratch buffer at its
+// native size in the current font and returns the covered area, or nil for
+// empty text. The image is only valid until the next call.
+func (r *Renderer) rasterizeText(text string) *ebiten.Image {
+	if text == "" {
+		return nil
+	}
+
+	font := r.font()
+	size := font.Size(text)
+	width := int(math.Ceil(size.X))
+	height := int(math.Ceil(size.Y))
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+
+	// Grow the scratch buffer as needed
+	if r.textBuffer == nil ||
+		r.textBuffer.Bounds().Dx() < width ||
+		r.textBuffer.Bounds().Dy() < height {
+		r.textBuffer = ebiten.NewImage(width, height)
 	}
+	r.textBuffer.Clear()
+	font.Draw(r.textBuffer, text)
+	return r.textBuffer.SubImage(image.Rect(0, 0, width, height)).(*ebiten.Image)
 }
 
-// DrawUIText draws text directly to the UI buffer (no transformation)
+// DrawUIText draws text to the UI buffer, scaled by the UI scale
 func (r *Renderer) DrawUIText(text string, pos common.Vector2, col color.RGBA) {
-	ebitenutil.DebugPrintAt(r.uiBuffer, text, int(pos.X), int(pos.Y))
+	r.DrawHUDText(r.uiBuffer, text, pos, col)
 }
 
 // Helper functions for coordinate transformations
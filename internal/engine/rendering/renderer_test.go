@@ -0,0 +1,55 @@
+package rendering
+
+import (
+	"novampires-go/internal/common"
+	"testing"
+)
+
+func TestOriginOffset(t *testing.T) {
+	tests := []struct {
+		name   string
+		origin common.Vector2
+		wantX  float64
+		wantY  float64
+	}{
+		{"center", CenterOrigin, -48, -32},
+		{"top left", common.Vector2{}, 0, 0},
+		{"feet", common.Vector2{X: 0.5, Y: 1}, -48, -64},
+		{"muzzle", common.Vector2{X: 0.75, Y: 0.25}, -72, -16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, y := OriginOffset(96, 64, tt.origin)
+			if x != tt.wantX || y != tt.wantY {
+				t.Errorf("OriginOffset() = (%v, %v), want (%v, %v)", x, y, tt.wantX, tt.wantY)
+			}
+		})
+	}
+}
+
+func TestSpriteDrawScale(t *testing.T) {
+	tests := []struct {
+		name        string
+		nativeWidth float64
+		worldSize   float64
+		extraScale  float64
+		want        float64
+	}{
+		{"96px sprite at 48 units", 96, 48, 1, 0.5},
+		{"48px sprite at 48 units", 48, 48, 1, 1},
+		{"24px sprite at 48 units", 24, 48, 1, 2},
+		{"extra scale multiplies", 96, 48, 1.5, 0.75},
+		{"no world size keeps native", 96, 0, 1.5, 1.5},
+		{"empty image keeps native", 0, 48, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SpriteDrawScale(tt.nativeWidth, tt.worldSize, tt.extraScale); got != tt.want {
+				t.Errorf("SpriteDrawScale(%v, %v, %v) = %v, want %v",
+					tt.nativeWidth, tt.worldSize, tt.extraScale, got, tt.want)
+			}
+		})
+	}
+}
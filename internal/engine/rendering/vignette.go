@@ -0,0 +1,80 @@
+// internal/engine/rendering/vignette.go
+package rendering
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"image/color"
+	"math"
+	"time"
+)
+
+// Low health warning tuning
+const (
+	lowHealthPulsePeriod = 900 * time.Millisecond
+	lowHealthMinAlpha    = 0.25
+	lowHealthMaxAlpha    = 0.7
+
+	// Width of the vignette border in screen pixels and how many bands it is drawn with
+	vignetteWidth = 48.0
+	vignetteBands = 6
+)
+
+// SetLowHealthWarning enables the pulsing red vignette below threshold health (0-1)
+func (r *Renderer) SetLowHealthWarning(enabled bool, threshold float64) {
+	r.lowHealthEnabled = enabled
+	r.lowHealthThreshold = threshold
+}
+
+// SetHealthFraction sets the player's current health fraction (0-1) for the low health warning
+func (r *Renderer) SetHealthFraction(fraction float64) {
+	r.healthFraction = fraction
+}
+
+// LowHealthPulseAlpha returns the vignette alpha (0-1) for a health fraction at
+// time t. The vignette is invisible at or above threshold and gets stronger as
+// health drops, pulsing with a sine wave.
+func LowHealthPulseAlpha(healthFraction, threshold float64, t time.Duration) float64 {
+	if threshold <= 0 || healthFraction >= threshold {
+		return 0
+	}
+
+	severity := math.Min(1, (threshold-math.Max(0, healthFraction))/threshold)
+	pulse := 0.5 + 0.5*math.Sin(2*math.Pi*float64(t)/float64(lowHealthPulsePeriod))
+
+	return severity * (lowHealthMinAlpha + (lowHealthMaxAlpha-lowHealthMinAlpha)*pulse)
+}
+
+// drawLowHealthVignette draws a red border that fades toward the screen center
+func (r *Renderer) drawLowHealthVignette(screen *ebiten.Image) {
+	if !r.lowHealthEnabled {
+		return
+	}
+
+	alpha := LowHealthPulseAlpha(r.healthFraction, r.lowHealthThreshold, time.Since(r.createdAt))
+	if alpha <= 0 {
+		return
+	}
+
+	width := float32(screen.Bounds().Dx())
+	height := float32(screen.Bounds().Dy())
+	band := float32(vignetteWidth / vignetteBands)
+
+	// Nested bands, strongest at the edge
+	for i := 0; i < vignetteBands; i++ {
+		bandAlpha := alpha * (1 - float64(i)/vignetteBands)
+		col := color.RGBA{uint8(200 * bandAlpha), 0, 0, uint8(255 * bandAlpha)}
+		inset := band * float32(i)
+
+		vector.StrokeRect(
+			screen,
+			inset+band/2,
+			inset+band/2,
+			width-2*inset-band,
+			height-2*inset-band,
+			band,
+			col,
+			false,
+		)
+	}
+}
@@ -0,0 +1,40 @@
+package rendering
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLowHealthPulseAlpha(t *testing.T) {
+	const threshold = 0.3
+	peak := lowHealthPulsePeriod / 4
+	trough := 3 * lowHealthPulsePeriod / 4
+
+	tests := []struct {
+		name      string
+		health    float64
+		threshold float64
+		at        time.Duration
+		want      float64
+	}{
+		{"healthy", 0.5, threshold, peak, 0},
+		{"at threshold", threshold, threshold, peak, 0},
+		{"disabled threshold", 0, 0, peak, 0},
+		{"empty at pulse midpoint", 0, threshold, 0, 0.475},
+		{"empty at pulse peak", 0, threshold, peak, lowHealthMaxAlpha},
+		{"empty at pulse trough", 0, threshold, trough, lowHealthMinAlpha},
+		{"half way to empty at peak", threshold / 2, threshold, peak, lowHealthMaxAlpha / 2},
+		{"negative health clamps", -1, threshold, peak, lowHealthMaxAlpha},
+		{"pulse repeats each period", 0, threshold, peak + 3*lowHealthPulsePeriod, lowHealthMaxAlpha},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LowHealthPulseAlpha(tt.health, tt.threshold, tt.at)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("LowHealthPulseAlpha(%v, %v, %v) = %v, want %v", tt.health, tt.threshold, tt.at, got, tt.want)
+			}
+		})
+	}
+}
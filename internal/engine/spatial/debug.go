@@ -0,0 +1,73 @@
+// internal/engine/spatial/debug.go
+package spatial
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"math"
+	"novampires-go/internal/common"
+)
+
+// DebugRenderer is the subset of the entity renderer the debug overlay
+// needs. It is declared here so the entity package can use the grid.
+type DebugRenderer interface {
+	DrawRect(screen *ebiten.Image, rect common.Rectangle, fill color.RGBA)
+	DrawRectOutline(screen *ebiten.Image, rect common.Rectangle, lineWidth float64, stroke color.RGBA)
+	DrawArc(screen *ebiten.Image, center common.Vector2, radius, startAngle, endAngle, lineWidth float64, stroke color.RGBA)
+}
+
+var (
+	debugCellOutline  = color.RGBA{90, 160, 255, 90}
+	debugCellOccupied = color.RGBA{90, 160, 255, 60}
+	debugQueryRadius  = color.RGBA{255, 200, 60, 180}
+)
+
+// DrawDebug outlines the cells around the grid's contents, highlights the
+// occupied ones and draws the radius of the most recent query
+func (g *Grid) DrawDebug(screen *ebiten.Image, renderer DebugRenderer) {
+	occupied := g.OccupiedCells(nil)
+
+	// Outline every cell in the area spanned by the entries and the last query
+	if minCell, maxCell, ok := g.debugBounds(occupied); ok {
+		for y := minCell.Y; y <= maxCell.Y; y++ {
+			for x := minCell.X; x <= maxCell.X; x++ {
+				renderer.DrawRectOutline(screen, g.CellBounds(Cell{X: x, Y: y}), 1, debugCellOutline)
+			}
+		}
+	}
+
+	for _, cell := range occupied {
+		renderer.DrawRect(screen, g.CellBounds(cell), debugCellOccupied)
+	}
+
+	if center, radius, ok := g.LastQuery(); ok {
+		renderer.DrawArc(screen, center, radius, 0, 2*math.Pi, 2, debugQueryRadius)
+	}
+}
+
+// debugBounds returns the range of cells covering the occupied cells and
+// the last query, padded by one cell
+func (g *Grid) debugBounds(occupied []Cell) (Cell, Cell, bool) {
+	cells := occupied
+	if center, radius, ok := g.LastQuery(); ok {
+		cells = append(cells,
+			g.CellOf(common.Vector2{X: center.X - radius, Y: center.Y - radius}),
+			g.CellOf(common.Vector2{X: center.X + radius, Y: center.Y + radius}),
+		)
+	}
+	if len(cells) == 0 {
+		return Cell{}, Cell{}, false
+	}
+
+	minCell, maxCell := cells[0], cells[0]
+	for _, c := range cells[1:] {
+		minCell.X, minCell.Y = min(minCell.X, c.X), min(minCell.Y, c.Y)
+		maxCell.X, maxCell.Y = max(maxCell.X, c.X), max(maxCell.Y, c.Y)
+	}
+
+	minCell.X--
+	minCell.Y--
+	maxCell.X++
+	maxCell.Y++
+	return minCell, maxCell, true
+}
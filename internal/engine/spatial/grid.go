@@ -0,0 +1,108 @@
+// internal/engine/spatial/grid.go
+package spatial
+
+import (
+	"math"
+	"novampires-go/internal/common"
+)
+
+// Cell identifies a grid cell by its integer coordinates
+type Cell struct {
+	X, Y int
+}
+
+// Entry is an item stored in the grid
+type Entry struct {
+	ID  uint64
+	Pos common.Vector2
+}
+
+// Grid is a uniform spatial hash used for fast proximity queries.
+// It is meant to be cleared and refilled every frame.
+type Grid struct {
+	cellSize float64
+	cells    map[Cell][]Entry
+
+	// Most recent QueryRadius call, kept for debug drawing
+	lastQueryCenter common.Vector2
+	lastQueryRadius float64
+	hasLastQuery    bool
+}
+
+// NewGrid creates a grid with square cells of the given size in world units
+func NewGrid(cellSize float64) *Grid {
+	return &Grid{
+		cellSize: math.Max(1, cellSize),
+		cells:    make(map[Cell][]Entry),
+	}
+}
+
+// CellSize returns the size of a cell in world units
+func (g *Grid) CellSize() float64 {
+	return g.cellSize
+}
+
+// CellOf returns the cell containing a world position
+func (g *Grid) CellOf(pos common.Vector2) Cell {
+	return Cell{
+		X: int(math.Floor(pos.X / g.cellSize)),
+		Y: int(math.Floor(pos.Y / g.cellSize)),
+	}
+}
+
+// CellBounds returns the world space rectangle covered by a cell
+func (g *Grid) CellBounds(cell Cell) common.Rectangle {
+	return common.Rectangle{
+		Pos:  common.Vector2{X: float64(cell.X) * g.cellSize, Y: float64(cell.Y) * g.cellSize},
+		Size: common.Vector2{X: g.cellSize, Y: g.cellSize},
+	}
+}
+
+// OccupiedCells appends the cells holding at least one entry to out and returns it
+func (g *Grid) OccupiedCells(out []Cell) []Cell {
+	for cell, entries := range g.cells {
+		if len(entries) > 0 {
+			out = append(out, cell)
+		}
+	}
+	return out
+}
+
+// LastQuery returns the center and radius of the most recent QueryRadius call
+func (g *Grid) LastQuery() (common.Vector2, float64, bool) {
+	return g.lastQueryCenter, g.lastQueryRadius, g.hasLastQuery
+}
+
+// Clear removes all entries while keeping the allocated cells for reuse
+func (g *Grid) Clear() {
+	for cell, entries := range g.cells {
+		g.cells[cell] = entries[:0]
+	}
+}
+
+// Insert adds an item at a world position
+func (g *Grid) Insert(id uint64, pos common.Vector2) {
+	cell := g.CellOf(pos)
+	g.cells[cell] = append(g.cells[cell], Entry{ID: id, Pos: pos})
+}
+
+// QueryRadius appends the entries within radius of center to out and returns it
+func (g *Grid) QueryRadius(center common.Vector2, radius float64, out []Entry) []Entry {
+	g.lastQueryCenter, g.lastQueryRadius, g.hasLastQuery = center, radius, true
+
+	minCell := g.CellOf(common.Vector2{X: center.X - radius, Y: center.Y - radius})
+	maxCell := g.CellOf(common.Vector2{X: center.X + radius, Y: center.Y + radius})
+	radiusSq := radius * radius
+
+	for y := minCell.Y; y <= maxCell.Y; y++ {
+		for x := minCell.X; x <= maxCell.X; x++ {
+			for _, entry := range g.cells[Cell{X: x, Y: y}] {
+				if entry.Pos.Sub(center).MagnitudeSquared() <= radiusSq {
+					out = append(out, entry)
+				}
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,81 @@
+package spatial
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"maps"
+	"novampires-go/internal/common"
+	"testing"
+)
+
+// highlightRenderer records the cells filled by the debug overlay
+type highlightRenderer struct {
+	g     *Grid
+	cells map[Cell]bool
+}
+
+func (r *highlightRenderer) DrawRect(screen *ebiten.Image, rect common.Rectangle, fill color.RGBA) {
+	r.cells[r.g.CellOf(rect.Center())] = true
+}
+
+func (r *highlightRenderer) DrawRectOutline(screen *ebiten.Image, rect common.Rectangle, lineWidth float64, stroke color.RGBA) {
+}
+
+func (r *highlightRenderer) DrawArc(screen *ebiten.Image, center common.Vector2, radius, startAngle, endAngle, lineWidth float64, stroke color.RGBA) {
+}
+
+func TestOccupiedCellsMatchEntities(t *testing.T) {
+	g := NewGrid(100)
+
+	// Last frame's positions leave cells behind when the grid is cleared
+	g.Insert(1, common.Vector2{X: 950, Y: 950})
+	g.Insert(2, common.Vector2{X: -750, Y: 20})
+	g.Clear()
+
+	positions := []common.Vector2{
+		{X: 10, Y: 10},
+		{X: 99, Y: 50},  // Same cell as the first
+		{X: 100, Y: 50}, // Edge of the next cell
+		{X: -1, Y: -1},  // Negative coordinates round down
+		{X: -250, Y: 420},
+	}
+	want := make(map[Cell]bool)
+	for i, pos := range positions {
+		g.Insert(uint64(i+1), pos)
+		want[g.CellOf(pos)] = true
+	}
+
+	if occupied := g.OccupiedCells(nil); len(occupied) != len(want) {
+		t.Errorf("%d occupied cells, want %d", len(occupied), len(want))
+	}
+
+	renderer := &highlightRenderer{g: g, cells: make(map[Cell]bool)}
+	g.DrawDebug(nil, renderer)
+	if !maps.Equal(renderer.cells, want) {
+		t.Errorf("highlighted cells %v, want %v", renderer.cells, want)
+	}
+}
+
+func TestCellOf(t *testing.T) {
+	g := NewGrid(100)
+
+	tests := []struct {
+		pos  common.Vector2
+		want Cell
+	}{
+		{common.Vector2{X: 0, Y: 0}, Cell{0, 0}},
+		{common.Vector2{X: 99.9, Y: 50}, Cell{0, 0}},
+		{common.Vector2{X: 100, Y: 250}, Cell{1, 2}},
+		{common.Vector2{X: -0.1, Y: -100}, Cell{-1, -1}},
+		{common.Vector2{X: -100.1, Y: 0}, Cell{-2, 0}},
+	}
+
+	for _, tt := range tests {
+		if got := g.CellOf(tt.pos); got != tt.want {
+			t.Errorf("CellOf(%v) = %v, want %v", tt.pos, got, tt.want)
+		}
+		if bounds := g.CellBounds(tt.want); !bounds.Contains(tt.pos) {
+			t.Errorf("CellBounds(%v) = %+v does not contain %v", tt.want, bounds, tt.pos)
+		}
+	}
+}
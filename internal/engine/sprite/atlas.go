@@ -0,0 +1,196 @@
+package sprite
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// DefaultAtlasFrameDuration is used for atlas frames without a duration, in milliseconds
+const DefaultAtlasFrameDuration = 100
+
+// atlasRect is a rectangle as written by atlas packers
+type atlasRect struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+// atlasFrame is a single frame entry in TexturePacker/Aseprite JSON
+type atlasFrame struct {
+	Filename         string    `json:"filename"` // Array format only
+	Frame            atlasRect `json:"frame"`
+	Rotated          bool      `json:"rotated"`
+	Trimmed          bool      `json:"trimmed"`
+	SpriteSourceSize atlasRect `json:"spriteSourceSize"`
+	SourceSize       atlasRect `json:"sourceSize"`
+	Duration         int       `json:"duration"`
+}
+
+// Atlas holds named frames of a packed sprite sheet whose frames may all
+// have different sizes
+type Atlas struct {
+	frames map[string]FrameData
+	names  []string
+}
+
+// LoadAtlas reads a packed atlas description from a JSON file
+func LoadAtlas(path string) (*Atlas, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read atlas: %w", err)
+	}
+	return ParseAtlas(data)
+}
+
+// ParseAtlas decodes a packed atlas in the TexturePacker JSON layout, with
+// frames either as an object keyed by name ("hash") or as an array with a
+// filename per frame. Trimmed frames get a draw offset that keeps them
+// where they were in the untrimmed image. Rotated frames are not supported.
+func ParseAtlas(data []byte) (*Atlas, error) {
+	var doc struct {
+		Frames json.RawMessage `json:"frames"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode atlas: %w", err)
+	}
+
+	var entries []atlasFrame
+	switch trimmed := bytes.TrimSpace(doc.Frames); {
+	case len(trimmed) == 0:
+		return nil, fmt.Errorf("decode atlas: no frames")
+	case trimmed[0] == '[':
+		if err := json.Unmarshal(trimmed, &entries); err != nil {
+			return nil, fmt.Errorf("decode atlas frames: %w", err)
+		}
+	default:
+		var byName map[string]atlasFrame
+		if err := json.Unmarshal(trimmed, &byName); err != nil {
+			return nil, fmt.Errorf("decode atlas frames: %w", err)
+		}
+		for name, entry := range byName {
+			entry.Filename = name
+			entries = append(entries, entry)
+		}
+	}
+
+	atlas := &Atlas{frames: make(map[string]FrameData, len(entries))}
+	for _, entry := range entries {
+		if entry.Rotated {
+			return nil, fmt.Errorf("atlas frame %q: rotated frames are not supported", entry.Filename)
+		}
+		if entry.Frame.W <= 0 || entry.Frame.H <= 0 {
+			return nil, fmt.Errorf("atlas frame %q: invalid size %dx%d", entry.Filename, entry.Frame.W, entry.Frame.H)
+		}
+		if _, ok := atlas.frames[entry.Filename]; ok {
+			return nil, fmt.Errorf("atlas frame %q: duplicate name", entry.Filename)
+		}
+
+		atlas.frames[entry.Filename] = entry.frameData()
+		atlas.names = append(atlas.names, entry.Filename)
+	}
+	slices.SortFunc(atlas.names, compareNatural)
+
+	return atlas, nil
+}
+
+// frameData converts an atlas entry to a sprite frame
+func (f atlasFrame) frameData() FrameData {
+	frame := FrameData{
+		SrcX:      f.Frame.X,
+		SrcY:      f.Frame.Y,
+		SrcWidth:  f.Frame.W,
+		SrcHeight: f.Frame.H,
+		Duration:  f.Duration,
+	}
+	if frame.Duration <= 0 {
+		frame.Duration = DefaultAtlasFrameDuration
+	}
+
+	// Sprites are drawn centered, so shift by how far the trimmed frame's
+	// center is from the original image's center
+	if f.Trimmed && f.SourceSize.W > 0 && f.SourceSize.H > 0 {
+		frame.OffsetX = (2*f.SpriteSourceSize.X + f.Frame.W - f.SourceSize.W) / 2
+		frame.OffsetY = (2*f.SpriteSourceSize.Y + f.Frame.H - f.SourceSize.H) / 2
+	}
+
+	return frame
+}
+
+// Frame returns a frame by name
+func (a *Atlas) Frame(name string) (FrameData, bool) {
+	frame, ok := a.frames[name]
+	return frame, ok
+}
+
+// Names returns all frame names in natural order ("walk_2" before "walk_10")
+func (a *Atlas) Names() []string {
+	return a.names
+}
+
+// FramesWithPrefix returns the frames whose name starts with prefix, in
+// natural name order
+func (a *Atlas) FramesWithPrefix(prefix string) []FrameData {
+	var frames []FrameData
+	for _, name := range a.names {
+		if strings.HasPrefix(name, prefix) {
+			frames = append(frames, a.frames[name])
+		}
+	}
+	return frames
+}
+
+// Animation creates an animation from the frames whose name starts with prefix
+func (a *Atlas) Animation(prefix string, loop bool) (*Animation, error) {
+	frames := a.FramesWithPrefix(prefix)
+	if len(frames) == 0 {
+		return nil, fmt.Errorf("sprite: no atlas frames start with %q", prefix)
+	}
+	return NewAnimation(frames, loop)
+}
+
+// compareNatural orders strings with runs of digits compared by value
+func compareNatural(a, b string) int {
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			na, restA := splitDigits(a)
+			nb, restB := splitDigits(b)
+
+			// Compare by length first so leading zeros don't matter
+			na = strings.TrimLeft(na, "0")
+			nb = strings.TrimLeft(nb, "0")
+			if len(na) != len(nb) {
+				return len(na) - len(nb)
+			}
+			if c := strings.Compare(na, nb); c != 0 {
+				return c
+			}
+			a, b = restA, restB
+			continue
+		}
+
+		if a[0] != b[0] {
+			return int(a[0]) - int(b[0])
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) - len(b)
+}
+
+// isDigit returns whether c is an ASCII digit
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// splitDigits splits the leading run of digits off s
+func splitDigits(s string) (string, string) {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i], s[i:]
+}
@@ -0,0 +1,86 @@
+package sprite
+
+import (
+	"slices"
+	"testing"
+)
+
+const hashAtlas = `{
+	"frames": {
+		"walk_10.png": {"frame": {"x": 40, "y": 0, "w": 20, "h": 30}, "duration": 80},
+		"walk_2.png": {"frame": {"x": 20, "y": 0, "w": 20, "h": 30}},
+		"idle.png": {
+			"frame": {"x": 0, "y": 0, "w": 10, "h": 20},
+			"trimmed": true,
+			"spriteSourceSize": {"x": 4, "y": 2, "w": 10, "h": 20},
+			"sourceSize": {"w": 32, "h": 32}
+		}
+	}
+}`
+
+const arrayAtlas = `{
+	"frames": [
+		{"filename": "a", "frame": {"x": 0, "y": 0, "w": 8, "h": 8}},
+		{"filename": "b", "frame": {"x": 8, "y": 0, "w": 16, "h": 8}}
+	]
+}`
+
+func TestParseAtlasHash(t *testing.T) {
+	atlas, err := ParseAtlas([]byte(hashAtlas))
+	if err != nil {
+		t.Fatalf("ParseAtlas: %v", err)
+	}
+
+	if want := []string{"idle.png", "walk_2.png", "walk_10.png"}; !slices.Equal(atlas.Names(), want) {
+		t.Errorf("Names() = %v, want natural order %v", atlas.Names(), want)
+	}
+
+	walk, _ := atlas.Frame("walk_2.png")
+	if walk.SrcX != 20 || walk.SrcWidth != 20 || walk.Duration != DefaultAtlasFrameDuration {
+		t.Errorf("walk_2 = %+v, want x 20, width 20 and the default duration", walk)
+	}
+	if frame, _ := atlas.Frame("walk_10.png"); frame.Duration != 80 {
+		t.Errorf("walk_10 duration = %d, want 80", frame.Duration)
+	}
+
+	// Trimmed 10x20 at (4, 2) in a 32x32 image: centers differ by (-7, -4)
+	idle, _ := atlas.Frame("idle.png")
+	if idle.OffsetX != -7 || idle.OffsetY != -4 {
+		t.Errorf("idle offset = (%d, %d), want (-7, -4)", idle.OffsetX, idle.OffsetY)
+	}
+
+	if frames := atlas.FramesWithPrefix("walk_"); len(frames) != 2 || frames[0].SrcX != 20 {
+		t.Errorf("FramesWithPrefix(walk_) = %+v, want walk_2 then walk_10", frames)
+	}
+}
+
+func TestParseAtlasArray(t *testing.T) {
+	atlas, err := ParseAtlas([]byte(arrayAtlas))
+	if err != nil {
+		t.Fatalf("ParseAtlas: %v", err)
+	}
+	if frame, ok := atlas.Frame("b"); !ok || frame.SrcX != 8 || frame.SrcWidth != 16 {
+		t.Errorf("Frame(b) = %+v, %v, want x 8 and width 16", frame, ok)
+	}
+}
+
+func TestParseAtlasErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"invalid json", `{"frames": `},
+		{"no frames", `{}`},
+		{"rotated", `{"frames": [{"filename": "a", "frame": {"w": 8, "h": 8}, "rotated": true}]}`},
+		{"empty frame", `{"frames": [{"filename": "a", "frame": {"w": 0, "h": 8}}]}`},
+		{"duplicate", `{"frames": [{"filename": "a", "frame": {"w": 8, "h": 8}}, {"filename": "a", "frame": {"w": 8, "h": 8}}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseAtlas([]byte(tt.data)); err == nil {
+				t.Error("ParseAtlas succeeded, want an error")
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ package sprite
 import (
 	"fmt"
 	imgui "github.com/gabstv/cimgui-go"
+	ebimgui "github.com/gabstv/ebiten-imgui/v3"
+	"github.com/hajimehoshi/ebiten/v2"
 	"novampires-go/internal/common"
 	"unsafe"
 )
@@ -30,9 +32,21 @@ type DebugWindow struct {
 	previewSize    float32
 	previewSizePtr unsafe.Pointer
 
+	// Copy of the current frame handed to ImGui. Sprite frames are sub-images
+	// of a sheet, which the ImGui renderer can't sample directly.
+	preview *ebiten.Image
+
+	// ImGui texture ID the preview is registered under. ImGui IDs are
+	// pointers, so it points at previewKey to stay unique per window.
+	previewKey byte
+	previewID  imgui.TextureID
+
 	// Selected animation
 	currentAnim string
 	animations  []string
+
+	// Frame the seek bar points at
+	seekFrame int32
 }
 
 // NewDebugWindow creates a new sprite debug window
@@ -53,6 +67,7 @@ func NewDebugWindow(controller interface{}) *DebugWindow {
 	w.scalePtr = unsafe.Pointer(&w.scale)
 	w.flipXPtr = unsafe.Pointer(&w.flipX)
 	w.previewSizePtr = unsafe.Pointer(&w.previewSize)
+	w.previewID = imgui.TextureID(unsafe.Pointer(&w.previewKey))
 
 	return w
 }
@@ -60,6 +75,7 @@ func NewDebugWindow(controller interface{}) *DebugWindow {
 // Draw renders the debug window
 func (w *DebugWindow) Draw() {
 	if !w.open {
+		w.releasePreview()
 		return
 	}
 
@@ -118,9 +134,7 @@ func (w *DebugWindow) Draw() {
 
 		imgui.SliderFloat("Preview Size", (*float32)(w.previewSizePtr), 64.0, 256.0)
 
-		// Preview animation
-		//previewPos := imgui.CursorPos()
-		imgui.InvisibleButton("preview", imgui.Vec2{X: w.previewSize, Y: w.previewSize})
+		w.drawPreview()
 
 		// Display current animation info
 		imgui.Separator()
@@ -132,6 +146,8 @@ func (w *DebugWindow) Draw() {
 			imgui.Text(fmt.Sprintf("Current Frame: %d", frame))
 		}
 
+		w.drawSeekBar()
+
 		// Display animation duration if controller has the method
 		if ctrl, ok := w.controller.(interface{ GetAnimationDuration() float32 }); ok {
 			duration := ctrl.GetAnimationDuration()
@@ -141,6 +157,105 @@ func (w *DebugWindow) Draw() {
 	imgui.End()
 }
 
+// drawPreview draws the controller's current frame fitted into a square of
+// previewSize, mirrored when flipX is set. The space is reserved even when
+// there is nothing to draw so the layout doesn't jump.
+func (w *DebugWindow) drawPreview() {
+	var frame *ebiten.Image
+	if ctrl, ok := w.controller.(interface{ GetSprite() *ebiten.Image }); ok {
+		frame = ctrl.GetSprite()
+	}
+
+	manager := ebimgui.GlobalManager()
+	if frame == nil || manager == nil {
+		imgui.InvisibleButton("preview", imgui.Vec2{X: w.previewSize, Y: w.previewSize})
+		return
+	}
+
+	bounds := frame.Bounds()
+	if w.preview == nil || w.preview.Bounds().Size() != bounds.Size() {
+		if w.preview != nil {
+			w.preview.Deallocate()
+		}
+		w.preview = ebiten.NewImage(bounds.Dx(), bounds.Dy())
+	}
+	w.preview.Clear()
+	w.preview.DrawImage(frame, nil)
+
+	size := previewRect(bounds.Dx(), bounds.Dy(), w.previewSize)
+	manager.Cache.SetTexture(w.previewID, w.preview)
+
+	uv0, uv1 := imgui.Vec2{X: 0, Y: 0}, imgui.Vec2{X: 1, Y: 1}
+	if w.flipX {
+		uv0.X, uv1.X = 1, 0
+	}
+	imgui.ImageV(w.previewID, size, uv0, uv1, imgui.Vec4{X: 1, Y: 1, Z: 1, W: 1}, imgui.Vec4{})
+}
+
+// releasePreview drops the preview texture from the ImGui cache and frees
+// its image. Called once the window is closed, including from its title bar.
+func (w *DebugWindow) releasePreview() {
+	if w.preview == nil {
+		return
+	}
+
+	if manager := ebimgui.GlobalManager(); manager != nil {
+		manager.Cache.RemoveTexture(w.previewID)
+	}
+	w.preview.Deallocate()
+	w.preview = nil
+}
+
+// previewRect returns the size of a width x height frame scaled to fit a
+// square of side size, keeping its aspect ratio
+func previewRect(width, height int, size float32) imgui.Vec2 {
+	if width <= 0 || height <= 0 {
+		return imgui.Vec2{X: size, Y: size}
+	}
+
+	scale := size / float32(max(width, height))
+	return imgui.Vec2{X: float32(width) * scale, Y: float32(height) * scale}
+}
+
+// seekController is implemented by controllers that can be scrubbed
+type seekController interface {
+	GetCurrentFrame() int
+	GetFrameCount() int
+	SetFrame(i int)
+	SetPaused(paused bool)
+	IsPaused() bool
+}
+
+// drawSeekBar draws a frame slider and a play/pause toggle. Dragging the
+// slider pauses playback on the chosen frame.
+func (w *DebugWindow) drawSeekBar() {
+	ctrl, ok := w.controller.(seekController)
+	if !ok {
+		return
+	}
+
+	count := ctrl.GetFrameCount()
+	if count == 0 {
+		return
+	}
+
+	paused := ctrl.IsPaused()
+	label := "Pause"
+	if paused {
+		label = "Play"
+	}
+	if imgui.Button(label) {
+		ctrl.SetPaused(!paused)
+	}
+	imgui.SameLine()
+
+	w.seekFrame = int32(ctrl.GetCurrentFrame())
+	if imgui.SliderInt("Frame", &w.seekFrame, 0, int32(count-1)) {
+		ctrl.SetPaused(true)
+		ctrl.SetFrame(int(w.seekFrame))
+	}
+}
+
 // Name returns the window name for the debug manager
 func (w *DebugWindow) Name() string {
 	return common.WindowSpriteDebug
@@ -154,11 +269,15 @@ func (w *DebugWindow) IsOpen() bool {
 // Toggle toggles the window's visibility
 func (w *DebugWindow) Toggle() {
 	w.open = !w.open
+	if !w.open {
+		w.releasePreview()
+	}
 }
 
 // Close closes the window
 func (w *DebugWindow) Close() {
 	w.open = false
+	w.releasePreview()
 }
 
 func (a *Animation) CreateDebugWindow() *DebugWindow {
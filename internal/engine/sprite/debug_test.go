@@ -0,0 +1,60 @@
+package sprite
+
+import (
+	"testing"
+
+	imgui "github.com/gabstv/cimgui-go"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestPreviewRect(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		size          float32
+		want          imgui.Vec2
+	}{
+		{"square", 24, 24, 128, imgui.Vec2{X: 128, Y: 128}},
+		{"wide", 32, 16, 128, imgui.Vec2{X: 128, Y: 64}},
+		{"tall", 16, 64, 256, imgui.Vec2{X: 64, Y: 256}},
+		{"larger than preview", 512, 256, 64, imgui.Vec2{X: 64, Y: 32}},
+		{"empty frame", 0, 0, 96, imgui.Vec2{X: 96, Y: 96}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := previewRect(tt.width, tt.height, tt.size); got != tt.want {
+				t.Errorf("previewRect(%d, %d, %v) = %v, want %v", tt.width, tt.height, tt.size, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDebugWindowPreviewIDsAreUnique(t *testing.T) {
+	a, b := NewDebugWindow(nil), NewDebugWindow(nil)
+	if a.previewID == nil || a.previewID == b.previewID {
+		t.Errorf("preview IDs %v and %v should be distinct and non-nil", a.previewID, b.previewID)
+	}
+}
+
+func TestDebugWindowReleasesPreviewOnClose(t *testing.T) {
+	tests := []struct {
+		name  string
+		close func(w *DebugWindow)
+	}{
+		{"close", func(w *DebugWindow) { w.Close() }},
+		{"toggle", func(w *DebugWindow) { w.Toggle() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewDebugWindow(nil)
+			w.preview = ebiten.NewImage(8, 8)
+
+			tt.close(w)
+			if w.preview != nil {
+				t.Error("preview image should be released when the window closes")
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package sprite
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// SliceConfig controls how SliceFrames finds frames on a sheet without metadata
+type SliceConfig struct {
+	// Pixels with alpha above this count as part of a frame
+	AlphaThreshold uint8
+
+	// Regions smaller than this in either direction are dropped as noise
+	MinWidth, MinHeight int
+
+	// Duration given to every detected frame, in milliseconds
+	Duration int
+}
+
+// DefaultSliceConfig returns settings that treat any visible pixel as opaque
+func DefaultSliceConfig() SliceConfig {
+	return SliceConfig{
+		AlphaThreshold: 0,
+		MinWidth:       2,
+		MinHeight:      2,
+		Duration:       DefaultAtlasFrameDuration,
+	}
+}
+
+// SliceFrames guesses the frames of a sprite sheet by splitting it along
+// rows and columns that are fully transparent. Frames come back in reading
+// order, trimmed to their opaque pixels. Frames that touch without a
+// transparent gutter between them are returned as one.
+func SliceFrames(img *ebiten.Image, config SliceConfig) []FrameData {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+
+	pixels := make([]byte, 4*width*height)
+	img.ReadPixels(pixels)
+
+	alpha := make([]uint8, width*height)
+	for i := range alpha {
+		alpha[i] = pixels[4*i+3]
+	}
+
+	// Sub images keep their parent's coordinates, frames must too
+	frames := SliceAlpha(alpha, width, height, config)
+	for i := range frames {
+		frames[i].SrcX += bounds.Min.X
+		frames[i].SrcY += bounds.Min.Y
+	}
+	return frames
+}
+
+// SliceAlpha is SliceFrames on a row-major alpha mask of the given size
+func SliceAlpha(alpha []uint8, width, height int, config SliceConfig) []FrameData {
+	opaque := func(x, y int) bool {
+		return alpha[y*width+x] > config.AlphaThreshold
+	}
+
+	var frames []FrameData
+	for _, rows := range opaqueRuns(height, func(y int) bool {
+		for x := range width {
+			if opaque(x, y) {
+				return true
+			}
+		}
+		return false
+	}) {
+		for _, cols := range opaqueRuns(width, func(x int) bool {
+			for y := rows[0]; y < rows[1]; y++ {
+				if opaque(x, y) {
+					return true
+				}
+			}
+			return false
+		}) {
+			// Trim rows the frame leaves empty within its band
+			top, bottom := rows[0], rows[1]
+			for top < bottom && !anyOpaque(cols, top, opaque) {
+				top++
+			}
+			for bottom > top && !anyOpaque(cols, bottom-1, opaque) {
+				bottom--
+			}
+
+			w, h := cols[1]-cols[0], bottom-top
+			if w < config.MinWidth || h < config.MinHeight {
+				continue
+			}
+			frames = append(frames, FrameData{
+				SrcX:      cols[0],
+				SrcY:      top,
+				SrcWidth:  w,
+				SrcHeight: h,
+				Duration:  config.Duration,
+			})
+		}
+	}
+	return frames
+}
+
+// opaqueRuns returns the [start, end) runs of consecutive indices below n
+// for which filled is true
+func opaqueRuns(n int, filled func(int) bool) [][2]int {
+	var runs [][2]int
+	start := -1
+	for i := range n {
+		on := filled(i)
+		switch {
+		case on && start < 0:
+			start = i
+		case !on && start >= 0:
+			runs = append(runs, [2]int{start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		runs = append(runs, [2]int{start, n})
+	}
+	return runs
+}
+
+// anyOpaque returns whether row y has an opaque pixel within cols
+func anyOpaque(cols [2]int, y int, opaque func(x, y int) bool) bool {
+	for x := cols[0]; x < cols[1]; x++ {
+		if opaque(x, y) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package sprite
+
+import (
+	"testing"
+)
+
+// mask builds an alpha mask from rows where '#' is opaque
+func mask(rows ...string) ([]uint8, int, int) {
+	width, height := len(rows[0]), len(rows)
+	alpha := make([]uint8, width*height)
+	for y, row := range rows {
+		for x, c := range row {
+			if c == '#' {
+				alpha[y*width+x] = 255
+			}
+		}
+	}
+	return alpha, width, height
+}
+
+func TestSliceAlpha(t *testing.T) {
+	alpha, width, height := mask(
+		"..........",
+		".##...###.",
+		".##....##.",
+		"..........",
+		"...##.....",
+		"...##...#.",
+	)
+
+	config := DefaultSliceConfig()
+	frames := SliceAlpha(alpha, width, height, config)
+
+	want := []FrameData{
+		{SrcX: 1, SrcY: 1, SrcWidth: 2, SrcHeight: 2, Duration: config.Duration},
+		{SrcX: 6, SrcY: 1, SrcWidth: 3, SrcHeight: 2, Duration: config.Duration},
+		{SrcX: 3, SrcY: 4, SrcWidth: 2, SrcHeight: 2, Duration: config.Duration},
+	}
+	if len(frames) != len(want) {
+		t.Fatalf("got %d frames %v, want %d", len(frames), frames, len(want))
+	}
+	for i := range want {
+		if frames[i] != want[i] {
+			t.Errorf("frame %d = %+v, want %+v", i, frames[i], want[i])
+		}
+	}
+}
+
+func TestSliceAlphaThreshold(t *testing.T) {
+	alpha := []uint8{
+		0, 0, 0, 0,
+		0, 40, 200, 0,
+		0, 40, 200, 0,
+		0, 0, 0, 0,
+	}
+
+	config := DefaultSliceConfig()
+	config.AlphaThreshold = 100
+	config.MinWidth = 1
+	frames := SliceAlpha(alpha, 4, 4, config)
+
+	if len(frames) != 1 {
+		t.Fatalf("got %d frames, want 1", len(frames))
+	}
+	if got := frames[0]; got.SrcX != 2 || got.SrcWidth != 1 || got.SrcHeight != 2 {
+		t.Errorf("frame = %+v, want the column above the threshold", got)
+	}
+}
@@ -1,6 +1,10 @@
 package sprite
 
 import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
 	"sync"
 	"time"
 )
@@ -12,6 +16,9 @@ type FrameData struct {
 
 	// Duration in milliseconds
 	Duration int
+
+	// Optional draw offset in source pixels, e.g. to lunge during an attack
+	OffsetX, OffsetY int
 }
 
 // Animation represents a sequence of frames
@@ -22,43 +29,89 @@ type Animation struct {
 	// Whether the animation should loop
 	Loop bool
 
+	// Playback rate multiplier (1 = authored frame durations)
+	speed float64
+
+	// OnFinish is called once when a non-looping animation reaches its end
+	OnFinish func()
+
 	// Current state
 	currentFrame int
 	elapsed      time.Duration
 	reversed     bool
 	finished     bool
+	paused       bool
 
 	// Mutex for concurrent access
 	mu sync.RWMutex
 }
 
 // NewAnimation creates a new animation with the given frames
-func NewAnimation(frames []FrameData, loop bool) *Animation {
-	// Safety check - don't create animations with no frames
+func NewAnimation(frames []FrameData, loop bool) (*Animation, error) {
 	if len(frames) == 0 {
-		return nil
+		return nil, errors.New("sprite: animation needs at least one frame")
 	}
 
+	for i, frame := range frames {
+		if frame.SrcWidth <= 0 || frame.SrcHeight <= 0 {
+			return nil, fmt.Errorf("sprite: frame %d has invalid size %dx%d", i, frame.SrcWidth, frame.SrcHeight)
+		}
+	}
+
+	frames = clampFrameDurations(frames)
+
 	return &Animation{
 		Frames:       frames,
 		Loop:         loop,
+		speed:        1.0,
 		currentFrame: 0,
 		elapsed:      0,
 		reversed:     false,
 		finished:     false,
+	}, nil
+}
+
+// MinFrameDuration is the shortest frame duration in milliseconds
+const MinFrameDuration = 1
+
+// clampFrameDurations raises non-positive frame durations to MinFrameDuration.
+// The input slice is copied rather than modified if any frame needs clamping.
+func clampFrameDurations(frames []FrameData) []FrameData {
+	clamped := frames
+	copied := false
+	for i, frame := range frames {
+		if frame.Duration >= MinFrameDuration {
+			continue
+		}
+
+		if !copied {
+			clamped = slices.Clone(frames)
+			copied = true
+		}
+		log.Printf("sprite: frame %d has duration %dms, clamping to %dms", i, frame.Duration, MinFrameDuration)
+		clamped[i].Duration = MinFrameDuration
 	}
+	return clamped
 }
 
-// CreateAnimationFromStrip creates a single row animation with safety checks
+// MustAnimation wraps an animation constructor call and panics on error.
+// It is meant for animations built from literal frame data.
+func MustAnimation(anim *Animation, err error) *Animation {
+	if err != nil {
+		panic(err)
+	}
+	return anim
+}
+
+// CreateAnimationFromStrip creates a single row animation
 func CreateAnimationFromStrip(
 	frameWidth, frameHeight int,
 	startX, startY int,
 	frameCount, frameDuration int,
 	loop bool,
-) *Animation {
-	// Safety checks
-	if frameCount <= 0 || frameWidth <= 0 || frameHeight <= 0 || frameDuration <= 0 {
-		return nil
+) (*Animation, error) {
+	if frameCount <= 0 {
+		return nil, fmt.Errorf("sprite: strip needs at least one frame, got %d", frameCount)
 	}
 
 	frames := make([]FrameData, frameCount)
@@ -76,22 +129,23 @@ func CreateAnimationFromStrip(
 	return NewAnimation(frames, loop)
 }
 
-func NewAnimationWithSequence(baseFrames []FrameData, sequence []int, loop bool) *Animation {
-	// Safety check - don't create animations with no frames or sequence
-	if len(baseFrames) == 0 || len(sequence) == 0 {
-		return nil
+// NewAnimationWithSequence creates an animation that plays baseFrames in the
+// order given by sequence, which holds indices into baseFrames
+func NewAnimationWithSequence(baseFrames []FrameData, sequence []int, loop bool) (*Animation, error) {
+	if len(baseFrames) == 0 {
+		return nil, errors.New("sprite: sequence needs at least one base frame")
+	}
+	if len(sequence) == 0 {
+		return nil, errors.New("sprite: sequence is empty")
 	}
 
 	// Create a new array of frames based on the sequence
 	sequencedFrames := make([]FrameData, len(sequence))
 	for i, idx := range sequence {
-		// Make sure we don't access an index out of bounds
-		if idx >= 0 && idx < len(baseFrames) {
-			sequencedFrames[i] = baseFrames[idx]
-		} else {
-			// If index is invalid, use the first frame as a fallback
-			sequencedFrames[i] = baseFrames[0]
+		if idx < 0 || idx >= len(baseFrames) {
+			return nil, fmt.Errorf("sprite: sequence step %d references frame %d, only %d frames available", i, idx, len(baseFrames))
 		}
+		sequencedFrames[i] = baseFrames[idx]
 	}
 
 	// Create a new animation with the sequenced frames
@@ -101,13 +155,25 @@ func NewAnimationWithSequence(baseFrames []FrameData, sequence []int, loop bool)
 // Update advances the animation based on elapsed time
 func (a *Animation) Update(dt time.Duration) {
 	a.mu.Lock()
-	defer a.mu.Unlock()
-
-	if a.finished {
+	if a.finished || a.paused {
+		a.mu.Unlock()
 		return
 	}
 
-	a.elapsed += dt
+	a.advance(dt)
+	finished := a.finished
+	onFinish := a.OnFinish
+	a.mu.Unlock()
+
+	// Called without the lock so the callback can restart or swap animations
+	if finished && onFinish != nil {
+		onFinish()
+	}
+}
+
+// advance moves the animation forward by dt. The lock must be held.
+func (a *Animation) advance(dt time.Duration) {
+	a.elapsed += time.Duration(float64(dt) * a.speed)
 
 	frameDuration := time.Duration(a.Frames[a.currentFrame].Duration) * time.Millisecond
 	if a.elapsed >= frameDuration {
@@ -175,6 +241,55 @@ func (a *Animation) IsReversed() bool {
 	return a.reversed
 }
 
+// SetFrame jumps to frame i, clamped to the valid frame indices, and
+// restarts that frame's timer
+func (a *Animation) SetFrame(i int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.currentFrame = max(0, min(i, len(a.Frames)-1))
+	a.elapsed = 0
+	a.finished = false
+}
+
+// FrameCount returns the number of frames in the animation
+func (a *Animation) FrameCount() int {
+	return len(a.Frames)
+}
+
+// SetPaused stops or resumes playback. A paused animation ignores Update.
+func (a *Animation) SetPaused(paused bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.paused = paused
+}
+
+// IsPaused returns whether playback is paused
+func (a *Animation) IsPaused() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.paused
+}
+
+// SetSpeed sets the playback rate multiplier (2 plays twice as fast).
+// Negative speeds are treated as 0.
+func (a *Animation) SetSpeed(speed float64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.speed = max(speed, 0)
+}
+
+// GetSpeed returns the playback rate multiplier
+func (a *Animation) GetSpeed() float64 {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.speed
+}
+
 func (a *Animation) SetDuration() {
 	
 }
\ No newline at end of file
@@ -0,0 +1,130 @@
+package sprite
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetFrameClamps(t *testing.T) {
+	frames := []FrameData{
+		{SrcWidth: 8, SrcHeight: 8, Duration: 100},
+		{SrcX: 8, SrcWidth: 8, SrcHeight: 8, Duration: 100},
+		{SrcX: 16, SrcWidth: 8, SrcHeight: 8, Duration: 100},
+	}
+
+	tests := []struct {
+		name  string
+		frame int
+		want  int
+	}{
+		{"first", 0, 0},
+		{"middle", 1, 1},
+		{"last", len(frames) - 1, len(frames) - 1},
+		{"negative", -1, 0},
+		{"one past the end", len(frames), len(frames) - 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anim := MustAnimation(NewAnimation(frames, false))
+
+			// Finish first so SetFrame also has to restart playback
+			anim.Update(time.Second)
+			anim.SetFrame(tt.frame)
+
+			if got := anim.GetCurrentFrameInt(); got != tt.want {
+				t.Errorf("frame = %d, want %d", got, tt.want)
+			}
+			if anim.IsFinished() {
+				t.Error("still finished after SetFrame")
+			}
+		})
+	}
+}
+
+func TestAnimationConstructorsRejectBadFrames(t *testing.T) {
+	frame := FrameData{SrcWidth: 8, SrcHeight: 8, Duration: 100}
+
+	tests := []struct {
+		name    string
+		build   func() (*Animation, error)
+		wantErr string
+	}{
+		{
+			name:    "empty frames",
+			build:   func() (*Animation, error) { return NewAnimation(nil, true) },
+			wantErr: "sprite: animation needs at least one frame",
+		},
+		{
+			name:    "zero sized frame",
+			build:   func() (*Animation, error) { return NewAnimation([]FrameData{frame, {SrcWidth: 8}}, true) },
+			wantErr: "sprite: frame 1 has invalid size 8x0",
+		},
+		{
+			name:    "empty strip",
+			build:   func() (*Animation, error) { return CreateAnimationFromStrip(8, 8, 0, 0, 0, 100, true) },
+			wantErr: "sprite: strip needs at least one frame, got 0",
+		},
+		{
+			name:    "empty sequence",
+			build:   func() (*Animation, error) { return NewAnimationWithSequence([]FrameData{frame}, nil, true) },
+			wantErr: "sprite: sequence is empty",
+		},
+		{
+			name:    "sequence out of range",
+			build:   func() (*Animation, error) { return NewAnimationWithSequence([]FrameData{frame}, []int{0, 2}, true) },
+			wantErr: "sprite: sequence step 1 references frame 2, only 1 frames available",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anim, err := tt.build()
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("error = %q, want %q", err, tt.wantErr)
+			}
+			if anim != nil {
+				t.Errorf("got an animation alongside the error")
+			}
+		})
+	}
+}
+
+func TestMustAnimationPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustAnimation did not panic on an empty frame slice")
+		}
+	}()
+	MustAnimation(NewAnimation(nil, true))
+}
+
+func TestNonPositiveDurationsAreClamped(t *testing.T) {
+	frames := []FrameData{
+		{SrcWidth: 8, SrcHeight: 8, Duration: 0},
+		{SrcX: 8, SrcWidth: 8, SrcHeight: 8, Duration: -5},
+		{SrcX: 16, SrcWidth: 8, SrcHeight: 8, Duration: 100},
+	}
+
+	anim := MustAnimation(NewAnimation(frames, true))
+
+	for i, want := range []int{MinFrameDuration, MinFrameDuration, 100} {
+		if got := anim.Frames[i].Duration; got != want {
+			t.Errorf("frame %d duration = %d, want %d", i, got, want)
+		}
+	}
+	if frames[0].Duration != 0 || frames[1].Duration != -5 {
+		t.Error("constructor modified the caller's frames")
+	}
+
+	// A long update still advances one frame at a time
+	for want := 1; want <= 3; want++ {
+		anim.Update(time.Second)
+		if got := anim.GetCurrentFrameInt(); got != want%len(frames) {
+			t.Fatalf("after update %d frame = %d, want %d", want, got, want%len(frames))
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package sprite
+
+import (
+	"novampires-go/internal/common"
+	"time"
+)
+
+// IntervalTrigger fires a callback repeatedly after a randomized delay of
+// Min plus up to Spread. It drives periodic effects such as blinks, idle
+// fidgets and flicker.
+type IntervalTrigger struct {
+	// Shortest delay between firings
+	Min time.Duration
+
+	// Largest random delay added on top of Min
+	Spread time.Duration
+
+	onFire  func()
+	rng     *common.Rng
+	elapsed time.Duration
+	next    time.Duration
+}
+
+// NewIntervalTrigger creates a trigger that calls onFire every Min to
+// Min+Spread, using rng to pick each delay
+func NewIntervalTrigger(min, spread time.Duration, rng *common.Rng, onFire func()) *IntervalTrigger {
+	t := &IntervalTrigger{
+		Min:    min,
+		Spread: spread,
+		onFire: onFire,
+		rng:    rng,
+	}
+	t.Reset()
+	return t
+}
+
+// Update advances the trigger and fires the callback when the delay runs out
+func (t *IntervalTrigger) Update(dt time.Duration) {
+	t.elapsed += dt
+	if t.elapsed < t.next {
+		return
+	}
+
+	t.Reset()
+	if t.onFire != nil {
+		t.onFire()
+	}
+}
+
+// Reset restarts the wait with a newly rolled delay
+func (t *IntervalTrigger) Reset() {
+	t.elapsed = 0
+	t.next = t.Min
+	if t.Spread > 0 {
+		t.next = t.rng.Duration(t.Min, t.Min+t.Spread)
+	}
+}
+
+// SetRng replaces the random source and rolls a new delay
+func (t *IntervalTrigger) SetRng(rng *common.Rng) {
+	t.rng = rng
+	t.Reset()
+}
+
+// Remaining returns the time left until the trigger next fires
+func (t *IntervalTrigger) Remaining() time.Duration {
+	return t.next - t.elapsed
+}
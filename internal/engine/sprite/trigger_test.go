@@ -0,0 +1,59 @@
+package sprite
+
+import (
+	"novampires-go/internal/common"
+	"testing"
+	"time"
+)
+
+func TestIntervalTriggerFiresWithinBounds(t *testing.T) {
+	tests := []struct {
+		name   string
+		min    time.Duration
+		spread time.Duration
+	}{
+		{"fixed interval", 500 * time.Millisecond, 0},
+		{"randomized interval", 2 * time.Second, 3 * time.Second},
+	}
+
+	const step = 10 * time.Millisecond
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sinceFire time.Duration
+			fired := 0
+			trigger := NewIntervalTrigger(tt.min, tt.spread, common.NewRng(7), func() {
+				if sinceFire < tt.min || sinceFire > tt.min+tt.spread+step {
+					t.Errorf("fired after %v, want between %v and %v", sinceFire, tt.min, tt.min+tt.spread)
+				}
+				sinceFire = 0
+				fired++
+			})
+
+			for i := 0; i < 10000; i++ {
+				sinceFire += step
+				trigger.Update(step)
+			}
+
+			if fired == 0 {
+				t.Fatal("trigger never fired")
+			}
+			if maxFires := int(10000 * step / tt.min); fired > maxFires {
+				t.Errorf("fired %d times, want at most %d", fired, maxFires)
+			}
+		})
+	}
+}
+
+func TestIntervalTriggerRemaining(t *testing.T) {
+	trigger := NewIntervalTrigger(time.Second, 0, common.NewRng(1), nil)
+	trigger.Update(300 * time.Millisecond)
+	if got, want := trigger.Remaining(), 700*time.Millisecond; got != want {
+		t.Errorf("Remaining() = %v, want %v", got, want)
+	}
+
+	trigger.Update(700 * time.Millisecond)
+	if got, want := trigger.Remaining(), time.Second; got != want {
+		t.Errorf("Remaining() after firing = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,288 @@
+package boss
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"math"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/events"
+	"novampires-go/internal/engine/rendering"
+	"time"
+)
+
+// AttackPattern is how the boss moves and attacks during a phase
+type AttackPattern int
+
+const (
+	// PatternOrbit circles the boss's spawn point
+	PatternOrbit AttackPattern = iota
+	// PatternChase walks straight at the target
+	PatternChase
+	// PatternDash alternates fast charges at the target with short pauses
+	PatternDash
+)
+
+func (p AttackPattern) String() string {
+	switch p {
+	case PatternOrbit:
+		return "Orbit"
+	case PatternChase:
+		return "Chase"
+	case PatternDash:
+		return "Dash"
+	default:
+		return "Unknown"
+	}
+}
+
+// Config contains boss tuning parameters
+type Config struct {
+	MaxHealth int
+	Radius    float64
+	Speed     float64 // World units per second
+
+	// Damage dealt to the target by touching it, in every phase
+	ContactDamage int
+
+	// Health fractions at which the boss enters its next phase, highest first
+	Thresholds []float64
+
+	// Attack pattern for each phase, one more than there are thresholds
+	Patterns []AttackPattern
+
+	OrbitRadius  float64       // Distance from the spawn point in PatternOrbit
+	DashSpeed    float64       // Speed multiplier while dashing
+	DashDuration time.Duration // Length of each charge and each pause in PatternDash
+
+	Color     color.RGBA
+	HealthBar entity.HealthBarStyle
+}
+
+// DefaultConfig returns default boss configuration
+func DefaultConfig() Config {
+	return Config{
+		MaxHealth:    3000,
+		Radius:       48.0,
+		Speed:        90.0,
+		Thresholds:   []float64{0.66, 0.33},
+		Patterns:     []AttackPattern{PatternOrbit, PatternChase, PatternDash},
+		OrbitRadius:  120.0,
+		DashSpeed:    3.0,
+		DashDuration: 600 * time.Millisecond,
+		Color:        rendering.DefaultColorPalette().EnemyBoss,
+		HealthBar: entity.HealthBarStyle{
+			Height:   8.0,
+			Offset:   10.0,
+			MinWidth: 120.0,
+			Frame: rendering.HealthBarFrame{
+				Border:      2.0,
+				BorderColor: color.RGBA{20, 10, 10, 230},
+				Rounded:     true,
+			},
+		},
+
+		ContactDamage: 25,
+	}
+}
+
+// Boss is a large enemy that changes attack pattern as its health drops
+type Boss struct {
+	*entity.Entity
+
+	config Config
+	ai     *bossAI
+	events *events.Bus
+
+	// Index of the current phase, 0 at full health
+	phase int
+
+	// Set once the death event has been published
+	defeated bool
+}
+
+// NewBoss creates a boss at full health. Phase changes and its death are
+// published on bus, which may be nil.
+func NewBoss(id uint64, pos common.Vector2, config Config, bus *events.Bus) *Boss {
+	b := &Boss{
+		Entity: entity.NewEntity(id, pos),
+		config: config,
+		events: bus,
+	}
+	b.SetHealth(entity.NewHealthComponent(config.MaxHealth))
+	b.SetCollision(entity.NewCollisionComponent(config.Radius))
+
+	b.ai = &bossAI{boss: b, anchor: pos}
+	b.SetInput(b.ai)
+	return b
+}
+
+// Hit applies damage, advancing the phase for every threshold crossed.
+// It returns the health actually removed.
+func (b *Boss) Hit(amount int, dtype entity.DamageType) int {
+	health := b.GetHealth()
+	damage := health.TakeDamage(amount, dtype)
+
+	for b.phase < len(b.config.Thresholds) && health.Percent() <= b.config.Thresholds[b.phase] {
+		b.phase++
+		b.ai.enterPhase()
+		b.publish(events.BossPhaseChanged{
+			BossID:   b.ID,
+			Phase:    b.phase,
+			Position: b.Position,
+		})
+	}
+
+	if health.IsDead() && !b.defeated {
+		b.defeated = true
+		b.publish(events.EnemyKilled{EnemyID: b.ID, Position: b.Position})
+	}
+
+	return damage
+}
+
+// publish sends an event if an event bus is attached
+func (b *Boss) publish(event events.Event) {
+	if b.events != nil {
+		b.events.Publish(event)
+	}
+}
+
+// GetPhase returns the current phase, 0 at full health
+func (b *Boss) GetPhase() int {
+	return b.phase
+}
+
+// GetPattern returns the attack pattern of the current phase
+func (b *Boss) GetPattern() AttackPattern {
+	if len(b.config.Patterns) == 0 {
+		return PatternOrbit
+	}
+	return b.config.Patterns[min(b.phase, len(b.config.Patterns)-1)]
+}
+
+// Touches returns whether a circle at pos overlaps the living boss
+func (b *Boss) Touches(pos common.Vector2, radius float64) bool {
+	return !b.defeated && pos.Sub(b.Position).Magnitude() <= b.config.Radius+radius
+}
+
+// GetContactDamage returns the damage the boss deals by touching its target
+func (b *Boss) GetContactDamage() int {
+	return b.config.ContactDamage
+}
+
+// SetTarget sets the position the boss attacks, usually the player's
+func (b *Boss) SetTarget(target *common.Vector2) {
+	b.ai.target = target
+}
+
+// SetColor changes the color the boss is drawn with
+func (b *Boss) SetColor(col color.RGBA) {
+	b.config.Color = col
+}
+
+// IsDefeated returns whether the boss has run out of health
+func (b *Boss) IsDefeated() bool {
+	return b.defeated
+}
+
+// Update runs the boss's AI and moves it
+func (b *Boss) Update(dt time.Duration) {
+	if b.defeated {
+		b.SetVelocity(common.Vector2{})
+		return
+	}
+	b.Entity.Update(dt)
+}
+
+// Draw draws the boss and its health bar
+func (b *Boss) Draw(screen *ebiten.Image, renderer entity.Renderer) {
+	renderer.DrawCircle(screen, b.Position, b.config.Radius, b.config.Color)
+	entity.DrawEntityHealthBar(screen, b.Entity, renderer, b.config.HealthBar)
+}
+
+// bossAI steers the boss according to the current phase's attack pattern
+type bossAI struct {
+	boss   *Boss
+	target *common.Vector2
+
+	// Spawn point orbited in PatternOrbit
+	anchor common.Vector2
+
+	// Time spent in the current phase
+	elapsed time.Duration
+
+	// Direction of the current dash
+	dashDirection common.Vector2
+}
+
+// enterPhase restarts the pattern timing when the phase changes
+func (a *bossAI) enterPhase() {
+	a.elapsed = 0
+	a.dashDirection = common.Vector2{}
+}
+
+// ProcessInput sets the boss's velocity for this frame
+func (a *bossAI) ProcessInput(e *entity.Entity, dt time.Duration) {
+	a.elapsed += dt
+	speed := a.boss.config.Speed
+
+	switch a.boss.GetPattern() {
+	case PatternOrbit:
+		// Angular speed that moves along the orbit at the boss's speed
+		radius := max(a.boss.config.OrbitRadius, 1)
+		angle := a.elapsed.Seconds() * speed / radius
+		goal := a.anchor.Add(common.Vector2{X: math.Cos(angle) * radius, Y: math.Sin(angle) * radius})
+		e.SetVelocity(a.seek(e.Position, goal, speed))
+	case PatternChase:
+		if a.target != nil {
+			e.SetVelocity(a.seek(e.Position, *a.target, speed))
+		}
+	case PatternDash:
+		e.SetVelocity(a.dash(e.Position, speed))
+	}
+}
+
+// dash returns the velocity for PatternDash: charge for one DashDuration in
+// the direction of the target at its start, then rest for one
+func (a *bossAI) dash(pos common.Vector2, speed float64) common.Vector2 {
+	period := a.boss.config.DashDuration
+	if period <= 0 || a.target == nil {
+		return common.Vector2{}
+	}
+
+	if (a.elapsed/period)%2 == 1 {
+		// Resting, aim the next charge
+		a.dashDirection = common.Vector2{}
+		return common.Vector2{}
+	}
+
+	if a.dashDirection == (common.Vector2{}) {
+		if offset := a.target.Sub(pos); offset.MagnitudeSquared() > 0 {
+			a.dashDirection = offset.Normalized()
+		}
+	}
+	return a.dashDirection.Scale(speed * a.boss.config.DashSpeed)
+}
+
+// seek returns a velocity toward goal, stopping once it is reached
+func (a *bossAI) seek(pos, goal common.Vector2, speed float64) common.Vector2 {
+	offset := goal.Sub(pos)
+	distance := offset.Magnitude()
+	if distance < 1 {
+		return common.Vector2{}
+	}
+	return offset.Scale(speed / distance)
+}
+
+// GetAimDirection returns the direction toward the target
+func (a *bossAI) GetAimDirection() common.Vector2 {
+	if a.target == nil {
+		return common.Vector2{}
+	}
+	offset := a.target.Sub(a.boss.Position)
+	if offset.MagnitudeSquared() == 0 {
+		return common.Vector2{}
+	}
+	return offset.Normalized()
+}
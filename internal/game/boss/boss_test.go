@@ -0,0 +1,84 @@
+package boss
+
+import (
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/events"
+	"slices"
+	"testing"
+)
+
+// newTestBoss creates a 300 health boss with phases at 66% and 33% and
+// records the phases it publishes
+func newTestBoss() (*Boss, *[]int) {
+	config := DefaultConfig()
+	config.MaxHealth = 300
+
+	bus := events.NewBus()
+	phases := &[]int{}
+	bus.Subscribe(events.TypeBossPhaseChanged, func(e events.Event) {
+		*phases = append(*phases, e.(events.BossPhaseChanged).Phase)
+	})
+	return NewBoss(1, common.Vector2{}, config, bus), phases
+}
+
+func TestHitAdvancesPhaseOncePerThreshold(t *testing.T) {
+	tests := []struct {
+		name       string
+		hits       []int
+		wantPhase  int
+		wantEvents []int
+	}{
+		{"above first threshold", []int{50, 50}, 0, nil},
+		{"crossing first threshold", []int{110}, 1, []int{1}},
+		{"hits within a phase", []int{110, 10, 10, 10}, 1, []int{1}},
+		{"crossing each threshold in turn", []int{110, 50, 50, 10}, 2, []int{1, 2}},
+		{"one hit crossing both", []int{250}, 2, []int{1, 2}},
+		{"hits after the last phase", []int{250, 10, 10}, 2, []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, phases := newTestBoss()
+			for _, amount := range tt.hits {
+				b.Hit(amount, entity.DamageTrue)
+			}
+
+			if got := b.GetPhase(); got != tt.wantPhase {
+				t.Errorf("phase = %d, want %d", got, tt.wantPhase)
+			}
+			if !slices.Equal(*phases, tt.wantEvents) {
+				t.Errorf("published phases %v, want %v", *phases, tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestPatternFollowsPhase(t *testing.T) {
+	b, _ := newTestBoss()
+	want := []AttackPattern{PatternOrbit, PatternChase, PatternDash}
+
+	for phase, pattern := range want {
+		if got := b.GetPattern(); got != pattern {
+			t.Errorf("phase %d pattern = %v, want %v", phase, got, pattern)
+		}
+		b.Hit(110, entity.DamageTrue)
+	}
+}
+
+func TestTouches(t *testing.T) {
+	b, _ := newTestBoss()
+	reach := b.config.Radius + 10
+
+	if !b.Touches(common.Vector2{X: reach}, 10) {
+		t.Error("circle touching the boss's edge doesn't touch it")
+	}
+	if b.Touches(common.Vector2{X: reach + 1}, 10) {
+		t.Error("circle clear of the boss touches it")
+	}
+
+	b.Hit(b.config.MaxHealth, entity.DamageTrue)
+	if b.Touches(common.Vector2{}, 10) {
+		t.Error("defeated boss still touches")
+	}
+}
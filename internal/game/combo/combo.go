@@ -0,0 +1,132 @@
+package combo
+
+import (
+	"novampires-go/internal/engine/events"
+	"time"
+)
+
+// Config contains kill streak tuning parameters
+type Config struct {
+	Window        time.Duration // Time after a kill for the next one to continue the streak
+	Step          float64       // Multiplier gained per kill after the first
+	MaxMultiplier float64
+}
+
+// DefaultConfig returns default combo configuration
+func DefaultConfig() Config {
+	return Config{
+		Window:        3 * time.Second,
+		Step:          0.1,
+		MaxMultiplier: 3.0,
+	}
+}
+
+// Multiplier returns the score multiplier for a streak of count kills. A
+// single kill is not a combo yet and scores 1x.
+func Multiplier(count int, config Config) float64 {
+	if count <= 1 {
+		return 1
+	}
+	return min(1+config.Step*float64(count-1), max(config.MaxMultiplier, 1))
+}
+
+// Tracker counts consecutive kills made within the combo window of each
+// other and publishes ComboChanged whenever the streak changes
+type Tracker struct {
+	config Config
+	bus    *events.Bus
+	sub    events.Subscription
+
+	count int
+	best  int
+
+	// Time left before the streak resets
+	remaining time.Duration
+}
+
+// NewTracker creates a tracker. With a bus it counts EnemyKilled events and
+// publishes its changes there; without one kills are added with AddKill.
+func NewTracker(config Config, bus *events.Bus) *Tracker {
+	t := &Tracker{
+		config: config,
+		bus:    bus,
+	}
+	if bus != nil {
+		t.sub = bus.Subscribe(events.TypeEnemyKilled, func(events.Event) {
+			t.AddKill()
+		})
+	}
+	return t
+}
+
+// Close stops listening for kills
+func (t *Tracker) Close() {
+	if t.bus != nil {
+		t.bus.Unsubscribe(t.sub)
+	}
+}
+
+// AddKill extends the streak and restarts the window
+func (t *Tracker) AddKill() {
+	t.count++
+	t.best = max(t.best, t.count)
+	t.remaining = t.config.Window
+	t.publish()
+}
+
+// Update counts down the window, resetting the streak once it runs out
+func (t *Tracker) Update(dt time.Duration) {
+	if t.count == 0 {
+		return
+	}
+
+	t.remaining -= dt
+	if t.remaining <= 0 {
+		t.Reset()
+	}
+}
+
+// Reset ends the current streak
+func (t *Tracker) Reset() {
+	if t.count == 0 {
+		return
+	}
+
+	t.count = 0
+	t.remaining = 0
+	t.publish()
+}
+
+// publish sends the current streak if an event bus is attached
+func (t *Tracker) publish() {
+	if t.bus != nil {
+		t.bus.Publish(events.ComboChanged{
+			Count:      t.count,
+			Multiplier: t.Multiplier(),
+		})
+	}
+}
+
+// GetCount returns the number of kills in the current streak
+func (t *Tracker) GetCount() int {
+	return t.count
+}
+
+// GetBest returns the longest streak so far
+func (t *Tracker) GetBest() int {
+	return t.best
+}
+
+// Multiplier returns the score multiplier for the current streak
+func (t *Tracker) Multiplier() float64 {
+	return Multiplier(t.count, t.config)
+}
+
+// WindowFraction returns how much of the window is left (1 right after a
+// kill, 0 when the streak is about to reset), for drawing a timer
+func (t *Tracker) WindowFraction() float64 {
+	if t.count == 0 || t.config.Window <= 0 {
+		return 0
+	}
+	return float64(t.remaining) / float64(t.config.Window)
+}
@@ -0,0 +1,83 @@
+package combo
+
+import (
+	"math"
+	"novampires-go/internal/engine/events"
+	"testing"
+	"time"
+)
+
+func TestMultiplier(t *testing.T) {
+	config := Config{Window: time.Second, Step: 0.25, MaxMultiplier: 2}
+
+	tests := []struct {
+		count int
+		want  float64
+	}{
+		{0, 1},
+		{1, 1},
+		{2, 1.25},
+		{4, 1.75},
+		{5, 2},
+		{50, 2},
+	}
+
+	for _, tt := range tests {
+		if got := Multiplier(tt.count, config); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Multiplier(%d) = %v, want %v", tt.count, got, tt.want)
+		}
+	}
+}
+
+func TestTrackerCountsKillEvents(t *testing.T) {
+	bus := events.NewBus()
+	tracker := NewTracker(DefaultConfig(), bus)
+	defer tracker.Close()
+
+	// Kills in quick succession build the streak
+	for range 3 {
+		bus.Publish(events.EnemyKilled{})
+		tracker.Update(500 * time.Millisecond)
+	}
+	if got := tracker.GetCount(); got != 3 {
+		t.Fatalf("count after rapid kills = %d, want 3", got)
+	}
+	if tracker.Multiplier() <= 1 {
+		t.Errorf("multiplier after rapid kills = %v, want above 1", tracker.Multiplier())
+	}
+
+	// A gap longer than the window ends it
+	tracker.Update(DefaultConfig().Window)
+	if got := tracker.GetCount(); got != 0 {
+		t.Errorf("count after a gap = %d, want 0", got)
+	}
+	if got := tracker.GetBest(); got != 3 {
+		t.Errorf("best = %d, want 3", got)
+	}
+}
+
+func TestTrackerPublishesChanges(t *testing.T) {
+	bus := events.NewBus()
+	var counts []int
+	bus.Subscribe(events.TypeComboChanged, func(e events.Event) {
+		counts = append(counts, e.(events.ComboChanged).Count)
+	})
+
+	tracker := NewTracker(DefaultConfig(), bus)
+	defer tracker.Close()
+
+	bus.Publish(events.EnemyKilled{})
+	bus.Publish(events.EnemyKilled{})
+	tracker.Update(DefaultConfig().Window)
+
+	want := []int{1, 2, 0}
+	if len(counts) != len(want) {
+		t.Fatalf("ComboChanged counts = %v, want %v", counts, want)
+	}
+	for i := range want {
+		if counts[i] != want[i] {
+			t.Errorf("ComboChanged counts = %v, want %v", counts, want)
+			break
+		}
+	}
+}
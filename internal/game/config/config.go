@@ -1,6 +1,12 @@
 // internal/game/config/config.go
 package config
 
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
 // DisplayConfig contains display-related settings
 type DisplayConfig struct {
 	Width         int
@@ -10,6 +16,9 @@ type DisplayConfig struct {
 	TargetFPS     int
 	ShowFPS       bool
 	ShowDebugInfo bool
+
+	// Scale of HUD text and bars, e.g. 2 for 4K displays
+	UIScale float64
 }
 
 // DefaultDisplay returns sensible display defaults
@@ -22,6 +31,7 @@ func DefaultDisplay() DisplayConfig {
 		TargetFPS:     60,
 		ShowFPS:       true,
 		ShowDebugInfo: true,
+		UIScale:       1.0,
 	}
 }
 
@@ -49,10 +59,26 @@ func DefaultAudio() AudioConfig {
 type GameplayConfig struct {
 	Difficulty        int
 	AutoAimStrength   float64
+	AutoAimCandidates int // Closest targets auto-aim ranks, 0 for all
 	CameraShakeAmount float64
 	ScreenShake       bool
+	HitStop           bool
 	HitMarkers        bool
 	DamageNumbers     bool
+
+	// Pause the game when a gamepad is unplugged
+	PauseOnGamepadDisconnect bool
+
+	// Pulse a red vignette when health drops below LowHealthThreshold (0-1)
+	LowHealthWarning   bool
+	LowHealthThreshold float64
+
+	// Keys that aim apart from movement: 0=Off, 1=Arrows, 2=IJKL
+	KeyboardAim int
+
+	// Fraction of crowd overlap removed per pass (0-1) and passes per frame
+	SeparationStrength float64
+	SeparationPasses   int
 }
 
 // DefaultGameplay returns sensible gameplay defaults
@@ -60,10 +86,22 @@ func DefaultGameplay() GameplayConfig {
 	return GameplayConfig{
 		Difficulty:        1, // 0=Easy, 1=Normal, 2=Hard
 		AutoAimStrength:   0.6,
+		AutoAimCandidates: 32,
 		CameraShakeAmount: 0.7,
 		ScreenShake:       true,
+		HitStop:           true,
 		HitMarkers:        true,
 		DamageNumbers:     true,
+
+		PauseOnGamepadDisconnect: true,
+
+		LowHealthWarning:   true,
+		LowHealthThreshold: 0.3,
+
+		KeyboardAim: 0,
+
+		SeparationStrength: 1.0,
+		SeparationPasses:   1,
 	}
 }
 
@@ -82,3 +120,35 @@ func Default() Config {
 		Gameplay: DefaultGameplay(),
 	}
 }
+
+// Load reads a configuration written by Save. Settings missing from the file
+// keep their default values.
+func Load(path string) (Config, error) {
+	cfg := Default()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("read config: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Default(), fmt.Errorf("decode config: %w", err)
+	}
+	return cfg, nil
+}
+
+// Save writes a configuration to path, replacing any previous file atomically
+func Save(path string, cfg Config) error {
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode config: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+	return nil
+}
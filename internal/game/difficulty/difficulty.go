@@ -0,0 +1,99 @@
+// internal/game/difficulty/difficulty.go
+package difficulty
+
+// Level identifies a difficulty setting, matching GameplayConfig.Difficulty
+type Level int
+
+const (
+	Easy Level = iota
+	Normal
+	Hard
+)
+
+func (l Level) String() string {
+	switch l {
+	case Easy:
+		return "Easy"
+	case Normal:
+		return "Normal"
+	case Hard:
+		return "Hard"
+	default:
+		return "Unknown"
+	}
+}
+
+// Modifiers are the multipliers a difficulty level applies to gameplay
+type Modifiers struct {
+	EnemyHealth       float64 // Scales enemy max health
+	SpawnRate         float64 // Scales how often enemies spawn
+	PlayerDamageTaken float64 // Scales damage dealt to the player
+	AimAssist         float64 // Scales auto-aim strength, 0 disables it
+}
+
+// Table holds the modifiers for each level, indexed by Level.
+// Tune difficulty by editing the entries.
+var Table = []Modifiers{
+	Easy: {
+		EnemyHealth:       0.75,
+		SpawnRate:         0.8,
+		PlayerDamageTaken: 0.5,
+		AimAssist:         1.5,
+	},
+	Normal: {
+		EnemyHealth:       1.0,
+		SpawnRate:         1.0,
+		PlayerDamageTaken: 1.0,
+		AimAssist:         1.0,
+	},
+	Hard: {
+		EnemyHealth:       1.5,
+		SpawnRate:         1.3,
+		PlayerDamageTaken: 1.5,
+		AimAssist:         0,
+	},
+}
+
+// For returns the modifiers for a level, clamping unknown levels to the
+// nearest entry in the table
+func For(level Level) Modifiers {
+	if len(Table) == 0 {
+		return Modifiers{EnemyHealth: 1, SpawnRate: 1, PlayerDamageTaken: 1, AimAssist: 1}
+	}
+	idx := min(max(int(level), 0), len(Table)-1)
+	return Table[idx]
+}
+
+// ScaleEnemyHealth applies the enemy health multiplier to a base value
+func (m Modifiers) ScaleEnemyHealth(base int) int {
+	return max(1, int(float64(base)*m.EnemyHealth+0.5))
+}
+
+// ScaleSpawnInterval shortens or lengthens a base spawn interval
+// according to the spawn rate multiplier
+func (m Modifiers) ScaleSpawnInterval(base float64) float64 {
+	if m.SpawnRate <= 0 {
+		return base
+	}
+	return base / m.SpawnRate
+}
+
+// ScaleAutoAimStrength applies the aim assist multiplier to the configured
+// auto-aim strength, keeping the result in [0, 1]
+func (m Modifiers) ScaleAutoAimStrength(base float64) float64 {
+	return min(max(base*m.AimAssist, 0), 1)
+}
+
+// AimAssistLabel describes an effective auto-aim strength for the HUD
+func AimAssistLabel(strength float64) string {
+	switch {
+	case strength <= 0:
+		return "off"
+	case strength < 0.4:
+		return "low"
+	case strength < 0.75:
+		return "medium"
+	default:
+		return "high"
+	}
+}
@@ -0,0 +1,44 @@
+package difficulty
+
+import "testing"
+
+func TestHardIsHarderThanNormal(t *testing.T) {
+	normal, hard := For(Normal), For(Hard)
+
+	if got, want := hard.ScaleEnemyHealth(100), normal.ScaleEnemyHealth(100); got <= want {
+		t.Errorf("hard enemy health = %d, want more than normal's %d", got, want)
+	}
+	if got, want := hard.ScaleSpawnInterval(2), normal.ScaleSpawnInterval(2); got >= want {
+		t.Errorf("hard spawn interval = %v, want shorter than normal's %v", got, want)
+	}
+	if hard.PlayerDamageTaken <= normal.PlayerDamageTaken {
+		t.Errorf("hard damage taken = %v, want more than normal's %v", hard.PlayerDamageTaken, normal.PlayerDamageTaken)
+	}
+}
+
+func TestForClampsUnknownLevels(t *testing.T) {
+	tests := []struct {
+		name  string
+		level Level
+		want  Level
+	}{
+		{"below easy", -1, Easy},
+		{"normal", Normal, Normal},
+		{"above hard", 7, Hard},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, want := For(tt.level), Table[tt.want]; got != want {
+				t.Errorf("For(%d) = %+v, want %v's %+v", tt.level, got, tt.want, want)
+			}
+		})
+	}
+}
+
+func TestScaleEnemyHealthKeepsOne(t *testing.T) {
+	m := Modifiers{EnemyHealth: 0.1}
+	if got := m.ScaleEnemyHealth(3); got != 1 {
+		t.Errorf("ScaleEnemyHealth(3) = %d, want 1", got)
+	}
+}
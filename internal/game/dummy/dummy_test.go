@@ -0,0 +1,69 @@
+package dummy
+
+import (
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/events"
+	"testing"
+	"time"
+)
+
+func TestHitPublishesKillOnce(t *testing.T) {
+	bus := events.NewBus()
+	kills := 0
+	bus.Subscribe(events.TypeEnemyKilled, func(events.Event) { kills++ })
+
+	config := DefaultConfig()
+	d := NewDummy(1, common.Vector2{}, config, bus)
+
+	d.Hit(config.MaxHealth-1, entity.DamageTrue)
+	if kills != 0 {
+		t.Fatalf("kills = %d before health ran out, want 0", kills)
+	}
+
+	d.Hit(5, entity.DamageTrue)
+	d.Hit(5, entity.DamageTrue)
+	if kills != 1 {
+		t.Errorf("kills = %d, want 1", kills)
+	}
+}
+
+func TestRegenAfterDelay(t *testing.T) {
+	config := DefaultConfig()
+	d := NewDummy(1, common.Vector2{}, config, nil)
+	d.Hit(10, entity.DamageTrue)
+
+	d.Update(config.RegenDelay - time.Millisecond)
+	if got := d.GetHealth().GetCurrent(); got != config.MaxHealth-10 {
+		t.Fatalf("health = %d before the delay, want %d", got, config.MaxHealth-10)
+	}
+
+	d.Update(time.Millisecond)
+	if got := d.GetHealth().GetCurrent(); got != config.MaxHealth {
+		t.Errorf("health = %d after the delay, want %d", got, config.MaxHealth)
+	}
+	if got := d.GetDamageTaken(); got != 0 {
+		t.Errorf("damage taken = %d after regen, want 0", got)
+	}
+}
+
+func TestHitResetsRegenDelay(t *testing.T) {
+	config := DefaultConfig()
+	d := NewDummy(1, common.Vector2{}, config, nil)
+	d.Hit(10, entity.DamageTrue)
+
+	d.Update(config.RegenDelay - time.Millisecond)
+	d.Hit(10, entity.DamageTrue)
+	d.Update(config.RegenDelay - time.Millisecond)
+	if got := d.GetHealth().GetCurrent(); got != config.MaxHealth-20 {
+		t.Fatalf("health = %d, want %d while the delay restarts", got, config.MaxHealth-20)
+	}
+	if got := d.GetDamageTaken(); got != 20 {
+		t.Errorf("damage taken = %d, want 20", got)
+	}
+
+	d.Update(time.Millisecond)
+	if got := d.GetHealth().GetCurrent(); got != config.MaxHealth {
+		t.Errorf("health = %d after the restarted delay, want %d", got, config.MaxHealth)
+	}
+}
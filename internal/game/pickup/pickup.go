@@ -0,0 +1,143 @@
+package pickup
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"image/color"
+	"math"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/events"
+	"novampires-go/internal/engine/spatial"
+	"time"
+)
+
+// Config contains pickup tuning parameters
+type Config struct {
+	MagnetRadius  float64 // Distance at which pickups start flying to the player
+	CollectRadius float64 // Distance at which a pickup is collected
+	Acceleration  float64 // Homing acceleration in world units per second squared
+	MaxSpeed      float64 // Homing speed cap in world units per second
+	Radius        float64 // Drawn size of a pickup
+}
+
+// DefaultConfig returns default pickup configuration
+func DefaultConfig() Config {
+	return Config{
+		MagnetRadius:  120.0,
+		CollectRadius: 20.0,
+		Acceleration:  1500.0,
+		MaxSpeed:      900.0,
+		Radius:        5.0,
+	}
+}
+
+// pickupColor is the color pickups are drawn with
+var pickupColor = color.RGBA{68, 221, 255, 255}
+
+// Pickup is a collectible (XP gem, coin) that homes in on a nearby player
+type Pickup struct {
+	*entity.Entity
+
+	// Value granted when collected
+	Value int
+
+	// Once magnetized the pickup keeps homing even if the player moves away
+	magnetized bool
+	speed      float64
+}
+
+// IsMagnetized returns whether the pickup is flying toward the player
+func (p *Pickup) IsMagnetized() bool {
+	return p.magnetized
+}
+
+// Field manages all pickups lying in the world
+type Field struct {
+	config  Config
+	pickups map[uint64]*Pickup
+	grid    *spatial.Grid
+	bus     *events.Bus
+	nextID  uint64
+
+	// Reused query buffer
+	nearby []spatial.Entry
+}
+
+// NewField creates an empty pickup field. The bus may be nil.
+func NewField(config Config, bus *events.Bus) *Field {
+	return &Field{
+		config:  config,
+		pickups: make(map[uint64]*Pickup),
+		grid:    spatial.NewGrid(config.MagnetRadius),
+		bus:     bus,
+	}
+}
+
+// Spawn places a pickup worth value at a world position
+func (f *Field) Spawn(pos common.Vector2, value int) *Pickup {
+	f.nextID++
+	p := &Pickup{
+		Entity: entity.NewEntity(f.nextID, pos),
+		Value:  value,
+	}
+	f.pickups[p.ID] = p
+	return p
+}
+
+// Count returns the number of pickups in the field
+func (f *Field) Count() int {
+	return len(f.pickups)
+}
+
+// Update pulls pickups near the player toward it and collects the ones it
+// touches. It returns the total value collected this update.
+func (f *Field) Update(playerPos common.Vector2, dt time.Duration) int {
+	// Rebuild the grid and magnetize pickups within range of the player
+	f.grid.Clear()
+	for id, p := range f.pickups {
+		f.grid.Insert(id, p.Position)
+	}
+
+	f.nearby = f.grid.QueryRadius(playerPos, f.config.MagnetRadius, f.nearby[:0])
+	for _, entry := range f.nearby {
+		f.pickups[entry.ID].magnetized = true
+	}
+
+	collected := 0
+	seconds := dt.Seconds()
+
+	for id, p := range f.pickups {
+		if p.magnetized {
+			p.speed = math.Min(f.config.MaxSpeed, p.speed+f.config.Acceleration*seconds)
+			p.Position = p.Position.MoveTowards(playerPos, p.speed*seconds)
+		}
+
+		if p.Position.Sub(playerPos).MagnitudeSquared() > f.config.CollectRadius*f.config.CollectRadius {
+			continue
+		}
+
+		collected += p.Value
+		delete(f.pickups, id)
+
+		if f.bus != nil {
+			f.bus.Publish(events.PickupCollected{
+				Value:    p.Value,
+				Position: p.Position,
+			})
+		}
+	}
+
+	return collected
+}
+
+// DrawGridDebug overlays the spatial grid used for magnet queries
+func (f *Field) DrawGridDebug(screen *ebiten.Image, renderer entity.Renderer) {
+	f.grid.DrawDebug(screen, renderer)
+}
+
+// Draw draws all pickups
+func (f *Field) Draw(screen *ebiten.Image, renderer entity.Renderer) {
+	for _, p := range f.pickups {
+		renderer.DrawCircle(screen, p.Position, f.config.Radius, pickupColor)
+	}
+}
@@ -0,0 +1,79 @@
+package pickup
+
+import (
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/events"
+	"testing"
+	"time"
+)
+
+func TestMagnetStartsAtRadius(t *testing.T) {
+	config := DefaultConfig()
+
+	tests := []struct {
+		name       string
+		distance   float64
+		magnetized bool
+	}{
+		{"inside the radius", config.MagnetRadius - 1, true},
+		{"outside the radius", config.MagnetRadius + 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewField(config, nil)
+			start := common.Vector2{X: tt.distance}
+			p := f.Spawn(start, 1)
+
+			f.Update(common.Vector2{}, 16*time.Millisecond)
+
+			if p.IsMagnetized() != tt.magnetized {
+				t.Errorf("magnetized = %v, want %v", p.IsMagnetized(), tt.magnetized)
+			}
+			if moved := p.Position != start; moved != tt.magnetized {
+				t.Errorf("moved = %v, want %v", moved, tt.magnetized)
+			}
+			if tt.magnetized && p.Position.Magnitude() >= tt.distance {
+				t.Errorf("pickup at %v did not move toward the player", p.Position)
+			}
+		})
+	}
+}
+
+func TestCollectOnContact(t *testing.T) {
+	bus := events.NewBus()
+	var collected []events.PickupCollected
+	bus.Subscribe(events.TypePickupCollected, func(e events.Event) {
+		collected = append(collected, e.(events.PickupCollected))
+	})
+
+	config := DefaultConfig()
+	f := NewField(config, bus)
+	f.Spawn(common.Vector2{X: config.CollectRadius / 2}, 5)
+	f.Spawn(common.Vector2{X: config.MagnetRadius * 3}, 7)
+
+	if got := f.Update(common.Vector2{}, 16*time.Millisecond); got != 5 {
+		t.Errorf("Update() collected %d, want 5", got)
+	}
+	if f.Count() != 1 {
+		t.Errorf("Count() = %d after collecting one of two, want 1", f.Count())
+	}
+	if len(collected) != 1 || collected[0].Value != 5 {
+		t.Errorf("PickupCollected events %v, want one worth 5", collected)
+	}
+}
+
+func TestMagnetizedPickupReachesPlayer(t *testing.T) {
+	config := DefaultConfig()
+	f := NewField(config, nil)
+	f.Spawn(common.Vector2{Y: config.MagnetRadius - 1}, 3)
+
+	total := 0
+	for range 60 {
+		total += f.Update(common.Vector2{}, 16*time.Millisecond)
+	}
+
+	if total != 3 || f.Count() != 0 {
+		t.Errorf("collected %d with %d pickups left, want 3 and none", total, f.Count())
+	}
+}
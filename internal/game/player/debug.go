@@ -1,8 +1,11 @@
 package player
 
 import (
+	"fmt"
+	imgui "github.com/gabstv/cimgui-go"
 	"novampires-go/internal/common"
 	"novampires-go/internal/engine/debug"
+	"novampires-go/internal/engine/entity"
 	"unsafe"
 )
 
@@ -24,12 +27,48 @@ type DebugWindow struct {
 	frameRatePtr unsafe.Pointer
 }
 
-func NewDebugWindow(manager *debug.Manager, player Player) *DebugWindow {
-	return &DebugWindow{}
+func NewDebugWindow(manager *debug.Manager, player *Player) *DebugWindow {
+	return &DebugWindow{
+		manager: manager,
+		player:  player,
+	}
 }
 
 func (w *DebugWindow) Draw() {
+	if !w.open || w.player == nil {
+		return
+	}
 
+	if imgui.BeginV("Player Debug", &w.open, imgui.WindowFlagsNone) {
+		pos := w.player.GetPosition()
+		imgui.Text(fmt.Sprintf("Position: %.1f, %.1f", pos.X, pos.Y))
+
+		noclip := w.player.IsNoclip()
+		if imgui.Checkbox("Noclip (click to teleport)", &noclip) {
+			w.player.SetNoclip(noclip)
+		}
+
+		melee := w.player.GetMelee() != nil
+		if imgui.Checkbox("Melee attack", &melee) {
+			if melee {
+				w.player.SetMelee(entity.NewMeleeAttack(entity.DefaultMeleeConfig()))
+			} else {
+				w.player.SetMelee(nil)
+			}
+		}
+
+		predictive := w.player.IsPredictiveAimEnabled()
+		if imgui.Checkbox("Predictive aim", &predictive) {
+			w.player.SetPredictiveAim(predictive)
+		}
+
+		// Cycle through the flip sources
+		source := w.player.GetFlipSource()
+		if imgui.Button("Facing: " + source.String()) {
+			w.player.SetFlipSource((source + 1) % (entity.FlipMovementInput + 1))
+		}
+	}
+	imgui.End()
 }
 
 func (w *DebugWindow) Name() string {
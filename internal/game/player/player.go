@@ -1,12 +1,15 @@
 package player
 
 import (
+	"fmt"
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 	"log"
 	"novampires-go/internal/common"
+	"novampires-go/internal/engine/assets"
 	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/rendering"
 	"novampires-go/internal/engine/sprite"
+	"time"
 )
 
 // Player represents the player character built on the entity system
@@ -14,10 +17,38 @@ type Player struct {
 	*entity.Entity
 	input         *entity.PlayerInput
 	eyeController *entity.EyeController
+
+	// Debug mode: fast movement that ignores collisions
+	noclip bool
+
+	// Optional melee swing, nil for ranged characters
+	melee *entity.MeleeAttack
+
+	// Optional ranged weapon, fired in the aim direction
+	weapon *entity.Weapon
 }
 
-// NewPlayer creates a new player instance
-func NewPlayer(inputManager common.InputProvider, initialPos common.Vector2) *Player {
+const (
+	// playerMaxHealth is the player's starting health
+	playerMaxHealth = 100
+
+	// aimLineLength is how far the aim line extends in world units
+	aimLineLength = 200.0
+
+	// playerRadius is the size of the player's collision body
+	playerRadius = 20.0
+
+	// noclipSpeedMultiplier speeds the player up while noclip is on
+	noclipSpeedMultiplier = 4.0
+
+	// Idle time before the player fidgets, min plus up to spread
+	fidgetIntervalMin    = 6 * time.Second
+	fidgetIntervalSpread = 4 * time.Second
+)
+
+// NewPlayer creates a new player instance. It fails if the player's sprites
+// can't be loaded.
+func NewPlayer(inputManager common.InputProvider, initialPos common.Vector2) (*Player, error) {
 	// Create base entity
 	baseEntity := entity.NewEntity(1, initialPos)
 
@@ -25,12 +56,18 @@ func NewPlayer(inputManager common.InputProvider, initialPos common.Vector2) *Pl
 	playerInput := entity.NewPlayerInput(inputManager, entity.DefaultPlayerInputConfig(), baseEntity)
 	baseEntity.SetInput(playerInput)
 
+	// Create health component
+	baseEntity.SetHealth(entity.NewHealthComponent(playerMaxHealth))
+
+	// Create collision component
+	baseEntity.SetCollision(entity.NewCollisionComponent(playerRadius))
+
 	// Create sprite component
 	spriteComponent := entity.NewSpriteComponent()
 	baseEntity.SetSprite(spriteComponent)
 
 	// Create eye controller
-	eyeController := entity.NewEyeController()
+	eyeController := entity.NewEyeController(common.NewTimeSeededRng())
 
 	// Create player instance
 	player := &Player{
@@ -40,24 +77,25 @@ func NewPlayer(inputManager common.InputProvider, initialPos common.Vector2) *Pl
 	}
 
 	// Load player sprites
-	player.loadSprites()
+	if err := player.loadSprites(); err != nil {
+		return nil, err
+	}
 
-	return player
+	return player, nil
 }
 
 // loadSprites loads the player's sprites and sets up animations
-func (p *Player) loadSprites() {
+func (p *Player) loadSprites() error {
 	// Load body spritesheet
-	playerSpritesheet, _, err := ebitenutil.NewImageFromFile("assets/doux.png")
+	playerSpritesheet, err := assets.LoadImage("assets/doux.png")
 	if err != nil {
-		log.Printf("Failed to load player spritesheet: %v", err)
-		return
+		return fmt.Errorf("player spritesheet: %w", err)
 	}
 
 	// Get sprite component
 	spriteComponent := p.GetSprite()
 	if spriteComponent == nil {
-		return
+		return nil
 	}
 
 	// Set main sprite sheet
@@ -71,7 +109,9 @@ func (p *Player) loadSprites() {
 		{SrcX: 192, SrcY: 0, SrcWidth: 96, SrcHeight: 96, Duration: 150},
 		{SrcX: 288, SrcY: 0, SrcWidth: 96, SrcHeight: 96, Duration: 150},
 	}
-	spriteComponent.AddAnimation("idle", idleFrames, true)
+	if err := spriteComponent.AddAnimation("idle", idleFrames, true); err != nil {
+		log.Printf("Failed to create player animation: %v", err)
+	}
 
 	// Create walk animation (second row of spritesheet)
 	walkFrames := []sprite.FrameData{
@@ -82,13 +122,28 @@ func (p *Player) loadSprites() {
 		{SrcX: 768, SrcY: 0, SrcWidth: 96, SrcHeight: 96, Duration: 100},
 		{SrcX: 864, SrcY: 0, SrcWidth: 96, SrcHeight: 96, Duration: 100},
 	}
-	spriteComponent.AddAnimation("walk", walkFrames, true)
+	if err := spriteComponent.AddAnimation("walk", walkFrames, true); err != nil {
+		log.Printf("Failed to create player animation: %v", err)
+	}
+
+	// Create fidget animation (kick frames), played now and then while idle
+	fidgetFrames := []sprite.FrameData{
+		{SrcX: 960, SrcY: 0, SrcWidth: 96, SrcHeight: 96, Duration: 120},
+		{SrcX: 1056, SrcY: 0, SrcWidth: 96, SrcHeight: 96, Duration: 120},
+		{SrcX: 1152, SrcY: 0, SrcWidth: 96, SrcHeight: 96, Duration: 160},
+	}
+	if err := spriteComponent.AddAnimation("fidget", fidgetFrames, false); err != nil {
+		log.Printf("Failed to create player animation: %v", err)
+	} else if err := spriteComponent.SetFidget("fidget", "idle", fidgetIntervalMin, fidgetIntervalSpread, common.NewTimeSeededRng()); err != nil {
+		log.Printf("Failed to set up player fidget: %v", err)
+	}
 
 	// Set default animation
 	spriteComponent.PlayAnimation("idle")
 
 	// Load eye spritesheet
-	eyeSpritesheet, _, err := ebitenutil.NewImageFromFile("assets/doux-eyes.png")
+	// The eyes are cosmetic, so the player still works without them
+	eyeSpritesheet, err := assets.LoadImage("assets/doux-eyes.png")
 	if err != nil {
 		log.Printf("Failed to load eye spritesheet: %v", err)
 	} else {
@@ -103,18 +158,40 @@ func (p *Player) loadSprites() {
 
 	// Set scale
 	spriteComponent.SetScale(1.0)
+
+	// Stretch a little when running
+	spriteComponent.SetSquash(entity.NewSquashStretch(entity.DefaultSquashConfig()))
+	return nil
 }
 
 // Update updates the player state
-func (p *Player) Update(targets []common.TargetInfo) {
+func (p *Player) Update(targets []common.TargetInfo, dt time.Duration) {
 	// Update targets in player input
 	p.input.UpdateTargets(targets)
+	p.update(dt)
+}
+
+// UpdateWithQuery updates the player, with auto-aim gathering its targets in
+// range from query (e.g. an entity manager) instead of taking a list
+func (p *Player) UpdateWithQuery(query entity.TargetQuery, dt time.Duration) {
+	p.input.SetTargetQuery(query)
+	p.update(dt)
+}
 
+// update advances the player once its targets are set
+func (p *Player) update(dt time.Duration) {
 	// Update eye direction based on aim direction
 	p.eyeController.UpdateLookDirection(p.input.GetAimDirection())
 
 	// Update base entity
-	p.Entity.Update()
+	p.Entity.Update(dt)
+
+	if p.melee != nil {
+		p.melee.Update(dt)
+	}
+	if p.weapon != nil {
+		p.weapon.Update(dt)
+	}
 }
 
 // Draw draws the player
@@ -122,8 +199,120 @@ func (p *Player) Draw(screen *ebiten.Image, renderer entity.Renderer) {
 	// Draw entity (will use sprite component if available)
 	p.Entity.Draw(screen, renderer)
 
+	if p.melee != nil {
+		p.melee.Draw(screen, renderer)
+	}
+
 	// Draw aim line if needed
-	renderer.DrawAimLine(screen, p.GetPosition(), p.input.GetAimDirection(), 200)
+	renderer.DrawAimLine(screen, p.GetPosition(), p.input.GetAimDirection(), aimLineLength)
+
+	// Draw reticle where the shot will land
+	renderer.DrawReticle(screen, p.GetReticlePosition(), rendering.ReticleCrosshair)
+
+	// Mark the lead separately so the offset from the target is visible
+	if lead, ok := p.GetLeadPoint(); ok {
+		renderer.DrawReticle(screen, lead, rendering.ReticleLead)
+	}
+}
+
+// GetLeadPoint returns where predictive aim expects the equipped weapon's
+// shot to meet the locked target, and false without a lead
+func (p *Player) GetLeadPoint() (common.Vector2, bool) {
+	return p.input.GetLeadPoint()
+}
+
+// GetReticlePosition returns the locked auto-aim target's position, or the
+// end of the aim line when nothing is locked
+func (p *Player) GetReticlePosition() common.Vector2 {
+	if target, ok := p.input.GetLockedTarget(); ok {
+		return target.Pos
+	}
+	return p.GetPosition().Add(p.input.GetAimDirection().Scale(aimLineLength))
+}
+
+// SetNoclip toggles the debug mode that moves fast and ignores collisions
+func (p *Player) SetNoclip(enabled bool) {
+	p.noclip = enabled
+
+	if collision := p.GetCollision(); collision != nil {
+		collision.Disabled = enabled
+	}
+
+	if enabled {
+		p.input.SetSpeedMultiplier(noclipSpeedMultiplier)
+	} else {
+		p.input.SetSpeedMultiplier(1.0)
+	}
+}
+
+// SetMelee gives the player a melee attack, or removes it when nil
+func (p *Player) SetMelee(melee *entity.MeleeAttack) {
+	p.melee = melee
+}
+
+// GetMelee returns the player's melee attack, or nil if it has none
+func (p *Player) GetMelee() *entity.MeleeAttack {
+	return p.melee
+}
+
+// Swing swings the melee attack in the aim direction. It returns false if
+// the player has no melee attack or it is on cooldown.
+func (p *Player) Swing() bool {
+	if p.melee == nil {
+		return false
+	}
+	return p.melee.Swing(p.GetPosition(), p.GetRotation())
+}
+
+// SetWeapon gives the player a ranged weapon, or removes it when nil
+func (p *Player) SetWeapon(weapon *entity.Weapon) {
+	p.weapon = weapon
+	if weapon != nil {
+		p.input.SetLeadSpeed(weapon.GetConfig().Projectile.Speed)
+	}
+}
+
+// GetWeapon returns the player's ranged weapon, or nil if it has none
+func (p *Player) GetWeapon() *entity.Weapon {
+	return p.weapon
+}
+
+// Fire shoots the weapon in the aim direction from the edge of the player's
+// body and applies the weapon's recoil. It returns nil if the player has no
+// weapon or it is not ready.
+func (p *Player) Fire(projectiles *entity.Projectiles) *entity.Projectile {
+	if p.weapon == nil {
+		return nil
+	}
+
+	direction := p.GetAimDirection().Normalized()
+	origin := p.GetPosition().Add(direction.Scale(playerRadius))
+	shot := p.weapon.Fire(projectiles, origin, direction)
+	if shot != nil {
+		p.ApplyRecoil(p.weapon.GetConfig().Recoil)
+	}
+	return shot
+}
+
+// IsNoclip returns whether noclip is enabled
+func (p *Player) IsNoclip() bool {
+	return p.noclip
+}
+
+// TeleportTo moves the player to a world position and stops it
+func (p *Player) TeleportTo(pos common.Vector2) {
+	p.Position = pos
+	p.SetVelocity(common.Vector2{})
+
+	if trail := p.GetTrail(); trail != nil {
+		trail.Clear()
+	}
+}
+
+// Halt stops the player in place and clears input state left from before a
+// pause or scene change
+func (p *Player) Halt() {
+	p.input.Halt()
 }
 
 // TriggerEyeBlink triggers a blink animation
@@ -136,6 +325,11 @@ func (p *Player) GetAimDirection() common.Vector2 {
 	return p.input.GetAimDirection()
 }
 
+// ApplyRecoil applies a weapon's recoil to the player
+func (p *Player) ApplyRecoil(config entity.RecoilConfig) {
+	p.input.ApplyRecoil(config)
+}
+
 // IsAutoAimEnabled returns whether auto-aim is enabled
 func (p *Player) IsAutoAimEnabled() bool {
 	return p.input.IsAutoAimEnabled()
@@ -146,6 +340,42 @@ func (p *Player) SetAutoAim(enabled bool) {
 	p.input.SetAutoAim(enabled)
 }
 
+// SetAutoAimStrength sets how strongly auto-aim turns toward targets (0-1)
+func (p *Player) SetAutoAimStrength(strength float64) {
+	p.input.SetAutoAimStrength(strength)
+}
+
+// SetAutoAimCandidates sets how many of the closest targets auto-aim ranks,
+// 0 for all of them
+func (p *Player) SetAutoAimCandidates(k int) {
+	p.input.SetMaxCandidates(k)
+}
+
+// GetAutoAimStrength returns the effective auto-aim strength
+func (p *Player) GetAutoAimStrength() float64 {
+	return p.input.GetAutoAimStrength()
+}
+
+// SetPredictiveAim sets whether auto-aim leads moving targets
+func (p *Player) SetPredictiveAim(enabled bool) {
+	p.input.SetPredictiveAim(enabled)
+}
+
+// IsPredictiveAimEnabled returns whether auto-aim leads moving targets
+func (p *Player) IsPredictiveAimEnabled() bool {
+	return p.input.IsPredictiveAimEnabled()
+}
+
+// SetFlipSource sets which direction the player's sprite faces
+func (p *Player) SetFlipSource(source entity.FlipSource) {
+	p.input.SetFlipSource(source)
+}
+
+// GetFlipSource returns which direction the player's sprite faces
+func (p *Player) GetFlipSource() entity.FlipSource {
+	return p.input.GetFlipSource()
+}
+
 func (p *Player) GetEyePosition() common.Vector2 {
 	// Get base eye position from eye controller
 	currentAnim := p.GetSprite().GetCurrentAnimation()
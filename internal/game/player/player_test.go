@@ -0,0 +1,162 @@
+package player
+
+import (
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/input"
+	"novampires-go/internal/game/dummy"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestMain runs the package's tests from the repository root, where the
+// player finds its sprites as it does when the game runs
+func TestMain(m *testing.M) {
+	if err := os.Chdir(filepath.Join("..", "..", "..")); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// newPlayer creates a player at the origin with real input
+func newPlayer(t *testing.T) *Player {
+	t.Helper()
+	p, err := NewPlayer(input.New(), common.Vector2{})
+	if err != nil {
+		t.Fatalf("NewPlayer: %v", err)
+	}
+	return p
+}
+
+// target is a target's position and collision radius
+type target struct {
+	pos    common.Vector2
+	radius float64
+}
+
+func TestUpdateWithQueryMatchesSlice(t *testing.T) {
+	aimRange := entity.DefaultPlayerInputConfig().AutoAimRange
+
+	tests := []struct {
+		name    string
+		targets []target
+		want    int // Index of the picked target, -1 for none
+	}{
+		{
+			name:    "closest of several",
+			targets: []target{{common.Vector2{X: 250}, 10}, {common.Vector2{Y: -120}, 10}, {common.Vector2{X: -300}, 10}},
+			want:    1,
+		},
+		{
+			name:    "edge just inside range",
+			targets: []target{{common.Vector2{X: aimRange + 20}, 30}, {common.Vector2{Y: aimRange * 3}, 10}},
+			want:    0,
+		},
+		{
+			name:    "edge just outside range",
+			targets: []target{{common.Vector2{X: aimRange + 20}, 10}},
+			want:    -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := entity.NewManager()
+			var infos []common.TargetInfo
+			for _, target := range tt.targets {
+				e := m.Spawn(target.pos)
+				e.SetHealth(entity.NewHealthComponent(10))
+				e.SetCollision(entity.NewCollisionComponent(target.radius))
+				infos = append(infos, e.GetTargetInfo())
+			}
+
+			fromSlice := newPlayer(t)
+			fromSlice.Update(infos, 16*time.Millisecond)
+
+			fromQuery := newPlayer(t)
+			fromQuery.UpdateWithQuery(m, 16*time.Millisecond)
+
+			if got, want := fromQuery.GetReticlePosition(), fromSlice.GetReticlePosition(); got != want {
+				t.Errorf("query picked %v, slice picked %v", got, want)
+			}
+			if tt.want >= 0 {
+				if got, want := fromSlice.GetReticlePosition(), tt.targets[tt.want].pos; got != want {
+					t.Errorf("picked %v, want the target at %v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestReticleFollowsLockedTarget(t *testing.T) {
+	p := newPlayer(t)
+	targets := []common.TargetInfo{
+		{ID: 1, Pos: common.Vector2{X: 120, Y: -40}, Radius: 10},
+		{ID: 2, Pos: common.Vector2{X: -260, Y: 90}, Radius: 10},
+	}
+	p.Update(targets, 16*time.Millisecond)
+
+	locked, ok := p.input.GetLockedTarget()
+	if !ok {
+		t.Fatal("no target locked")
+	}
+	if locked.ID != 1 {
+		t.Errorf("locked target %d, want 1", locked.ID)
+	}
+	if got := p.GetReticlePosition(); got != locked.Pos {
+		t.Errorf("reticle at %v, want the locked target at %v", got, locked.Pos)
+	}
+
+	// Without a lock the reticle sits at the end of the aim line
+	p.Update(nil, 16*time.Millisecond)
+	if _, ok := p.input.GetLockedTarget(); ok {
+		t.Fatal("lock kept with no targets")
+	}
+	want := p.GetPosition().Add(p.input.GetAimDirection().Scale(aimLineLength))
+	if got := p.GetReticlePosition(); got != want {
+		t.Errorf("reticle at %v, want the aim line end at %v", got, want)
+	}
+}
+
+func TestNoclipBypassesCollisions(t *testing.T) {
+	tests := []struct {
+		name      string
+		noclip    bool
+		wantMoved bool
+	}{
+		{"pushed out of a dummy", false, true},
+		{"noclip passes through", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPlayer(t)
+			p.SetNoclip(tt.noclip)
+
+			// Overlapping a static dummy right next to the player
+			config := dummy.DefaultConfig()
+			d := dummy.NewDummy(2, common.Vector2{X: config.Radius}, config, nil)
+
+			entity.ResolveCollisions([]*entity.Entity{p.Entity, d.Entity})
+
+			if moved := p.GetPosition() != (common.Vector2{}); moved != tt.wantMoved {
+				t.Errorf("moved to %v, want moved = %v", p.GetPosition(), tt.wantMoved)
+			}
+			if d.Position != (common.Vector2{X: config.Radius}) {
+				t.Errorf("static dummy moved to %v", d.Position)
+			}
+		})
+	}
+}
+
+func TestNoclipOffRestoresCollisions(t *testing.T) {
+	p := newPlayer(t)
+	p.SetNoclip(true)
+	p.SetNoclip(false)
+
+	if p.IsNoclip() || p.GetCollision().Disabled {
+		t.Errorf("noclip = %v, collision disabled = %v after turning noclip off", p.IsNoclip(), p.GetCollision().Disabled)
+	}
+}
@@ -0,0 +1,210 @@
+// internal/game/prefab/prefab.go
+package prefab
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hajimehoshi/ebiten/v2"
+	"log"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/assets"
+	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/sprite"
+	"os"
+)
+
+// FrameDefinition is a single animation frame
+type FrameDefinition struct {
+	X        int `json:"x"`
+	Y        int `json:"y"`
+	Width    int `json:"width"`
+	Height   int `json:"height"`
+	Duration int `json:"duration"` // Milliseconds
+	OffsetX  int `json:"offsetX,omitempty"`
+	OffsetY  int `json:"offsetY,omitempty"`
+}
+
+// AnimationDefinition is a named frame sequence
+type AnimationDefinition struct {
+	Frames []FrameDefinition `json:"frames"`
+	Loop   bool              `json:"loop"`
+}
+
+// SpriteDefinition configures a sprite component
+type SpriteDefinition struct {
+	Sheet      string                         `json:"sheet"`
+	Animations map[string]AnimationDefinition `json:"animations"`
+	Default    string                         `json:"default"`
+	Scale      float64                        `json:"scale,omitempty"`
+	WorldSize  float64                        `json:"worldSize,omitempty"`
+}
+
+// CollisionDefinition configures a collision component
+type CollisionDefinition struct {
+	Radius float64 `json:"radius"`
+	Mass   float64 `json:"mass,omitempty"`
+	Static bool    `json:"static,omitempty"`
+}
+
+// Definition describes the components of a prefab. Missing sections leave
+// the component off.
+type Definition struct {
+	Sprite    *SpriteDefinition    `json:"sprite,omitempty"`
+	Health    int                  `json:"health,omitempty"`
+	Collision *CollisionDefinition `json:"collision,omitempty"`
+	Behavior  string               `json:"behavior,omitempty"`
+}
+
+// Behavior attaches code-defined behavior to a freshly spawned entity
+type Behavior func(e *entity.Entity)
+
+// Library holds prefab definitions and spawns entities from them
+type Library struct {
+	definitions map[string]Definition
+	behaviors   map[string]Behavior
+	sheets      map[string]*ebiten.Image
+	entities    *entity.Manager
+}
+
+// NewLibrary creates an empty library that spawns into an entity manager
+func NewLibrary(entities *entity.Manager) *Library {
+	return &Library{
+		definitions: make(map[string]Definition),
+		behaviors:   make(map[string]Behavior),
+		sheets:      make(map[string]*ebiten.Image),
+		entities:    entities,
+	}
+}
+
+// Load reads a JSON object of prefab definitions keyed by name and loads
+// their sprite sheets
+func (l *Library) Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read prefabs: %w", err)
+	}
+
+	var definitions map[string]Definition
+	if err := json.Unmarshal(data, &definitions); err != nil {
+		return fmt.Errorf("decode prefabs: %w", err)
+	}
+
+	for name, def := range definitions {
+		if err := l.Add(name, def); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Add registers a prefab definition, loading its sprite sheet
+func (l *Library) Add(name string, def Definition) error {
+	if def.Sprite != nil && def.Sprite.Sheet != "" {
+		if _, ok := l.sheets[def.Sprite.Sheet]; !ok {
+			sheet, err := assets.LoadImage(def.Sprite.Sheet)
+			if err != nil {
+				return fmt.Errorf("prefab %q: %w", name, err)
+			}
+			l.sheets[def.Sprite.Sheet] = sheet
+		}
+	}
+
+	l.definitions[name] = def
+	return nil
+}
+
+// RegisterBehavior makes a behavior available to prefabs by name
+func (l *Library) RegisterBehavior(name string, behavior Behavior) {
+	l.behaviors[name] = behavior
+}
+
+// Has returns whether a prefab is defined
+func (l *Library) Has(name string) bool {
+	_, ok := l.definitions[name]
+	return ok
+}
+
+// SpawnPrefab creates a managed entity from a prefab at a world position.
+// It returns nil if no prefab has that name.
+func (l *Library) SpawnPrefab(name string, pos common.Vector2) *entity.Entity {
+	def, ok := l.definitions[name]
+	if !ok {
+		log.Printf("Unknown prefab %q", name)
+		return nil
+	}
+
+	e := l.entities.Spawn(pos)
+
+	if def.Sprite != nil {
+		e.SetSprite(l.buildSprite(name, def.Sprite))
+	}
+
+	if def.Health > 0 {
+		e.SetHealth(entity.NewHealthComponent(def.Health))
+	}
+
+	if def.Collision != nil {
+		collision := entity.NewCollisionComponent(def.Collision.Radius)
+		if def.Collision.Static {
+			collision = entity.NewStaticCollisionComponent(def.Collision.Radius)
+		}
+		if def.Collision.Mass > 0 {
+			collision.Mass = def.Collision.Mass
+		}
+		e.SetCollision(collision)
+	}
+
+	if def.Behavior != "" {
+		if behavior, ok := l.behaviors[def.Behavior]; ok {
+			behavior(e)
+		} else {
+			log.Printf("Prefab %q: unknown behavior %q", name, def.Behavior)
+		}
+	}
+
+	return e
+}
+
+// buildSprite creates a sprite component from a definition
+func (l *Library) buildSprite(name string, def *SpriteDefinition) *entity.SpriteComponent {
+	component := entity.NewSpriteComponent()
+
+	// Animations go in before the sheet so no placeholder idle is created
+	for animName, anim := range def.Animations {
+		if err := component.AddAnimation(animName, toFrameData(anim.Frames), anim.Loop); err != nil {
+			log.Printf("Prefab %q: %v", name, err)
+		}
+	}
+	if sheet := l.sheets[def.Sheet]; sheet != nil {
+		component.SetSpriteSheet(sheet)
+	}
+	if def.Default != "" {
+		component.PlayAnimation(def.Default)
+	}
+
+	if def.Scale > 0 {
+		component.SetScale(def.Scale)
+	}
+	if def.WorldSize > 0 {
+		component.SetWorldSize(def.WorldSize)
+	}
+
+	return component
+}
+
+// toFrameData converts frame definitions to sprite frames
+func toFrameData(frames []FrameDefinition) []sprite.FrameData {
+	data := make([]sprite.FrameData, len(frames))
+	for i, f := range frames {
+		data[i] = sprite.FrameData{
+			SrcX:      f.X,
+			SrcY:      f.Y,
+			SrcWidth:  f.Width,
+			SrcHeight: f.Height,
+			Duration:  f.Duration,
+			OffsetX:   f.OffsetX,
+			OffsetY:   f.OffsetY,
+		}
+	}
+	return data
+}
@@ -0,0 +1,82 @@
+package prefab
+
+import (
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSpawnPrefabComponents(t *testing.T) {
+	manager := entity.NewManager()
+	library := NewLibrary(manager)
+
+	behaved := 0
+	library.RegisterBehavior("wander", func(e *entity.Entity) { behaved++ })
+
+	// No sprite, so nothing has to be loaded from disk
+	err := library.Add("post", Definition{
+		Health:    25,
+		Collision: &CollisionDefinition{Radius: 12, Mass: 3, Static: true},
+		Behavior:  "wander",
+	})
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	pos := common.Vector2{X: 10, Y: 20}
+	e := library.SpawnPrefab("post", pos)
+	if e == nil {
+		t.Fatal("SpawnPrefab returned nil")
+	}
+
+	if e.Position != pos {
+		t.Errorf("position = %v, want %v", e.Position, pos)
+	}
+	if health := e.GetHealth(); health == nil || health.GetMax() != 25 {
+		t.Errorf("health = %v, want a component with 25 max", health)
+	}
+	collision := e.GetCollision()
+	if collision == nil {
+		t.Fatal("no collision component")
+	}
+	if collision.Radius != 12 || collision.Mass != 3 || !collision.Static {
+		t.Errorf("collision = %+v, want a static radius 12 mass 3 body", *collision)
+	}
+	if collision.Restitution != entity.DefaultStaticRestitution {
+		t.Errorf("static restitution = %v, want %v", collision.Restitution, entity.DefaultStaticRestitution)
+	}
+	if e.GetSprite() != nil {
+		t.Error("sprite component without a sprite definition")
+	}
+	if behaved != 1 {
+		t.Errorf("behavior ran %d times, want 1", behaved)
+	}
+	if manager.Count() != 1 {
+		t.Errorf("manager holds %d entities, want 1", manager.Count())
+	}
+
+	if library.SpawnPrefab("missing", pos) != nil {
+		t.Error("SpawnPrefab of an unknown prefab returned an entity")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prefabs.json")
+	data := `{"crate": {"health": 10, "collision": {"radius": 8}}, "marker": {}}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	library := NewLibrary(entity.NewManager())
+	if err := library.Load(path); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !library.Has("crate") || !library.Has("marker") {
+		t.Error("loaded library is missing prefabs")
+	}
+	if e := library.SpawnPrefab("marker", common.Vector2{}); e.GetHealth() != nil || e.GetCollision() != nil {
+		t.Error("empty prefab has components")
+	}
+}
@@ -0,0 +1,88 @@
+// internal/game/save/save.go
+package save
+
+import (
+	"encoding/json"
+	"fmt"
+	"novampires-go/internal/common"
+	"os"
+	"time"
+)
+
+// CurrentVersion is the save format written by Write. Bump it when the
+// meaning of existing fields changes; new optional fields don't need a bump.
+const CurrentVersion = 1
+
+// PlayerState is the saved state of the player
+type PlayerState struct {
+	Position  common.Vector2 `json:"position"`
+	Health    int            `json:"health"`
+	MaxHealth int            `json:"maxHealth"`
+}
+
+// RngState captures a position in a seeded random sequence
+type RngState struct {
+	Seed  int64  `json:"seed"`
+	Draws uint64 `json:"draws"`
+}
+
+// State is a full snapshot of a run
+type State struct {
+	Version  int           `json:"version"`
+	Player   PlayerState   `json:"player"`
+	Wave     int           `json:"wave"`
+	Score    int           `json:"score"`
+	Survived time.Duration `json:"survived"`
+	Rng      RngState      `json:"rng"`
+}
+
+// CaptureRng records the current position of a random sequence
+func CaptureRng(rng *common.Rng) RngState {
+	return RngState{
+		Seed:  rng.Seed(),
+		Draws: rng.Draws(),
+	}
+}
+
+// RestoreRng moves a random sequence back to a saved position
+func RestoreRng(rng *common.Rng, state RngState) {
+	rng.Restore(state.Seed, state.Draws)
+}
+
+// Write writes a state to path as JSON
+func Write(path string, state State) error {
+	state.Version = CurrentVersion
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode save: %w", err)
+	}
+
+	// Write to a temporary file first so a crash can't leave a truncated save
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write save: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write save: %w", err)
+	}
+	return nil
+}
+
+// Read reads a state written by Write
+func Read(path string) (State, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return State{}, fmt.Errorf("read save: %w", err)
+	}
+
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return State{}, fmt.Errorf("decode save: %w", err)
+	}
+
+	if state.Version < 1 || state.Version > CurrentVersion {
+		return State{}, fmt.Errorf("unsupported save version %d", state.Version)
+	}
+	return state, nil
+}
@@ -0,0 +1,71 @@
+package save
+
+import (
+	"novampires-go/internal/common"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteReadRoundTrip(t *testing.T) {
+	rng := common.NewRng(42)
+	rng.Float64()
+	rng.Intn(10)
+
+	want := State{
+		Version: CurrentVersion,
+		Player: PlayerState{
+			Position:  common.Vector2{X: 120.5, Y: -40},
+			Health:    35,
+			MaxHealth: 100,
+		},
+		Wave:     3,
+		Score:    250,
+		Survived: 95 * time.Second,
+		Rng:      CaptureRng(rng),
+	}
+
+	path := filepath.Join(t.TempDir(), "save.json")
+	if err := Write(path, want); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	got, err := Read(path)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if got != want {
+		t.Errorf("Read = %+v, want %+v", got, want)
+	}
+
+	// The restored sequence continues where the saved one left off
+	restored := common.NewRng(0)
+	RestoreRng(restored, got.Rng)
+	if a, b := rng.Float64(), restored.Float64(); a != b {
+		t.Errorf("next random number = %v, want %v", b, a)
+	}
+}
+
+func TestReadRejectsUnknownVersions(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"missing version", `{"score": 10}`},
+		{"future version", `{"version": 99}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "save.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := Read(path)
+			if err == nil || !strings.Contains(err.Error(), "unsupported save version") {
+				t.Errorf("Read error = %v, want an unsupported version error", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// internal/game/scene/game_over_scene.go
+package scene
+
+import (
+	"fmt"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"novampires-go/internal/common"
+	"time"
+)
+
+// Layout of the game over summary in screen pixels
+const (
+	gameOverLeft       = 80
+	gameOverTop        = 80
+	gameOverLineHeight = 20
+	gameOverPadding    = 16
+	gameOverWidth      = 320
+)
+
+// RunStats summarizes a finished run
+type RunStats struct {
+	Score    int
+	Wave     int
+	Survived time.Duration
+}
+
+// GameOverChoice is what the player picked on the game over screen
+type GameOverChoice int
+
+const (
+	// GameOverNone means the player hasn't picked yet
+	GameOverNone GameOverChoice = iota
+	// GameOverRetry restarts the run from its seed
+	GameOverRetry
+	// GameOverMenu restarts the run and opens the menu
+	GameOverMenu
+)
+
+// gameOverOptions are the menu entries, in GameOverChoice order from Retry
+var gameOverOptions = []string{"Retry", "Main Menu"}
+
+// GameOverScene shows the final stats of a run and lets the player retry or
+// go back to the menu
+type GameOverScene struct {
+	input    common.InputProvider
+	stats    RunStats
+	selected int
+	choice   GameOverChoice
+}
+
+// NewGameOverScene creates a game over screen for a finished run
+func NewGameOverScene(input common.InputProvider, stats RunStats) *GameOverScene {
+	return &GameOverScene{
+		input: input,
+		stats: stats,
+	}
+}
+
+// Update moves the selection and confirms a choice
+func (s *GameOverScene) Update(dt time.Duration) error {
+	if s.choice != GameOverNone {
+		return nil
+	}
+
+	if s.input.JustPressed(common.ActionMoveUp) {
+		s.selected = (s.selected - 1 + len(gameOverOptions)) % len(gameOverOptions)
+	}
+	if s.input.JustPressed(common.ActionMoveDown) {
+		s.selected = (s.selected + 1) % len(gameOverOptions)
+	}
+
+	if s.input.JustPressed(common.ActionAutoAttack) {
+		// The confirming press must not also act in the restarted run
+		s.input.Consume(common.ActionAutoAttack)
+		s.choice = GameOverRetry + GameOverChoice(s.selected)
+	}
+
+	return nil
+}
+
+// Choice returns what the player picked, GameOverNone until they confirm
+func (s *GameOverScene) Choice() GameOverChoice {
+	return s.choice
+}
+
+// GetStats returns the summarized run
+func (s *GameOverScene) GetStats() RunStats {
+	return s.stats
+}
+
+// formatSurvived formats a run length as minutes and seconds
+func formatSurvived(d time.Duration) string {
+	seconds := int(d.Seconds())
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+// Draw draws the summary over the current frame
+func (s *GameOverScene) Draw(screen *ebiten.Image) {
+	lines := []string{
+		fmt.Sprintf("Score:    %d", s.stats.Score),
+		fmt.Sprintf("Wave:     %d", s.stats.Wave),
+		fmt.Sprintf("Survived: %s", formatSurvived(s.stats.Survived)),
+	}
+
+	rows := 2 + len(lines) + 1 + len(gameOverOptions) + 2
+	vector.DrawFilledRect(
+		screen,
+		gameOverLeft-gameOverPadding,
+		gameOverTop-gameOverPadding,
+		gameOverWidth,
+		float32(rows*gameOverLineHeight+2*gameOverPadding),
+		settingsBackground,
+		false,
+	)
+
+	ebitenutil.DebugPrintAt(screen, "GAME OVER", gameOverLeft, gameOverTop)
+
+	y := gameOverTop + 2*gameOverLineHeight
+	for _, line := range lines {
+		ebitenutil.DebugPrintAt(screen, line, gameOverLeft, y)
+		y += gameOverLineHeight
+	}
+
+	y += gameOverLineHeight
+	for i, option := range gameOverOptions {
+		cursor := "  "
+		if i == s.selected {
+			cursor = "> "
+		}
+		ebitenutil.DebugPrintAt(screen, cursor+option, gameOverLeft, y)
+		y += gameOverLineHeight
+	}
+
+	ebitenutil.DebugPrintAt(screen, "Up/Down select, Space to confirm", gameOverLeft, y+gameOverLineHeight)
+}
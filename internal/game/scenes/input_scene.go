@@ -0,0 +1,117 @@
+// internal/game/scene/input_scene.go
+package scene
+
+import (
+	"fmt"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"novampires-go/internal/common"
+	"time"
+)
+
+// Layout of the input test screen in screen pixels
+const (
+	inputTestLeft       = 80
+	inputTestTop        = 60
+	inputTestLineHeight = 16
+	inputTestPadding    = 16
+	inputTestWidth      = 460
+)
+
+// InputScene shows the live state of every action and axis so bindings and
+// devices can be checked without playing
+type InputScene struct {
+	input common.InputProvider
+	done  bool
+}
+
+// NewInputScene creates an input test screen reading from input
+func NewInputScene(input common.InputProvider) *InputScene {
+	return &InputScene{input: input}
+}
+
+// Update closes the screen when the menu action is pressed
+func (s *InputScene) Update(dt time.Duration) error {
+	if s.done {
+		return nil
+	}
+
+	if s.input.JustPressed(common.ActionMenu) {
+		// The press that closes the screen must not open the settings menu
+		s.input.Consume(common.ActionMenu)
+		s.done = true
+	}
+	return nil
+}
+
+// IsDone returns whether the player has left the screen
+func (s *InputScene) IsDone() bool {
+	return s.done
+}
+
+// lines returns the text shown on the screen, one entry per line
+func (s *InputScene) lines() []string {
+	moveX, moveY := s.input.GetMovementVector()
+	mouseX, mouseY := s.input.GetMousePosition()
+	worldX, worldY := s.input.GetMousePositionWorld()
+	padX, padY, padOK := s.input.GetGamepadAim()
+	keyX, keyY, keyOK := s.input.GetKeyboardAim()
+
+	lines := []string{
+		fmt.Sprintf("Move           %+.2f, %+.2f", moveX, moveY),
+		fmt.Sprintf("Mouse          %d, %d (world %d, %d)", mouseX, mouseY, worldX, worldY),
+		fmt.Sprintf("Gamepad aim    %+.2f, %+.2f %s", padX, padY, activeLabel(padOK)),
+		fmt.Sprintf("Keyboard aim   %+.2f, %+.2f %s", keyX, keyY, activeLabel(keyOK)),
+		"",
+	}
+	for _, action := range common.Actions {
+		lines = append(lines, fmt.Sprintf("%-24s %s", action, actionLabel(s.input.GetActionState(action))))
+	}
+	return lines
+}
+
+// activeLabel describes whether an aim source is in use
+func activeLabel(active bool) string {
+	if active {
+		return "active"
+	}
+	return "idle"
+}
+
+// actionLabel describes an action's state this frame
+func actionLabel(state common.ActionState) string {
+	switch {
+	case state.JustPressed:
+		return "pressed"
+	case state.JustReleased:
+		return "released"
+	case state.Active:
+		return "held"
+	default:
+		return "-"
+	}
+}
+
+// Draw draws the input state over the current frame
+func (s *InputScene) Draw(screen *ebiten.Image) {
+	lines := s.lines()
+	height := (len(lines)+3)*inputTestLineHeight + 2*inputTestPadding
+	vector.DrawFilledRect(
+		screen,
+		inputTestLeft-inputTestPadding,
+		inputTestTop-inputTestPadding,
+		inputTestWidth,
+		float32(height),
+		settingsBackground,
+		false,
+	)
+
+	ebitenutil.DebugPrintAt(screen, "INPUT TEST", inputTestLeft, inputTestTop)
+	for i, line := range lines {
+		ebitenutil.DebugPrintAt(screen, line, inputTestLeft, inputTestTop+(i+2)*inputTestLineHeight)
+	}
+
+	hintY := inputTestTop + (len(lines)+2)*inputTestLineHeight
+	ebitenutil.DebugPrintAt(screen, "Tab to close", inputTestLeft, hintY)
+}
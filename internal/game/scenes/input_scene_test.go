@@ -0,0 +1,38 @@
+package scene
+
+import (
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/input"
+	"strings"
+	"testing"
+)
+
+func TestInputSceneListsEveryAction(t *testing.T) {
+	s := NewInputScene(input.New())
+	text := strings.Join(s.lines(), "\n")
+	for _, action := range common.Actions {
+		if !strings.Contains(text, action.String()) {
+			t.Errorf("action %v not shown", action)
+		}
+	}
+}
+
+func TestInputSceneClosesOnMenu(t *testing.T) {
+	in := &menuInput{pressed: map[common.Action]bool{}}
+	s := NewInputScene(in)
+
+	in.pressed[common.ActionPause] = true
+	s.Update(0)
+	if s.IsDone() {
+		t.Fatal("closed on pause")
+	}
+
+	in.pressed[common.ActionMenu] = true
+	s.Update(0)
+	if !s.IsDone() {
+		t.Error("still open after the menu press")
+	}
+	if in.pressed[common.ActionMenu] {
+		t.Error("closing press not consumed")
+	}
+}
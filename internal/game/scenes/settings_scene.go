@@ -0,0 +1,222 @@
+// internal/game/scene/settings_scene.go
+package scene
+
+import (
+	"fmt"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"image/color"
+	"log"
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/input"
+	"novampires-go/internal/game/config"
+	"novampires-go/internal/game/difficulty"
+	"time"
+)
+
+// Layout of the settings menu in screen pixels
+const (
+	settingsLeft       = 80
+	settingsTop        = 80
+	settingsLineHeight = 20
+	settingsPadding    = 16
+	settingsWidth      = 420
+)
+
+// UI scale range and step offered by the menu
+const (
+	minUIScale  = 0.5
+	maxUIScale  = 3.0
+	uiScaleStep = 0.25
+)
+
+// settingsBackground dims the game behind the menu
+var settingsBackground = color.RGBA{0, 0, 0, 200}
+
+// setting is a single editable row in the settings menu
+type setting struct {
+	label string
+	value func() string
+
+	// adjust changes the value one step in direction (-1 or 1)
+	adjust func(direction int)
+}
+
+// SettingsScene lets the player edit the configuration in game. Changes are
+// applied live through the apply callback and written to disk on exit.
+type SettingsScene struct {
+	input    common.InputProvider
+	config   *config.Config
+	path     string
+	apply    func(*config.Config)
+	settings []setting
+	selected int
+	done     bool
+}
+
+// NewSettingsScene creates a settings menu editing cfg. apply is called after
+// every change and may be nil; the config is saved to path on exit.
+func NewSettingsScene(input common.InputProvider, cfg *config.Config, path string, apply func(*config.Config)) *SettingsScene {
+	s := &SettingsScene{
+		input:  input,
+		config: cfg,
+		path:   path,
+		apply:  apply,
+	}
+	s.settings = s.createSettings()
+	return s
+}
+
+// createSettings builds the editable rows bound to the config fields
+func (s *SettingsScene) createSettings() []setting {
+	cfg := s.config
+	return []setting{
+		volumeSetting("Master Volume", &cfg.Audio.MasterVolume),
+		volumeSetting("Music Volume", &cfg.Audio.MusicVolume),
+		volumeSetting("SFX Volume", &cfg.Audio.SFXVolume),
+		toggleSetting("Mute When Unfocused", &cfg.Audio.MuteWhenFocusLost),
+		{
+			label: "Difficulty",
+			value: func() string { return difficulty.Level(cfg.Gameplay.Difficulty).String() },
+			adjust: func(direction int) {
+				cfg.Gameplay.Difficulty = min(max(cfg.Gameplay.Difficulty+direction, int(difficulty.Easy)), int(difficulty.Hard))
+			},
+		},
+		volumeSetting("Camera Shake", &cfg.Gameplay.CameraShakeAmount),
+		toggleSetting("Screen Shake", &cfg.Gameplay.ScreenShake),
+		toggleSetting("Hit Stop", &cfg.Gameplay.HitStop),
+		toggleSetting("Damage Numbers", &cfg.Gameplay.DamageNumbers),
+		toggleSetting("Low Health Warning", &cfg.Gameplay.LowHealthWarning),
+		{
+			label: "Keyboard Aim",
+			value: func() string { return input.KeyboardAimMode(cfg.Gameplay.KeyboardAim).String() },
+			adjust: func(direction int) {
+				cfg.Gameplay.KeyboardAim = min(max(cfg.Gameplay.KeyboardAim+direction, int(input.KeyboardAimOff)), int(input.KeyboardAimIJKL))
+			},
+		},
+		toggleSetting("Fullscreen", &cfg.Display.Fullscreen),
+		toggleSetting("VSync", &cfg.Display.VSync),
+		toggleSetting("Show FPS", &cfg.Display.ShowFPS),
+		{
+			label: "UI Scale",
+			value: func() string { return fmt.Sprintf("%.2fx", cfg.Display.UIScale) },
+			adjust: func(direction int) {
+				cfg.Display.UIScale = min(max(cfg.Display.UIScale+float64(direction)*uiScaleStep, minUIScale), maxUIScale)
+			},
+		},
+	}
+}
+
+// volumeSetting edits a 0-1 value in steps of 10%
+func volumeSetting(label string, value *float64) setting {
+	return setting{
+		label: label,
+		value: func() string { return fmt.Sprintf("%3.0f%%", *value*100) },
+		adjust: func(direction int) {
+			*value = min(max(*value+float64(direction)*0.1, 0), 1)
+		},
+	}
+}
+
+// toggleSetting edits an on/off value
+func toggleSetting(label string, value *bool) setting {
+	return setting{
+		label: label,
+		value: func() string {
+			if *value {
+				return "On"
+			}
+			return "Off"
+		},
+		adjust: func(int) { *value = !*value },
+	}
+}
+
+// Update moves the selection, edits the selected setting and handles exit
+func (s *SettingsScene) Update(dt time.Duration) error {
+	if s.done {
+		return nil
+	}
+
+	if s.input.JustPressed(common.ActionMenu) || s.input.JustPressed(common.ActionPause) {
+		// The press that closes the menu must not reopen it or pause the game
+		s.input.Consume(common.ActionMenu)
+		s.input.Consume(common.ActionPause)
+		s.Close()
+		return nil
+	}
+
+	if s.input.JustPressed(common.ActionMoveUp) {
+		s.selected = (s.selected - 1 + len(s.settings)) % len(s.settings)
+	}
+	if s.input.JustPressed(common.ActionMoveDown) {
+		s.selected = (s.selected + 1) % len(s.settings)
+	}
+
+	if s.input.JustPressed(common.ActionMoveLeft) {
+		s.Adjust(s.selected, -1)
+	}
+	if s.input.JustPressed(common.ActionMoveRight) {
+		s.Adjust(s.selected, 1)
+	}
+
+	return nil
+}
+
+// Adjust steps a setting by index and applies the change
+func (s *SettingsScene) Adjust(index, direction int) {
+	if index < 0 || index >= len(s.settings) {
+		return
+	}
+
+	s.settings[index].adjust(direction)
+	if s.apply != nil {
+		s.apply(s.config)
+	}
+}
+
+// Close saves the configuration and marks the menu as finished
+func (s *SettingsScene) Close() {
+	if s.done {
+		return
+	}
+	s.done = true
+
+	if err := config.Save(s.path, *s.config); err != nil {
+		log.Printf("Failed to save settings: %v", err)
+	}
+}
+
+// IsDone returns whether the player has left the menu
+func (s *SettingsScene) IsDone() bool {
+	return s.done
+}
+
+// Draw draws the settings menu over the current frame
+func (s *SettingsScene) Draw(screen *ebiten.Image) {
+	height := len(s.settings)*settingsLineHeight + 3*settingsLineHeight + 2*settingsPadding
+	vector.DrawFilledRect(
+		screen,
+		settingsLeft-settingsPadding,
+		settingsTop-settingsPadding,
+		settingsWidth,
+		float32(height),
+		settingsBackground,
+		false,
+	)
+
+	ebitenutil.DebugPrintAt(screen, "SETTINGS", settingsLeft, settingsTop)
+
+	for i, st := range s.settings {
+		cursor := "  "
+		if i == s.selected {
+			cursor = "> "
+		}
+		line := fmt.Sprintf("%s%-22s < %s >", cursor, st.label, st.value())
+		ebitenutil.DebugPrintAt(screen, line, settingsLeft, settingsTop+(i+2)*settingsLineHeight)
+	}
+
+	hintY := settingsTop + (len(s.settings)+2)*settingsLineHeight
+	ebitenutil.DebugPrintAt(screen, "Up/Down select, Left/Right change, Tab to close", settingsLeft, hintY)
+}
@@ -0,0 +1,87 @@
+package scene
+
+import (
+	"novampires-go/internal/common"
+	"novampires-go/internal/game/config"
+	"path/filepath"
+	"testing"
+)
+
+// menuInput is an InputProvider where only the listed actions were just
+// pressed
+type menuInput struct {
+	common.InputProvider
+	pressed map[common.Action]bool
+}
+
+func (m *menuInput) JustPressed(action common.Action) bool { return m.pressed[action] }
+func (m *menuInput) Consume(action common.Action)          { delete(m.pressed, action) }
+
+// settingIndex returns the row of the setting with label
+func settingIndex(t *testing.T, s *SettingsScene, label string) int {
+	t.Helper()
+	for i, row := range s.settings {
+		if row.label == label {
+			return i
+		}
+	}
+	t.Fatalf("no setting %q", label)
+	return -1
+}
+
+func TestAdjustMutatesConfig(t *testing.T) {
+	tests := []struct {
+		label     string
+		direction int
+		check     func(cfg config.Config) bool
+	}{
+		{"Master Volume", -1, func(cfg config.Config) bool { return cfg.Audio.MasterVolume == 0.9 }},
+		{"SFX Volume", 1, func(cfg config.Config) bool { return cfg.Audio.SFXVolume == 0.9 }},
+		{"Difficulty", 1, func(cfg config.Config) bool { return cfg.Gameplay.Difficulty == config.DefaultGameplay().Difficulty+1 }},
+		{"Hit Stop", 1, func(cfg config.Config) bool { return cfg.Gameplay.HitStop != config.DefaultGameplay().HitStop }},
+		{"Fullscreen", -1, func(cfg config.Config) bool { return cfg.Display.Fullscreen != config.DefaultDisplay().Fullscreen }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			cfg := config.Default()
+			applied := 0
+			s := NewSettingsScene(&menuInput{}, &cfg, filepath.Join(t.TempDir(), "config.json"), func(*config.Config) { applied++ })
+
+			s.Adjust(settingIndex(t, s, tt.label), tt.direction)
+			if !tt.check(cfg) {
+				t.Errorf("config not changed: %+v", cfg)
+			}
+			if applied != 1 {
+				t.Errorf("applied %d times, want 1", applied)
+			}
+		})
+	}
+}
+
+func TestCloseSavesConfig(t *testing.T) {
+	cfg := config.Default()
+	path := filepath.Join(t.TempDir(), "config.json")
+	input := &menuInput{pressed: map[common.Action]bool{}}
+	s := NewSettingsScene(input, &cfg, path, nil)
+
+	s.Adjust(settingIndex(t, s, "Master Volume"), -1)
+	input.pressed[common.ActionMenu] = true
+	if err := s.Update(0); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if !s.IsDone() {
+		t.Fatal("menu still open after the menu press")
+	}
+	if input.pressed[common.ActionMenu] {
+		t.Error("closing press not consumed")
+	}
+
+	saved, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if saved.Audio.MasterVolume != cfg.Audio.MasterVolume {
+		t.Errorf("saved master volume = %v, want %v", saved.Audio.MasterVolume, cfg.Audio.MasterVolume)
+	}
+}
@@ -4,115 +4,371 @@ package scene
 import (
 	"fmt"
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 	"image/color"
+	"log"
 	"math"
 	"novampires-go/internal/common"
+	"novampires-go/internal/engine/camera"
+	"novampires-go/internal/engine/effects"
 	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/events"
+	"novampires-go/internal/engine/rendering"
+	"novampires-go/internal/game/boss"
+	"novampires-go/internal/game/combo"
+	"novampires-go/internal/game/difficulty"
+	"novampires-go/internal/game/dummy"
+	"novampires-go/internal/game/pickup"
 	"novampires-go/internal/game/player"
+	"novampires-go/internal/game/prefab"
+	"novampires-go/internal/game/save"
+	"novampires-go/internal/game/spawn"
+	"time"
 )
 
 // Dependencies contains all external dependencies needed by scenes
 type Dependencies struct {
 	InputManager common.InputProvider
 	Renderer     *entity.RendererAdapter
+	Camera       *camera.Camera
+	Events       *events.Bus
+	Difficulty   difficulty.Modifiers
+	Rng          *common.Rng
 	ScreenWidth  int
 	ScreenHeight int
 }
 
 // TestScene implements a test scene with moving targets
 type TestScene struct {
-	deps       Dependencies
-	player     *player.Player
-	targets    []common.TargetInfo
-	frameCount int
+	deps    Dependencies
+	player  *player.Player
+	enemies *entity.Manager
+	prefabs *prefab.Library
+	spawner *spawn.Spawner
+	targets []common.TargetInfo
+	pickups *pickup.Field
+	dummies []*dummy.Dummy
+	boss    *boss.Boss
+	minimap *rendering.Minimap
+	texts   *effects.FloatingTextSystem
+	combo   *combo.Tracker
+	palette rendering.ColorPalette
+	elapsed time.Duration
+
+	// Shots fired by the player, and the weapons the ability keys select
+	projectiles *entity.Projectiles
+	weapons     []entity.WeaponConfig
+	hits        []*entity.Entity
+
+	// Reused list of entities that take part in collision resolution
+	colliders  []*entity.Entity
+	separation entity.SeparationConfig
+
+	// Total value of pickups collected so far
+	experience int
+
+	// Time since an enemy last hurt the player by touching it
+	sinceContact time.Duration
 }
 
+// hudTextColor is the color of the scene's HUD text
+var hudTextColor = color.RGBA{255, 255, 255, 255}
+
+// cooldownRingCenter places the attack cooldown ring right of the weapon name
+var cooldownRingCenter = common.Vector2{X: 150, Y: 64}
+
+// cooldownRingRadius is the size of the attack cooldown ring
+const cooldownRingRadius = 7.0
+
+// targetHealth is the health of an orbiting target before difficulty scaling
+const targetHealth = 40
+
+// Tuning of the enemies the spawner sends after the player, used as is when
+// the chaser prefab is unavailable
+const (
+	chaserHealth = 30
+	chaserSpeed  = 70.0
+	chaserRadius = 14.0
+)
+
+// Damage enemies deal by touching the player, and how often they may
+const (
+	contactDamage   = 10
+	contactInterval = 500 * time.Millisecond
+)
+
+// Prefab definitions and the prefab the spawner uses
+const (
+	prefabsPath  = "assets/prefabs.json"
+	chaserPrefab = "chaser"
+)
+
 // NewTestScene creates a new test scene
-func NewTestScene(deps Dependencies) *TestScene {
-	// Create initial targets
-	targets := createInitialTargets(deps.ScreenWidth, deps.ScreenHeight)
+func NewTestScene(deps Dependencies) (*TestScene, error) {
+	// Enemies publish a kill event when they die
+	enemies := entity.NewManager()
+	enemies.SetEventBus(deps.Events)
+	createInitialTargets(deps, enemies)
 
 	// Create player at center of screen
 	initialPos := common.Vector2{
 		X: float64(deps.ScreenWidth) / 2,
 		Y: float64(deps.ScreenHeight) / 2,
 	}
-	player := player.NewPlayer(deps.InputManager, initialPos)
+	player, err := player.NewPlayer(deps.InputManager, initialPos)
+	if err != nil {
+		return nil, fmt.Errorf("create player: %w", err)
+	}
+	if health := player.GetHealth(); health != nil {
+		health.DamageScale = deps.Difficulty.PlayerDamageTaken
+	}
+
+	s := &TestScene{
+		deps:    deps,
+		player:  player,
+		enemies: enemies,
+		prefabs: prefab.NewLibrary(enemies),
+		spawner: spawn.NewSpawner(spawn.DefaultSpawnerConfig(), deps.Difficulty, deps.Rng),
+		pickups: createInitialPickups(deps),
+		dummies: createDummies(deps.ScreenWidth, deps.ScreenHeight, deps.Events),
+		boss:    createBoss(deps, player),
+		minimap: rendering.NewMinimap(rendering.DefaultMinimapConfig(), rendering.DefaultColorPalette()),
+		texts:   effects.NewFloatingTextSystem(),
+		combo:   combo.NewTracker(combo.DefaultConfig(), deps.Events),
+		palette: rendering.DefaultColorPalette(),
+		elapsed: 0,
 
-	return &TestScene{
-		deps:       deps,
-		player:     player,
-		targets:    targets,
-		frameCount: 0,
+		projectiles: entity.NewProjectiles(enemies),
+		weapons:     []entity.WeaponConfig{entity.BlasterWeaponConfig(), entity.LobberWeaponConfig(), entity.SeekerWeaponConfig()},
+
+		separation: entity.DefaultSeparationConfig(),
 	}
+
+	player.SetWeapon(entity.NewWeapon(s.weapons[0]))
+
+	// Spawned enemies come from prefabs when they load
+	s.prefabs.RegisterBehavior("chase", func(e *entity.Entity) {
+		e.SetInput(&chase{target: player.GetPositionPtr(), speed: chaserSpeed})
+	})
+	if err := s.prefabs.Load(prefabsPath); err != nil {
+		log.Printf("Failed to load prefabs, using plain enemies: %v", err)
+	}
+
+	return s, nil
 }
 
-// createInitialTargets creates initial target objects
-func createInitialTargets(screenWidth, screenHeight int) []common.TargetInfo {
-	targets := make([]common.TargetInfo, 0, 4)
+// createInitialPickups scatters a ring of pickups around the player
+func createInitialPickups(deps Dependencies) *pickup.Field {
+	field := pickup.NewField(pickup.DefaultConfig(), deps.Events)
 
-	// Add targets in a circular pattern around center
-	centerX := screenWidth / 2
-	centerY := screenHeight / 2
-	radius := 300.0
+	centerX := float64(deps.ScreenWidth) / 2
+	centerY := float64(deps.ScreenHeight) / 2
+	radius := 180.0
+	count := 12
 
-	for i := 0; i < 4; i++ {
-		// Distribute evenly around circle
-		angle := float64(i) * math.Pi / 2
+	for i := 0; i < count; i++ {
+		angle := float64(i)*2*math.Pi/float64(count) + deps.Rng.Range(-0.2, 0.2)
+		distance := radius + deps.Rng.Range(-40, 40)
+		field.Spawn(common.Vector2{
+			X: centerX + math.Cos(angle)*distance,
+			Y: centerY + math.Sin(angle)*distance,
+		}, 1)
+	}
+
+	return field
+}
+
+// createDummies places a row of target dummies below the player
+func createDummies(screenWidth, screenHeight int, bus *events.Bus) []*dummy.Dummy {
+	config := dummy.DefaultConfig()
+	centerX := float64(screenWidth) / 2
+	y := float64(screenHeight)/2 + 120
+	spacing := 80.0
+
+	dummies := make([]*dummy.Dummy, 0, 3)
+	for i := 0; i < 3; i++ {
 		pos := common.Vector2{
-			X: float64(centerX) + math.Cos(angle)*radius,
-			Y: float64(centerY) + math.Sin(angle)*radius,
+			X: centerX + float64(i-1)*spacing,
+			Y: y,
 		}
+		dummies = append(dummies, dummy.NewDummy(uint64(100+i), pos, config, bus))
+	}
+
+	return dummies
+}
+
+// createBoss places a boss above the player that attacks it
+func createBoss(deps Dependencies, p *player.Player) *boss.Boss {
+	pos := common.Vector2{
+		X: float64(deps.ScreenWidth) / 2,
+		Y: float64(deps.ScreenHeight)/2 - 260,
+	}
+	config := boss.DefaultConfig()
+	config.MaxHealth = int(float64(config.MaxHealth) * deps.Difficulty.EnemyHealth)
+
+	b := boss.NewBoss(200, pos, config, deps.Events)
+	b.SetTarget(p.GetPositionPtr())
+	return b
+}
 
-		// Initial velocity tangent to circle
-		velocity := common.Vector2{
-			X: -math.Sin(angle) * 5,
-			Y: math.Cos(angle) * 5,
+// createInitialTargets spawns targets circling the center of the screen
+func createInitialTargets(deps Dependencies, enemies *entity.Manager) {
+	center := common.Vector2{
+		X: float64(deps.ScreenWidth) / 2,
+		Y: float64(deps.ScreenHeight) / 2,
+	}
+
+	for i := 0; i < 4; i++ {
+		// Distribute evenly around the circle, each at a slightly different speed
+		o := &orbit{
+			center: center,
+			radius: 300,
+			speed:  0.3 + float64(i)*0.12,
+			angle:  float64(i) * math.Pi / 2,
 		}
 
-		targets = append(targets, common.TargetInfo{
-			ID:       uint64(i + 1),
-			Pos:      pos,
-			Vel:      velocity,
-			Radius:   15,
-			Priority: float64(i + 1),
-		})
+		e := enemies.Spawn(o.position())
+		e.SetHealth(entity.NewHealthComponent(deps.Difficulty.ScaleEnemyHealth(targetHealth)))
+		e.SetCollision(entity.NewCollisionComponent(15))
+		e.SetInput(o)
 	}
+}
+
+// orbit moves an entity around a circle, standing in for enemy AI
+type orbit struct {
+	center common.Vector2
+	radius float64
+	speed  float64 // Radians per second
+	angle  float64
+}
 
-	return targets
+// position returns the point on the circle at the current angle
+func (o *orbit) position() common.Vector2 {
+	return o.center.Add(common.Vector2{X: math.Cos(o.angle), Y: math.Sin(o.angle)}.Scale(o.radius))
 }
 
-// Update updates the scene
-func (s *TestScene) Update() error {
-	s.frameCount++
+// ProcessInput advances the entity along the circle, moving tangent to it
+func (o *orbit) ProcessInput(e *entity.Entity, dt time.Duration) {
+	o.angle += o.speed * dt.Seconds()
+	e.Position = o.position()
+	e.Velocity = common.Vector2{X: -math.Sin(o.angle), Y: math.Cos(o.angle)}.Scale(o.radius * o.speed)
+}
+
+// GetAimDirection returns no direction, targets don't aim
+func (o *orbit) GetAimDirection() common.Vector2 {
+	return common.Vector2{}
+}
 
-	// Update player with current targets
-	s.player.Update(s.targets)
+// chase moves an entity straight toward a position, e.g. the player's
+type chase struct {
+	target *common.Vector2
+	speed  float64
+}
+
+// ProcessInput points the entity's velocity at the target
+func (c *chase) ProcessInput(e *entity.Entity, dt time.Duration) {
+	offset := c.target.Sub(e.Position)
+	distance := offset.Magnitude()
+	if distance <= 0 {
+		e.Velocity = common.Vector2{}
+		return
+	}
+	e.Velocity = offset.Scale(c.speed / distance)
+}
+
+// GetAimDirection returns no direction, chasers don't aim
+func (c *chase) GetAimDirection() common.Vector2 {
+	return common.Vector2{}
+}
+
+// spawnChaser adds an enemy at pos that runs at the player, from its prefab
+// if it is loaded
+func (s *TestScene) spawnChaser(pos common.Vector2) {
+	if s.prefabs.Has(chaserPrefab) {
+		e := s.prefabs.SpawnPrefab(chaserPrefab, pos)
+		if health := e.GetHealth(); health != nil {
+			health.SetMax(s.deps.Difficulty.ScaleEnemyHealth(health.GetMax()))
+			health.SetCurrent(health.GetMax())
+		}
+		return
+	}
 
-	// Move targets in circular patterns
-	centerX := s.deps.ScreenWidth / 2
-	centerY := s.deps.ScreenHeight / 2
-	radius := 300.0
+	e := s.enemies.Spawn(pos)
+	e.SetHealth(entity.NewHealthComponent(s.deps.Difficulty.ScaleEnemyHealth(chaserHealth)))
+	e.SetCollision(entity.NewCollisionComponent(chaserRadius))
+	e.SetInput(&chase{target: s.player.GetPositionPtr(), speed: chaserSpeed})
+}
 
-	for i := range s.targets {
-		// Each target moves at slightly different speeds
-		speed := 0.005 + float64(i)*0.002
-		angle := float64(s.frameCount)*speed + float64(i)*(math.Pi/2)
+// Update updates the scene by the (scaled) simulation delta
+func (s *TestScene) Update(dt time.Duration) error {
+	s.elapsed += dt
 
-		// Update position
-		s.targets[i].Pos = common.Vector2{
-			X: float64(centerX) + math.Cos(angle)*radius,
-			Y: float64(centerY) + math.Sin(angle)*radius,
+	// A melee player attacks instead of toggling auto-aim, so the scene
+	// takes the press before the player's input sees it
+	if s.player.GetMelee() != nil && s.deps.InputManager.JustPressed(common.ActionAutoAttack) {
+		s.deps.InputManager.Consume(common.ActionAutoAttack)
+		if s.player.Swing() {
+			s.applyMeleeHits(s.player.GetMelee())
 		}
+	}
 
-		// Update velocity (tangent to circle)
-		s.targets[i].Vel = common.Vector2{
-			X: -math.Sin(angle) * 5,
-			Y: math.Cos(angle) * 5,
+	// Ability keys switch between the weapons
+	for i, action := range []common.Action{common.ActionUseAbility1, common.ActionUseAbility2, common.ActionUseAbility3} {
+		if i < len(s.weapons) && s.deps.InputManager.JustPressed(action) {
+			s.player.SetWeapon(entity.NewWeapon(s.weapons[i]))
 		}
 	}
 
+	// Bring in enemies from beyond the edges of the view
+	if s.deps.Camera != nil {
+		s.spawner.Update(dt, s.deps.Camera.GetViewport(), s.player.GetPosition(), s.enemies.Count(), s.spawnChaser)
+	}
+
+	// Move enemies and clear out the dead ones, then aim at those left
+	s.enemies.Update(dt)
+	s.player.UpdateWithQuery(s.enemies, dt)
+
+	// Ranged characters fire whenever the weapon is ready
+	if s.player.GetMelee() == nil {
+		s.fire()
+	}
+	if s.deps.Camera != nil {
+		s.projectiles.SetBounds(s.deps.Camera.GetViewport(), entity.DefaultProjectileCullMargin)
+	}
+	s.projectiles.Update(dt)
+	s.projectiles.Collide(s.projectileHit)
+
+	s.applyContactDamage(dt)
+
+	// Every enemy is shown on the minimap and off-screen indicators
+	s.targets = s.targets[:0]
+	for _, e := range s.enemies.Entities() {
+		s.targets = append(s.targets, e.GetTargetInfo())
+	}
+
+	// Pull in and collect nearby pickups, a kill streak multiplies their value
+	s.combo.Update(dt)
+	if gained := s.pickups.Update(s.player.GetPosition(), dt); gained > 0 {
+		gained = int(math.Round(float64(gained) * s.combo.Multiplier()))
+		s.experience += gained
+		s.texts.Spawn(fmt.Sprintf("+%d XP", gained), s.player.GetPosition(), effects.XPTextStyle())
+	}
+	s.texts.Update(dt)
+
+	// Regenerate target dummies
+	for _, d := range s.dummies {
+		d.Update(dt)
+	}
+
+	s.boss.Update(dt)
+
+	// Keep the player from walking through dummies and the boss
+	s.colliders = append(s.colliders[:0], s.player.Entity, s.boss.Entity)
+	for _, d := range s.dummies {
+		s.colliders = append(s.colliders, d.Entity)
+	}
+	s.colliders = append(s.colliders, s.enemies.Entities()...)
+	entity.ResolveCollisionsWith(s.colliders, s.separation)
+
 	return nil
 }
 
@@ -121,23 +377,73 @@ func (s *TestScene) Draw(screen *ebiten.Image) {
 	// Draw background grid
 	s.deps.Renderer.DrawGrid(screen)
 
-	// Draw all targets
-	for _, target := range s.targets {
-		drawTarget(screen, s.deps.Renderer, target)
+	// Draw all enemies, the ones without a sprite as plain circles
+	for _, e := range s.enemies.DrawOrder() {
+		if e.GetSprite() == nil {
+			drawTarget(screen, s.deps.Renderer, e)
+			continue
+		}
+		e.Draw(screen, s.deps.Renderer)
+		entity.DrawEntityHealthBar(screen, e, s.deps.Renderer, entity.DefaultHealthBarStyle())
+	}
+
+	// Draw target dummies
+	for _, d := range s.dummies {
+		d.Draw(screen, s.deps.Renderer)
 	}
 
-	// Draw player
+	// Draw boss
+	s.boss.Draw(screen, s.deps.Renderer)
+
+	// Draw pickups
+	s.pickups.Draw(screen, s.deps.Renderer)
+
+	// Draw player and shots in flight
 	s.player.Draw(screen, s.deps.Renderer)
+	s.projectiles.Draw(screen, s.deps.Renderer)
+
+	// Draw floating text over the world
+	s.texts.Draw(screen, s.deps.Renderer)
 
 	// Draw UI
 	// This would be better handled by a proper UI system
-	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("FPS: %0.2f", ebiten.ActualFPS()), 8, 8)
+	s.deps.Renderer.DrawHUDText(screen, fmt.Sprintf("FPS: %0.2f", ebiten.ActualFPS()), common.Vector2{X: 8, Y: 8}, hudTextColor)
+	s.deps.Renderer.DrawHUDText(screen, fmt.Sprintf("XP: %d", s.experience), common.Vector2{X: 8, Y: 24}, hudTextColor)
+	s.deps.Renderer.DrawHUDText(screen, "Aim assist: "+difficulty.AimAssistLabel(s.player.GetAutoAimStrength()), common.Vector2{X: 8, Y: 40}, hudTextColor)
+	if weapon := s.player.GetWeapon(); weapon != nil {
+		s.deps.Renderer.DrawHUDText(screen, "Weapon: "+weapon.GetConfig().Name, common.Vector2{X: 8, Y: 56}, hudTextColor)
+	}
+	if progress, ok := s.attackCooldown(); ok {
+		s.deps.Renderer.DrawCooldownRing(screen, cooldownRingCenter, cooldownRingRadius, progress)
+	}
+	if count := s.combo.GetCount(); count > 1 {
+		s.deps.Renderer.DrawHUDText(screen, fmt.Sprintf("Combo %d (x%.1f)", count, s.combo.Multiplier()), common.Vector2{X: 8, Y: 72}, hudTextColor)
+	}
+
+	// Point at targets outside the view
+	s.deps.Renderer.DrawOffscreenIndicators(s.targets)
+
+	// Draw minimap
+	s.minimap.Draw(screen, s.player.GetPosition(), s.targets, nil)
+}
+
+// DrawSpatialDebug overlays the scene's spatial grids
+func (s *TestScene) DrawSpatialDebug(screen *ebiten.Image) {
+	// Cell edges stay crisp regardless of the global setting
+	s.deps.Renderer.WithAntiAlias(rendering.AntiAliasOff, func() {
+		s.pickups.DrawGridDebug(screen, s.deps.Renderer)
+	})
+
+	// Label the collidable entities with their IDs
+	for _, e := range s.colliders {
+		s.deps.Renderer.DrawWorldLabel(screen, fmt.Sprintf("#%d", e.ID), e.Position, hudTextColor)
+	}
 }
 
-// Helper function to draw a target
-func drawTarget(screen *ebiten.Image, renderer *entity.RendererAdapter, target common.TargetInfo) {
-	// This would be better handled by a proper target entity
-	// For now, we'll just use the renderer adapter
+// drawTarget draws an enemy without a sprite as a circle with its velocity
+// and health
+func drawTarget(screen *ebiten.Image, renderer *entity.RendererAdapter, e *entity.Entity) {
+	target := e.GetTargetInfo()
 
 	// Draw target circle
 	renderer.DrawCircle(
@@ -147,8 +453,8 @@ func drawTarget(screen *ebiten.Image, renderer *entity.RendererAdapter, target c
 		color.RGBA{204, 0, 0, 255},
 	)
 
-	// Draw velocity vector
-	velEndPos := target.Pos.Add(target.Vel.Scale(5))
+	// Draw velocity vector, a quarter second ahead
+	velEndPos := target.Pos.Add(target.Vel.Scale(0.25))
 	renderer.DrawLine(
 		screen,
 		target.Pos,
@@ -158,21 +464,282 @@ func drawTarget(screen *ebiten.Image, renderer *entity.RendererAdapter, target c
 	)
 
 	// Draw health bar
-	healthBarPos := common.Vector2{
-		X: target.Pos.X - target.Radius,
-		Y: target.Pos.Y - target.Radius - 10,
+	bar := entity.HealthBarRect(target.Pos, target.Radius, entity.DefaultHealthBarStyle())
+	renderer.DrawHealthBar(screen, bar.Pos, bar.Size.X, bar.Size.Y, e.GetHealth().Percent())
+}
+
+// Snapshot captures the scene state for saving
+func (s *TestScene) Snapshot() save.State {
+	state := save.State{
+		Player: save.PlayerState{
+			Position: s.player.GetPosition(),
+		},
+		Wave:     s.spawner.GetWave(),
+		Score:    s.experience,
+		Survived: s.elapsed,
+		Rng:      save.CaptureRng(s.deps.Rng),
 	}
 
-	// Draw health bar using renderer
-	renderer.DrawHealthBar(
-		screen,
-		healthBarPos,
-		target.Radius*2,
-		4.0,
-		target.Priority/4.0,
-	)
+	if health := s.player.GetHealth(); health != nil {
+		state.Player.Health = health.GetCurrent()
+		state.Player.MaxHealth = health.GetMax()
+	}
+
+	return state
+}
+
+// Restore applies a saved state to the scene
+func (s *TestScene) Restore(state save.State) {
+	s.player.Position = state.Player.Position
+	s.player.SetVelocity(common.Vector2{})
+
+	if health := s.player.GetHealth(); health != nil && state.Player.MaxHealth > 0 {
+		health.SetMax(state.Player.MaxHealth)
+		health.SetCurrent(state.Player.Health)
+	}
+
+	s.spawner.SetWave(state.Wave)
+	s.experience = state.Score
+	s.elapsed = state.Survived
+	save.RestoreRng(s.deps.Rng, state.Rng)
+}
+
+// SaveGame writes the scene state to path
+func (s *TestScene) SaveGame(path string) error {
+	return save.Write(path, s.Snapshot())
+}
+
+// LoadGame restores the scene state saved at path
+func (s *TestScene) LoadGame(path string) error {
+	state, err := save.Read(path)
+	if err != nil {
+		return err
+	}
+	s.Restore(state)
+	return nil
+}
+
+// RunStats summarizes the run so far
+func (s *TestScene) RunStats() RunStats {
+	return RunStats{
+		Score:    s.experience,
+		Wave:     s.spawner.GetWave(),
+		Survived: s.elapsed,
+	}
+}
+
+// IsGameOver returns whether the player has died
+func (s *TestScene) IsGameOver() bool {
+	health := s.player.GetHealth()
+	return health != nil && health.IsDead()
+}
+
+// ResetRun puts the scene back to the start of the run. The random sequence
+// is rewound to its seed so the run plays out the same way again.
+func (s *TestScene) ResetRun() {
+	s.deps.Rng.Restore(s.deps.Rng.Seed(), 0)
+
+	s.player.TeleportTo(common.Vector2{
+		X: float64(s.deps.ScreenWidth) / 2,
+		Y: float64(s.deps.ScreenHeight) / 2,
+	})
+	if health := s.player.GetHealth(); health != nil {
+		health.SetCurrent(health.GetMax())
+	}
+
+	s.enemies.Clear()
+	createInitialTargets(s.deps, s.enemies)
+	s.spawner.Reset()
+	s.projectiles.Clear()
+	s.pickups = createInitialPickups(s.deps)
+	s.dummies = createDummies(s.deps.ScreenWidth, s.deps.ScreenHeight, s.deps.Events)
+	s.boss = createBoss(s.deps, s.player)
+	s.boss.SetColor(s.palette.EnemyBoss)
+	s.combo.Reset()
+	s.texts.Clear()
+	s.elapsed = 0
+	s.experience = 0
+	s.sinceContact = 0
+}
+
+// Halt stops the player so it does not drift once the scene resumes
+func (s *TestScene) Halt() {
+	s.player.Halt()
+}
+
+// SetUIScale resizes the scene's HUD elements
+func (s *TestScene) SetUIScale(scale float64) {
+	s.minimap.SetUIScale(scale)
+}
+
+// projectileHit damages the first enemy, dummy or boss a projectile touches
+// and reports whether it hit anything. Critical hits are published on the
+// event bus.
+func (s *TestScene) projectileHit(p *entity.Projectile) bool {
+	config := p.GetConfig()
+	damage, target := s.projectileTarget(p.Position, config.Radius)
+	if damage == nil {
+		return false
+	}
+	if target != nil {
+		target.ApplyEffect(config.Effect)
+	}
+
+	amount, critical := config.RollDamage(s.deps.Rng)
+	dealt := damage(amount, config.DamageType)
+	if critical && dealt > 0 && s.deps.Events != nil {
+		s.deps.Events.Publish(events.CriticalHit{Amount: dealt, Position: p.Position})
+	}
+	return true
+}
+
+// projectileTarget returns the damage function of the first enemy, dummy or
+// boss within radius of pos, or nil if nothing is there. The entity is
+// returned too when it takes status effects; dummies don't.
+func (s *TestScene) projectileTarget(pos common.Vector2, radius float64) (func(int, entity.DamageType) int, *entity.Entity) {
+	s.hits = s.enemies.QueryEntities(pos, radius, s.hits[:0])
+	defer clear(s.hits)
+	if len(s.hits) > 0 {
+		return s.hits[0].GetHealth().TakeDamage, s.hits[0]
+	}
+
+	for _, d := range s.dummies {
+		if pos.Sub(d.Position).Magnitude() <= d.GetRadius()+radius {
+			return d.Hit, nil
+		}
+	}
+
+	if s.boss.Touches(pos, radius) {
+		return s.boss.Hit, s.boss.Entity
+	}
+
+	return nil, nil
+}
+
+// applyContactDamage hurts the player when an enemy or the boss touches it,
+// at most once per contact interval. The boss hits harder than enemies.
+func (s *TestScene) applyContactDamage(dt time.Duration) {
+	s.sinceContact += dt
+	health := s.player.GetHealth()
+	if health == nil || s.player.IsNoclip() || s.sinceContact < contactInterval {
+		return
+	}
+
+	pos, radius := s.player.GetPosition(), s.player.GetCollision().Radius
+	damage := 0
+	if s.boss.Touches(pos, radius) {
+		damage = s.boss.GetContactDamage()
+	}
+	s.hits = s.enemies.QueryEntities(pos, radius, s.hits[:0])
+	if len(s.hits) > 0 {
+		damage = max(damage, contactDamage)
+	}
+	clear(s.hits)
+	if damage == 0 {
+		return
+	}
+
+	s.sinceContact = 0
+	dealt := health.TakeDamage(damage, entity.DamagePhysical)
+	if dealt > 0 && s.deps.Events != nil {
+		s.deps.Events.Publish(events.PlayerDamaged{Amount: dealt, Position: s.player.GetPosition()})
+	}
+}
+
+// fire shoots the player's weapon if it is ready and publishes the shot on
+// the event bus
+func (s *TestScene) fire() {
+	shot := s.player.Fire(s.projectiles)
+	if shot == nil || s.deps.Events == nil {
+		return
+	}
+
+	s.deps.Events.Publish(events.ProjectileFired{
+		OwnerID:   s.player.GetID(),
+		Position:  shot.Position,
+		Direction: shot.Velocity.Normalized(),
+	})
+}
+
+// applyMeleeHits damages the enemies, dummies and boss inside a swing's arc
+func (s *TestScene) applyMeleeHits(melee *entity.MeleeAttack) {
+	// Enemies are found through the manager's grid, the dead are removed on
+	// its next update
+	melee.HitEntities(s.enemies)
+
+	config := melee.GetConfig()
+	for _, d := range s.dummies {
+		if melee.InReach(d.Position, d.GetRadius()) {
+			d.Hit(config.Damage, config.DamageType)
+		}
+	}
+
+	if !s.boss.IsDefeated() && melee.InReach(s.boss.Position, s.boss.GetCollision().Radius) {
+		s.boss.Hit(config.Damage, config.DamageType)
+	}
+}
+
+// attackCooldown returns the remaining cooldown of the player's attack, the
+// melee swing if it has one and its weapon otherwise, and false if it has
+// neither
+func (s *TestScene) attackCooldown() (float64, bool) {
+	if melee := s.player.GetMelee(); melee != nil {
+		return melee.CooldownProgress(), true
+	}
+	if weapon := s.player.GetWeapon(); weapon != nil {
+		return weapon.CooldownProgress(), true
+	}
+	return 0, false
+}
+
+// SetAutoAimStrength sets the player's auto-aim strength
+func (s *TestScene) SetAutoAimStrength(strength float64) {
+	s.player.SetAutoAimStrength(strength)
+}
+
+// SetDifficulty changes the difficulty modifiers. Enemies spawned from now
+// on get the new health, the spawn rate follows at once and the player takes
+// damage at the new scale.
+func (s *TestScene) SetDifficulty(modifiers difficulty.Modifiers) {
+	s.deps.Difficulty = modifiers
+	s.spawner.SetModifiers(modifiers)
+	if health := s.player.GetHealth(); health != nil {
+		health.DamageScale = modifiers.PlayerDamageTaken
+	}
+}
+
+// SetAutoAimCandidates sets how many of the closest enemies auto-aim ranks
+func (s *TestScene) SetAutoAimCandidates(k int) {
+	s.player.SetAutoAimCandidates(k)
+}
+
+// SetSeparation sets how firmly overlapping entities are pushed apart
+func (s *TestScene) SetSeparation(config entity.SeparationConfig) {
+	s.separation = config
+}
+
+// SetPalette recolors scene elements that keep their own copy of the palette
+func (s *TestScene) SetPalette(palette rendering.ColorPalette) {
+	s.palette = palette
+	s.minimap.SetPalette(palette)
+	s.boss.SetColor(palette.EnemyBoss)
 }
 
 func (s *TestScene) GetPlayer() *player.Player {
 	return s.player
 }
+
+// GetBoss returns the scene's boss
+func (s *TestScene) GetBoss() *boss.Boss {
+	return s.boss
+}
+
+// GetEnemies returns the manager owning the scene's enemies
+func (s *TestScene) GetEnemies() *entity.Manager {
+	return s.enemies
+}
+
+// GetDummies returns the target dummies in the scene
+func (s *TestScene) GetDummies() []*dummy.Dummy {
+	return s.dummies
+}
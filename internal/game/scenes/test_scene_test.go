@@ -0,0 +1,272 @@
+package scene
+
+import (
+	"novampires-go/internal/common"
+	"novampires-go/internal/engine/entity"
+	"novampires-go/internal/engine/events"
+	"novampires-go/internal/engine/input"
+	"novampires-go/internal/game/difficulty"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestMain runs the package's tests from the repository root, where the
+// scene finds its sprites and prefabs as it does when the game runs
+func TestMain(m *testing.M) {
+	if err := os.Chdir(filepath.Join("..", "..", "..")); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// newScene creates a test scene with real input and no renderer or camera
+func newScene(t *testing.T) *TestScene {
+	t.Helper()
+	s, err := NewTestScene(Dependencies{
+		InputManager: input.New(),
+		Events:       events.NewBus(),
+		Difficulty:   difficulty.For(difficulty.Normal),
+		Rng:          common.NewRng(1),
+		ScreenWidth:  800,
+		ScreenHeight: 600,
+	})
+	if err != nil {
+		t.Fatalf("NewTestScene: %v", err)
+	}
+	return s
+}
+
+// spawnOnPlayer adds an enemy right on top of the player
+func spawnOnPlayer(s *TestScene) *entity.Entity {
+	e := s.enemies.Spawn(s.player.GetPosition())
+	e.SetHealth(entity.NewHealthComponent(chaserHealth))
+	e.SetCollision(entity.NewCollisionComponent(chaserRadius))
+	return e
+}
+
+func TestContactDamage(t *testing.T) {
+	s := newScene(t)
+	health := s.player.GetHealth()
+	spawnOnPlayer(s)
+
+	s.applyContactDamage(contactInterval)
+	want := health.GetMax() - contactDamage
+	if got := health.GetCurrent(); got != want {
+		t.Fatalf("health = %d after contact, want %d", got, want)
+	}
+
+	s.applyContactDamage(contactInterval - time.Millisecond)
+	if got := health.GetCurrent(); got != want {
+		t.Errorf("health = %d before the contact interval passed, want %d", got, want)
+	}
+}
+
+func TestBossContactDamage(t *testing.T) {
+	s := newScene(t)
+	health := s.player.GetHealth()
+	s.boss.Position = s.player.GetPosition()
+
+	s.applyContactDamage(contactInterval)
+	want := health.GetMax() - s.boss.GetContactDamage()
+	if got := health.GetCurrent(); got != want {
+		t.Errorf("health = %d after touching the boss, want %d", got, want)
+	}
+
+	// Touching an enemy at the same time deals the harder hit only
+	spawnOnPlayer(s)
+	s.applyContactDamage(contactInterval)
+	want -= max(s.boss.GetContactDamage(), contactDamage)
+	if got := health.GetCurrent(); got != want {
+		t.Errorf("health = %d after touching the boss and an enemy, want %d", got, want)
+	}
+}
+
+func TestContactDamagePublishesPlayerDamaged(t *testing.T) {
+	s := newScene(t)
+	var got []events.PlayerDamaged
+	s.deps.Events.Subscribe(events.TypePlayerDamaged, func(e events.Event) {
+		got = append(got, e.(events.PlayerDamaged))
+	})
+
+	s.applyContactDamage(contactInterval)
+	if len(got) != 0 {
+		t.Fatalf("published %v without an enemy touching the player", got)
+	}
+
+	spawnOnPlayer(s)
+	s.applyContactDamage(contactInterval)
+	want := events.PlayerDamaged{Amount: contactDamage, Position: s.player.GetPosition()}
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("published %v, want [%v]", got, want)
+	}
+}
+
+func TestFirePublishesProjectileFired(t *testing.T) {
+	s := newScene(t)
+	var got []events.ProjectileFired
+	s.deps.Events.Subscribe(events.TypeProjectileFired, func(e events.Event) {
+		got = append(got, e.(events.ProjectileFired))
+	})
+
+	s.deps.InputManager.(*input.Manager).ApplySnapshot(input.InputSnapshot{AimY: -1, HasAim: true})
+	s.fire()
+	s.fire() // On cooldown
+	if len(got) != 1 {
+		t.Fatalf("published %d events, want 1 for the one shot fired", len(got))
+	}
+
+	shot := s.projectiles.All()[0]
+	want := events.ProjectileFired{OwnerID: s.player.GetID(), Position: shot.Position, Direction: common.Vector2{X: 0, Y: -1}}
+	if got[0] != want {
+		t.Errorf("published %+v, want %+v", got[0], want)
+	}
+}
+
+func TestProjectileTargetReach(t *testing.T) {
+	s := newScene(t)
+	d := s.dummies[0]
+	reach := d.GetRadius() + 4
+
+	if damage, _ := s.projectileTarget(d.Position.Add(common.Vector2{Y: reach}), 4); damage == nil {
+		t.Error("projectile touching the dummy's edge missed it")
+	}
+	if damage, _ := s.projectileTarget(d.Position.Add(common.Vector2{Y: reach + 1}), 4); damage != nil {
+		t.Error("projectile clear of the dummy hit it")
+	}
+
+	edge := s.boss.Position.Add(common.Vector2{X: s.boss.GetCollision().Radius + 4})
+	if _, target := s.projectileTarget(edge, 4); target != s.boss.Entity {
+		t.Errorf("projectile touching the boss's edge hit %v, want the boss", target)
+	}
+}
+
+func TestLeadFollowsEquippedWeapon(t *testing.T) {
+	s := newScene(t)
+	s.player.SetPredictiveAim(true)
+	s.enemies = entity.NewManager()
+	e := s.enemies.Spawn(s.player.GetPosition().Add(common.Vector2{X: 200, Y: 100}))
+	e.SetHealth(entity.NewHealthComponent(chaserHealth))
+	e.SetCollision(entity.NewCollisionComponent(chaserRadius))
+	e.Velocity = common.Vector2{Y: -150}
+
+	for _, config := range s.weapons {
+		s.player.SetWeapon(entity.NewWeapon(config))
+		s.player.UpdateWithQuery(s.enemies, 16*time.Millisecond)
+
+		lead, ok := s.player.GetLeadPoint()
+		want := entity.InterceptPoint(s.player.GetPosition(), config.Projectile.Speed, e.Position, e.Velocity)
+		if !ok || lead != want {
+			t.Errorf("%s: lead point = %v, %v, want the intercept %v", config.Name, lead, ok, want)
+		}
+	}
+}
+
+func TestAttackCooldownFollowsAttack(t *testing.T) {
+	s := newScene(t)
+	if progress, ok := s.attackCooldown(); !ok || progress != 0 {
+		t.Errorf("cooldown = %v, %v with a ready weapon, want 0, true", progress, ok)
+	}
+
+	s.deps.InputManager.(*input.Manager).ApplySnapshot(input.InputSnapshot{AimX: 1, HasAim: true})
+	s.fire()
+	if progress, ok := s.attackCooldown(); !ok || progress != 1 {
+		t.Errorf("cooldown = %v, %v after firing, want 1, true", progress, ok)
+	}
+
+	s.player.SetMelee(entity.NewMeleeAttack(entity.DefaultMeleeConfig()))
+	if progress, _ := s.attackCooldown(); progress != 0 {
+		t.Errorf("cooldown = %v with a ready melee swing, want the swing's 0 over the weapon's", progress)
+	}
+
+	s.player.SetMelee(nil)
+	s.player.SetWeapon(nil)
+	if _, ok := s.attackCooldown(); ok {
+		t.Error("cooldown shown without a weapon or melee swing")
+	}
+}
+
+func TestResetRunAfterDeath(t *testing.T) {
+	s := newScene(t)
+	startDraws := s.deps.Rng.Draws()
+	health := s.player.GetHealth()
+	s.projectiles.Fire(s.player.GetPosition(), common.Vector2{X: 1}, entity.DefaultProjectileConfig())
+	s.spawner.SetWave(4)
+	s.experience = 120
+	s.elapsed = time.Minute
+	health.TakeDamage(health.GetMax(), entity.DamageTrue)
+
+	if !s.IsGameOver() {
+		t.Fatal("not game over with the player dead")
+	}
+	if got := s.RunStats().Wave; got != 4 {
+		t.Errorf("run wave = %d, want the spawner's wave 4", got)
+	}
+
+	s.ResetRun()
+	if s.IsGameOver() {
+		t.Error("still game over after retry")
+	}
+	if got := health.GetCurrent(); got != health.GetMax() {
+		t.Errorf("health = %d after retry, want %d", got, health.GetMax())
+	}
+	if got := s.RunStats(); got.Score != 0 || got.Wave != 1 || got.Survived != 0 {
+		t.Errorf("run stats = %+v after retry, want a fresh run", got)
+	}
+	if got := s.projectiles.Count(); got != 0 {
+		t.Errorf("projectiles in flight = %d after retry, want 0", got)
+	}
+	if got := s.deps.Rng.Draws(); got != startDraws {
+		t.Errorf("rng draws = %d after retry, want %d as at the start", got, startDraws)
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	s := newScene(t)
+	s.player.TeleportTo(common.Vector2{X: 10, Y: 20})
+	s.player.GetHealth().TakeDamage(25, entity.DamageTrue)
+	s.spawner.SetWave(3)
+	s.experience = 80
+	s.elapsed = 42 * time.Second
+	want := s.Snapshot()
+
+	path := filepath.Join(t.TempDir(), "save.json")
+	if err := s.SaveGame(path); err != nil {
+		t.Fatalf("SaveGame: %v", err)
+	}
+
+	loaded := newScene(t)
+	if err := loaded.LoadGame(path); err != nil {
+		t.Fatalf("LoadGame: %v", err)
+	}
+	got := loaded.Snapshot()
+	got.Version = want.Version
+	if got != want {
+		t.Errorf("loaded state = %+v, want %+v", got, want)
+	}
+	if wave := loaded.spawner.GetWave(); wave != 3 {
+		t.Errorf("spawner wave = %d, want 3", wave)
+	}
+}
+
+func TestSetDifficultyReachesSpawnedEnemies(t *testing.T) {
+	s := newScene(t)
+	hard := difficulty.For(difficulty.Hard)
+	s.SetDifficulty(hard)
+
+	before := len(s.enemies.Entities())
+	s.spawnChaser(common.Vector2{X: 1000, Y: 1000})
+	entities := s.enemies.Entities()
+	if len(entities) != before+1 {
+		t.Fatalf("entities = %d, want %d after spawning", len(entities), before+1)
+	}
+
+	want := hard.ScaleEnemyHealth(chaserHealth)
+	if got := entities[len(entities)-1].GetHealth().GetMax(); got != want {
+		t.Errorf("spawned health = %d, want %d", got, want)
+	}
+	if got := s.player.GetHealth().DamageScale; got != hard.PlayerDamageTaken {
+		t.Errorf("player damage scale = %v, want %v", got, hard.PlayerDamageTaken)
+	}
+}
@@ -0,0 +1,64 @@
+package spawn
+
+import (
+	"math"
+	"novampires-go/internal/common"
+)
+
+// Config contains spawn placement parameters
+type Config struct {
+	// Minimum distance between a spawn and the player, so nothing appears
+	// point-blank when the view is small or zoomed in
+	SafeRadius float64
+
+	// Distance beyond the viewport edge enemies appear at, so they are
+	// fully off-screen
+	Margin float64
+}
+
+// DefaultConfig returns default spawn configuration
+func DefaultConfig() Config {
+	return Config{
+		SafeRadius: 300.0,
+		Margin:     40.0,
+	}
+}
+
+// expand grows a rectangle by margin on every side
+func expand(rect common.Rectangle, margin float64) common.Rectangle {
+	return common.Rectangle{
+		Pos:  common.Vector2{X: rect.Pos.X - margin, Y: rect.Pos.Y - margin},
+		Size: common.Vector2{X: rect.Size.X + margin*2, Y: rect.Size.Y + margin*2},
+	}
+}
+
+// PositionAt returns the spawn position in a direction (radians) from the
+// player: just outside the viewport, pushed out to at least the safe radius.
+// If the player is off-screen the direction is taken from the viewport
+// center instead, and only the viewport rule is guaranteed.
+func PositionAt(angle float64, viewport common.Rectangle, player common.Vector2, config Config) common.Vector2 {
+	bounds := expand(viewport, max(config.Margin, 0))
+	dir := common.Vector2{X: math.Cos(angle), Y: math.Sin(angle)}
+
+	origin := player
+	if !viewport.Contains(player) {
+		origin = viewport.Center()
+	}
+
+	pos := common.EdgeIntersection(origin, origin.Add(dir), bounds)
+	if origin != player {
+		return pos
+	}
+
+	// Moving further along the ray keeps the point outside the viewport
+	if pos.Sub(player).Magnitude() < config.SafeRadius {
+		pos = player.Add(dir.Scale(config.SafeRadius))
+	}
+	return pos
+}
+
+// Position returns a random spawn position just outside the viewport and
+// at least the safe radius from the player
+func Position(rng *common.Rng, viewport common.Rectangle, player common.Vector2, config Config) common.Vector2 {
+	return PositionAt(rng.Range(0, 2*math.Pi), viewport, player, config)
+}
@@ -0,0 +1,136 @@
+package spawn
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"novampires-go/internal/game/difficulty"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPositionOutsideViewAndSafeRadius(t *testing.T) {
+	config := DefaultConfig()
+	rng := common.NewRng(7)
+
+	tests := []struct {
+		name     string
+		viewport common.Rectangle
+		player   common.Vector2
+	}{
+		{"centered", common.Rectangle{Size: common.Vector2{X: 1600, Y: 900}}, common.Vector2{X: 800, Y: 450}},
+		{"near an edge", common.Rectangle{Size: common.Vector2{X: 1600, Y: 900}}, common.Vector2{X: 20, Y: 450}},
+		{"zoomed in", common.Rectangle{Pos: common.Vector2{X: 700, Y: 400}, Size: common.Vector2{X: 200, Y: 100}}, common.Vector2{X: 800, Y: 450}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 200 {
+				pos := Position(rng, tt.viewport, tt.player, config)
+				if tt.viewport.Contains(pos) {
+					t.Fatalf("spawn %v is inside the viewport %v", pos, tt.viewport)
+				}
+				if d := pos.Sub(tt.player).Magnitude(); d < config.SafeRadius-1e-9 {
+					t.Fatalf("spawn %v is %v from the player, want at least %v", pos, d, config.SafeRadius)
+				}
+			}
+		})
+	}
+}
+
+func TestSpawnerPlacesEnemiesOffScreen(t *testing.T) {
+	viewport := common.Rectangle{Size: common.Vector2{X: 1600, Y: 900}}
+	player := viewport.Center()
+	config := DefaultSpawnerConfig()
+
+	spawner := NewSpawner(config, difficulty.For(difficulty.Normal), common.NewRng(1))
+
+	var spawned []common.Vector2
+	for range 600 {
+		spawner.Update(16*time.Millisecond, viewport, player, 0, func(pos common.Vector2) {
+			spawned = append(spawned, pos)
+		})
+	}
+
+	if len(spawned) == 0 {
+		t.Fatal("nothing spawned in ten seconds")
+	}
+	for _, pos := range spawned {
+		if viewport.Contains(pos) || pos.Sub(player).Magnitude() < config.Placement.SafeRadius-1e-9 {
+			t.Errorf("spawn %v is on screen or too close to the player", pos)
+		}
+	}
+}
+
+func TestSpawnerInterval(t *testing.T) {
+	config := DefaultSpawnerConfig()
+	config.MinInterval = 0
+
+	normal := NewSpawner(config, difficulty.For(difficulty.Normal), common.NewRng(1))
+	hard := NewSpawner(config, difficulty.For(difficulty.Hard), common.NewRng(1))
+	if hard.Interval() >= normal.Interval() {
+		t.Errorf("hard interval %v, want shorter than normal %v", hard.Interval(), normal.Interval())
+	}
+
+	normal.SetWave(3)
+	want := time.Duration(config.Interval.Seconds() * math.Pow(config.WaveSpeedup, 2) * float64(time.Second))
+	if got := normal.Interval(); got != want {
+		t.Errorf("wave 3 interval = %v, want %v", got, want)
+	}
+}
+
+func TestSpawnerMaxAlive(t *testing.T) {
+	config := DefaultSpawnerConfig()
+	config.MaxAlive = 3
+	spawner := NewSpawner(config, difficulty.For(difficulty.Normal), common.NewRng(1))
+
+	viewport := common.Rectangle{Size: common.Vector2{X: 1600, Y: 900}}
+	spawned := spawner.Update(10*config.Interval, viewport, viewport.Center(), 1, func(common.Vector2) {})
+	if spawned != 2 {
+		t.Errorf("spawned %d with 1 alive, want 2 to reach the cap of 3", spawned)
+	}
+}
+
+func TestSpawnerWaves(t *testing.T) {
+	config := DefaultSpawnerConfig()
+	spawner := NewSpawner(config, difficulty.For(difficulty.Normal), common.NewRng(1))
+
+	viewport := common.Rectangle{Size: common.Vector2{X: 1600, Y: 900}}
+	spawner.Update(2*config.WaveLength+time.Second, viewport, viewport.Center(), config.MaxAlive, func(common.Vector2) {})
+	if got := spawner.GetWave(); got != 3 {
+		t.Errorf("wave after two wave lengths = %d, want 3", got)
+	}
+
+	spawner.Reset()
+	if got := spawner.GetWave(); got != 1 {
+		t.Errorf("wave after reset = %d, want 1", got)
+	}
+}
+
+// spawnSequence runs a spawner seeded with seed for ten seconds and returns
+// where it spawned
+func spawnSequence(seed int64) []common.Vector2 {
+	viewport := common.Rectangle{Size: common.Vector2{X: 1600, Y: 900}}
+	spawner := NewSpawner(DefaultSpawnerConfig(), difficulty.For(difficulty.Normal), common.NewRng(seed))
+
+	var spawned []common.Vector2
+	for range 600 {
+		spawner.Update(16*time.Millisecond, viewport, viewport.Center(), 0, func(pos common.Vector2) {
+			spawned = append(spawned, pos)
+		})
+	}
+	return spawned
+}
+
+func TestSpawnerSeedIsReproducible(t *testing.T) {
+	first := spawnSequence(42)
+	if len(first) == 0 {
+		t.Fatal("nothing spawned in ten seconds")
+	}
+	if second := spawnSequence(42); !slices.Equal(first, second) {
+		t.Error("spawners with the same seed spawned different sequences")
+	}
+	if other := spawnSequence(43); slices.Equal(first, other) {
+		t.Error("spawners with different seeds spawned the same sequence")
+	}
+}
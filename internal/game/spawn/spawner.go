@@ -0,0 +1,124 @@
+package spawn
+
+import (
+	"math"
+	"novampires-go/internal/common"
+	"novampires-go/internal/game/difficulty"
+	"time"
+)
+
+// SpawnerConfig controls how often a Spawner places enemies and how the
+// pace picks up from wave to wave
+type SpawnerConfig struct {
+	Placement Config
+
+	// Time between spawns during the first wave, before difficulty scaling
+	Interval time.Duration
+
+	// Shortest interval later waves can reach
+	MinInterval time.Duration
+
+	// How long each wave lasts
+	WaveLength time.Duration
+
+	// Interval multiplier applied with each new wave (< 1 speeds up)
+	WaveSpeedup float64
+
+	// No spawns while this many enemies are alive, 0 for no limit
+	MaxAlive int
+}
+
+// DefaultSpawnerConfig returns default spawner configuration
+func DefaultSpawnerConfig() SpawnerConfig {
+	return SpawnerConfig{
+		Placement:   DefaultConfig(),
+		Interval:    2 * time.Second,
+		MinInterval: 250 * time.Millisecond,
+		WaveLength:  30 * time.Second,
+		WaveSpeedup: 0.8,
+		MaxAlive:    60,
+	}
+}
+
+// Spawner places enemies around the player on a timer, spawning faster each
+// wave. The difficulty's spawn rate scales the interval.
+type Spawner struct {
+	config    SpawnerConfig
+	modifiers difficulty.Modifiers
+	rng       *common.Rng
+
+	wave       int
+	sinceWave  time.Duration
+	sinceSpawn time.Duration
+}
+
+// NewSpawner creates a spawner at the start of the first wave
+func NewSpawner(config SpawnerConfig, modifiers difficulty.Modifiers, rng *common.Rng) *Spawner {
+	return &Spawner{
+		config:    config,
+		modifiers: modifiers,
+		rng:       rng,
+		wave:      1,
+	}
+}
+
+// Update advances the timers and calls spawn for every enemy due this frame,
+// at positions outside viewport and away from player. alive is the number of
+// enemies currently alive. It returns how many were spawned.
+func (s *Spawner) Update(dt time.Duration, viewport common.Rectangle, player common.Vector2, alive int, spawn func(pos common.Vector2)) int {
+	if s.config.WaveLength > 0 {
+		s.sinceWave += dt
+		for s.sinceWave >= s.config.WaveLength {
+			s.sinceWave -= s.config.WaveLength
+			s.wave++
+		}
+	}
+
+	interval := s.Interval()
+	if interval <= 0 {
+		return 0
+	}
+
+	spawned := 0
+	for s.sinceSpawn += dt; s.sinceSpawn >= interval; s.sinceSpawn -= interval {
+		if s.config.MaxAlive > 0 && alive+spawned >= s.config.MaxAlive {
+			continue
+		}
+		spawn(Position(s.rng, viewport, player, s.config.Placement))
+		spawned++
+	}
+	return spawned
+}
+
+// Interval returns the time between spawns in the current wave
+func (s *Spawner) Interval() time.Duration {
+	base := s.config.Interval.Seconds()
+	if s.config.WaveSpeedup > 0 {
+		base *= math.Pow(s.config.WaveSpeedup, float64(s.wave-1))
+	}
+
+	interval := time.Duration(s.modifiers.ScaleSpawnInterval(base) * float64(time.Second))
+	return max(interval, s.config.MinInterval)
+}
+
+// GetWave returns the current wave, starting at 1
+func (s *Spawner) GetWave() int {
+	return s.wave
+}
+
+// SetWave jumps to the start of a wave, e.g. when loading a save
+func (s *Spawner) SetWave(wave int) {
+	s.wave = max(wave, 1)
+	s.sinceWave = 0
+	s.sinceSpawn = 0
+}
+
+// SetModifiers changes the difficulty scaling of the interval
+func (s *Spawner) SetModifiers(modifiers difficulty.Modifiers) {
+	s.modifiers = modifiers
+}
+
+// Reset returns to the start of the first wave
+func (s *Spawner) Reset() {
+	s.SetWave(1)
+}